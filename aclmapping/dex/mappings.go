@@ -12,6 +12,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	dexkeeper "github.com/sei-protocol/sei-chain/x/dex/keeper"
 	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
 var ErrPlaceOrdersGenerator = fmt.Errorf("invalid message received for dex module")
@@ -22,8 +23,12 @@ func GetDexDependencyGenerators() aclkeeper.DependencyGeneratorMap {
 	// dex place orders
 	placeOrdersKey := acltypes.GenerateMessageKey(&dextypes.MsgPlaceOrders{})
 	cancelOrdersKey := acltypes.GenerateMessageKey(&dextypes.MsgCancelOrders{})
+	cancelAllKey := acltypes.GenerateMessageKey(&dextypes.MsgCancelAll{})
+	cancelReplaceKey := acltypes.GenerateMessageKey(&dextypes.MsgCancelReplace{})
 	dependencyGeneratorMap[placeOrdersKey] = DexPlaceOrdersDependencyGenerator
 	dependencyGeneratorMap[cancelOrdersKey] = DexCancelOrdersDependencyGenerator
+	dependencyGeneratorMap[cancelAllKey] = DexCancelAllDependencyGenerator
+	dependencyGeneratorMap[cancelReplaceKey] = DexCancelReplaceDependencyGenerator
 
 	return dependencyGeneratorMap
 }
@@ -44,6 +49,17 @@ func GetLongShortOrderBookOps(contractAddr string, priceDenom string, assetDenom
 	}
 }
 
+// GetTriggerBookOp reads the stop orders that have not been triggered yet under the given trigger
+// book prefix. The trigger book doesn't have a dedicated resource type, so the op is scoped by its
+// full key prefix through the prefix-less pair resource type.
+func GetTriggerBookOp(triggerBookPrefix []byte) sdkacltypes.AccessOperation {
+	return sdkacltypes.AccessOperation{
+		AccessType:         sdkacltypes.AccessType_READ,
+		ResourceType:       sdkacltypes.ResourceType_KV_DEX_PAIR_PREFIX,
+		IdentifierTemplate: hex.EncodeToString(triggerBookPrefix),
+	}
+}
+
 func DexPlaceOrdersDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
 	placeOrdersMsg, ok := msg.(*dextypes.MsgPlaceOrders)
 	if !ok {
@@ -155,6 +171,26 @@ func DexPlaceOrdersDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context, m
 		},
 	}
 
+	// orders of pairs linked to an oracle denom are checked against its exchange rate or TWAP.
+	// These are only written outside of txs, so the reads don't conflict with other txs.
+	aclOps = append(aclOps, []sdkacltypes.AccessOperation{
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_EXCHANGE_RATE,
+			IdentifierTemplate: hex.EncodeToString(oracletypes.ExchangeRateKey),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_VOTE_TARGETS,
+			IdentifierTemplate: hex.EncodeToString(oracletypes.VoteTargetKey),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_PRICE_SNAPSHOT,
+			IdentifierTemplate: hex.EncodeToString(oracletypes.PriceSnapshotKey),
+		},
+	}...)
+
 	// Last Operation should always be a commit
 	aclOps = append(aclOps, *acltypes.CommitAccessOp())
 	return aclOps, nil
@@ -189,9 +225,95 @@ func DexCancelOrdersDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg s
 		priceDenom := order.GetPriceDenom()
 		assetDenom := order.GetAssetDenom()
 		aclOps = append(aclOps, GetLongShortOrderBookOps(contractAddr, priceDenom, assetDenom)...)
+		// stop orders that have not been triggered yet are looked up in the trigger book
+		aclOps = append(aclOps, GetTriggerBookOp(dextypes.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom)))
 	}
 
 	// Last Operation should always be a commit
 	aclOps = append(aclOps, *acltypes.CommitAccessOp())
 	return aclOps, nil
 }
+
+func DexCancelAllDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+	cancelAllMsg, ok := msg.(*dextypes.MsgCancelAll)
+	if !ok {
+		return []sdkacltypes.AccessOperation{}, ErrPlaceOrdersGenerator
+	}
+	contractAddr := cancelAllMsg.ContractAddr
+
+	aclOps := []sdkacltypes.AccessOperation{
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_MEM_CANCEL,
+			IdentifierTemplate: hex.EncodeToString(dextypes.MemCancelPrefix(contractAddr)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_MEM_CANCEL,
+			IdentifierTemplate: hex.EncodeToString(dextypes.MemCancelPrefix(contractAddr)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_CONTRACT,
+			IdentifierTemplate: hex.EncodeToString([]byte(dexkeeper.ContractPrefixKey)),
+		},
+	}
+
+	if pair, ok := cancelAllMsg.GetPair(); ok {
+		aclOps = append(aclOps, sdkacltypes.AccessOperation{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_ACCOUNT_ACTIVE_ORDERS,
+			IdentifierTemplate: hex.EncodeToString(dextypes.AccountActiveOrdersPrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, cancelAllMsg.Creator)),
+		})
+		aclOps = append(aclOps, GetLongShortOrderBookOps(contractAddr, pair.PriceDenom, pair.AssetDenom)...)
+		aclOps = append(aclOps, GetTriggerBookOp(dextypes.TriggerOrderBookPrefix(contractAddr, pair.PriceDenom, pair.AssetDenom)))
+	} else {
+		// all registered pairs of the contract are looked up
+		aclOps = append(aclOps, []sdkacltypes.AccessOperation{
+			{
+				AccessType:         sdkacltypes.AccessType_READ,
+				ResourceType:       sdkacltypes.ResourceType_KV_DEX_REGISTERED_PAIR,
+				IdentifierTemplate: hex.EncodeToString(dextypes.RegisteredPairPrefix(contractAddr)),
+			},
+			{
+				AccessType:         sdkacltypes.AccessType_READ,
+				ResourceType:       sdkacltypes.ResourceType_KV_DEX_ACCOUNT_ACTIVE_ORDERS,
+				IdentifierTemplate: hex.EncodeToString(dextypes.AccountActiveOrdersAccountPrefix(contractAddr, cancelAllMsg.Creator)),
+			},
+			{
+				AccessType:         sdkacltypes.AccessType_READ,
+				ResourceType:       sdkacltypes.ResourceType_KV_DEX_CONTRACT_LONGBOOK,
+				IdentifierTemplate: hex.EncodeToString(dextypes.OrderBookContractPrefix(true, contractAddr)),
+			},
+			{
+				AccessType:         sdkacltypes.AccessType_READ,
+				ResourceType:       sdkacltypes.ResourceType_KV_DEX_CONTRACT_SHORTBOOK,
+				IdentifierTemplate: hex.EncodeToString(dextypes.OrderBookContractPrefix(false, contractAddr)),
+			},
+			GetTriggerBookOp(dextypes.TriggerOrderBookContractPrefix(contractAddr)),
+		}...)
+	}
+
+	// Last Operation should always be a commit
+	aclOps = append(aclOps, *acltypes.CommitAccessOp())
+	return aclOps, nil
+}
+
+// DexCancelReplaceDependencyGenerator combines the dependencies of cancelling the replaced
+// order and placing the new one
+func DexCancelReplaceDependencyGenerator(keeper aclkeeper.Keeper, ctx sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+	cancelReplaceMsg, ok := msg.(*dextypes.MsgCancelReplace)
+	if !ok || cancelReplaceMsg.Cancellation == nil || cancelReplaceMsg.Order == nil {
+		return []sdkacltypes.AccessOperation{}, ErrPlaceOrdersGenerator
+	}
+	cancelOps, err := DexCancelOrdersDependencyGenerator(keeper, ctx, cancelReplaceMsg.GetCancelOrders())
+	if err != nil {
+		return []sdkacltypes.AccessOperation{}, err
+	}
+	placeOps, err := DexPlaceOrdersDependencyGenerator(keeper, ctx, cancelReplaceMsg.GetPlaceOrders())
+	if err != nil {
+		return []sdkacltypes.AccessOperation{}, err
+	}
+	// drop the commit of the cancellation ops so that the only commit is the last operation
+	return append(cancelOps[:len(cancelOps)-1], placeOps...), nil
+}
@@ -180,6 +180,64 @@ func (suite *KeeperTestSuite) TestMsgPlaceOrder() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgPlaceOrderWithOracleDenom() {
+	suite.PrepareTest()
+	goCtx := context.WithValue(suite.Ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(suite.App.GetMemKey(dextypes.MemStoreKey)))
+	suite.Ctx = suite.Ctx.WithContext(goCtx)
+
+	band := sdk.MustNewDecFromStr("0.5")
+	for _, lookback := range []uint64{0, 100} {
+		pair := keepertest.TestPair
+		pair.AssetDenom = fmt.Sprintf("ATOM%d", lookback)
+		pair.OracleDenom = "uatom"
+		pair.OraclePriceBand = &band
+		pair.OracleTwapLookbackSeconds = lookback
+		suite.App.DexKeeper.AddRegisteredPair(suite.Ctx, suite.contract, pair)
+		suite.App.DexKeeper.SetPriceTickSizeForPair(suite.Ctx, suite.contract, pair, *pair.PriceTicksize)
+		suite.App.DexKeeper.SetQuantityTickSizeForPair(suite.Ctx, suite.contract, pair, *pair.QuantityTicksize)
+	}
+	suite.App.OracleKeeper.SetVoteTarget(suite.Ctx, "uatom")
+	suite.App.OracleKeeper.SetBaseExchangeRate(suite.Ctx, "uatom", sdk.NewDec(10))
+	suite.App.OracleKeeper.SetPriceSnapshot(suite.Ctx, oracletypes.NewPriceSnapshot(oracletypes.PriceSnapshotItems{
+		oracletypes.NewPriceSnapshotItem("uatom", oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(10), LastUpdate: sdk.NewInt(5)}),
+	}, 300))
+
+	msg := &dextypes.MsgPlaceOrders{
+		Creator:      suite.creator,
+		ContractAddr: suite.contract,
+	}
+	for _, assetDenom := range []string{"ATOM0", "ATOM100"} {
+		msg.Orders = append(msg.Orders, &types.Order{
+			Price:             sdk.MustNewDecFromStr("10"),
+			Quantity:          sdk.MustNewDecFromStr("10"),
+			PositionDirection: types.PositionDirection_LONG,
+			OrderType:         types.OrderType_LIMIT,
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        assetDenom,
+		})
+	}
+
+	handlerCtx, cms := aclutils.CacheTxContext(suite.Ctx)
+	_, err := suite.msgServer.PlaceOrders(sdk.WrapSDKContext(handlerCtx), msg)
+	suite.Require().NoError(err)
+
+	depdenencies, err := dexacl.DexPlaceOrdersDependencyGenerator(suite.App.AccessControlKeeper, handlerCtx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NoError(acltypes.ValidateAccessOps(depdenencies))
+	missing := handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, cms.GetEvents())
+	suite.Require().Empty(missing)
+
+	reads := map[sdkacltypes.ResourceType]bool{}
+	for _, dep := range depdenencies {
+		if dep.AccessType == sdkacltypes.AccessType_READ {
+			reads[dep.ResourceType] = true
+		}
+	}
+	suite.Require().True(reads[sdkacltypes.ResourceType_KV_ORACLE_EXCHANGE_RATE])
+	suite.Require().True(reads[sdkacltypes.ResourceType_KV_ORACLE_VOTE_TARGETS])
+	suite.Require().True(reads[sdkacltypes.ResourceType_KV_ORACLE_PRICE_SNAPSHOT])
+}
+
 func (suite *KeeperTestSuite) TestMsgCancelOrder() {
 	suite.PrepareTest()
 	tests := []struct {
@@ -222,6 +280,15 @@ func (suite *KeeperTestSuite) TestMsgCancelOrder() {
 				handlerCtx,
 				tc.msg,
 			)
+			suite.Require().NoError(acltypes.ValidateAccessOps(depdenencies))
+			// the trigger book is only read for the pairs of the cancellations
+			cancellation := tc.msg.Cancellations[0]
+			suite.Require().Contains(depdenencies, dexacl.GetTriggerBookOp(dextypes.TriggerOrderBookPrefix(tc.msg.ContractAddr, cancellation.PriceDenom, cancellation.AssetDenom)))
+			for _, dep := range depdenencies {
+				if dep.AccessType != sdkacltypes.AccessType_COMMIT {
+					suite.Require().NotEqual(aclutils.DefaultIDTemplate, dep.IdentifierTemplate, dep.ResourceType.String())
+				}
+			}
 
 			if !tc.dynamicDep {
 				depdenencies = sdkacltypes.SynchronousAccessOps()
@@ -263,6 +330,20 @@ func TestGeneratorInvalidMessageTypes(t *testing.T) {
 		&oracleVote,
 	)
 	require.Error(t, err)
+
+	_, err = dexacl.DexCancelAllDependencyGenerator(
+		testWrapper.App.AccessControlKeeper,
+		testWrapper.Ctx,
+		&oracleVote,
+	)
+	require.Error(t, err)
+
+	_, err = dexacl.DexCancelReplaceDependencyGenerator(
+		testWrapper.App.AccessControlKeeper,
+		testWrapper.Ctx,
+		&oracleVote,
+	)
+	require.Error(t, err)
 }
 
 func (suite *KeeperTestSuite) TestMsgPlaceOrderGenerator() {
@@ -289,3 +370,110 @@ func (suite *KeeperTestSuite) TestMsgCancelOrderGenerator() {
 	err = acltypes.ValidateAccessOps(accessOps)
 	require.NoError(suite.T(), err)
 }
+
+func (suite *KeeperTestSuite) TestMsgCancelAll() {
+	suite.PrepareTest()
+	tests := []struct {
+		name string
+		msg  *dextypes.MsgCancelAll
+	}{
+		{
+			name: "cancel all orders of a pair",
+			msg: &dextypes.MsgCancelAll{
+				Creator:      suite.creator,
+				ContractAddr: suite.contract,
+				PriceDenom:   keepertest.TestPriceDenom,
+				AssetDenom:   keepertest.TestAssetDenom,
+			},
+		},
+		{
+			name: "cancel all orders of all pairs",
+			msg: &dextypes.MsgCancelAll{
+				Creator:      suite.creator,
+				ContractAddr: suite.contract,
+			},
+		},
+	}
+	for _, tc := range tests {
+		suite.Run(fmt.Sprintf("Test Case: %s", tc.name), func() {
+			goCtx := context.WithValue(suite.Ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(suite.App.GetMemKey(dextypes.MemStoreKey)))
+			suite.Ctx = suite.Ctx.WithContext(goCtx)
+			restingOrder := *suite.msgPlaceOrders.Orders[0]
+			restingOrder.Id = 1
+			restingOrder.Account = suite.creator
+			suite.App.DexKeeper.SetAccountActiveOrder(suite.Ctx, suite.contract, restingOrder)
+			stopOrder := restingOrder
+			stopOrder.Id = 2
+			stopOrder.OrderType = types.OrderType_STOPLIMIT
+			stopOrder.TriggerPrice = sdk.MustNewDecFromStr("10")
+			suite.App.DexKeeper.SetTriggeredOrder(suite.Ctx, suite.contract, stopOrder)
+
+			handlerCtx, cms := aclutils.CacheTxContext(suite.Ctx)
+			_, err := suite.msgServer.CancelAll(
+				sdk.WrapSDKContext(handlerCtx),
+				tc.msg,
+			)
+			suite.Require().NoError(err)
+
+			depdenencies, err := dexacl.DexCancelAllDependencyGenerator(
+				suite.App.AccessControlKeeper,
+				handlerCtx,
+				tc.msg,
+			)
+			suite.Require().NoError(err)
+			suite.Require().NoError(acltypes.ValidateAccessOps(depdenencies))
+			// the cancellation is scoped to the sender and the contract so that it runs in parallel
+			// with other dex messages
+			for _, dep := range depdenencies {
+				if dep.AccessType != sdkacltypes.AccessType_COMMIT {
+					suite.Require().NotEqual(aclutils.DefaultIDTemplate, dep.IdentifierTemplate, dep.ResourceType.String())
+				}
+			}
+
+			missing := handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, cms.GetEvents())
+			suite.Require().Empty(missing)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgCancelReplace() {
+	suite.PrepareTest()
+	goCtx := context.WithValue(suite.Ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(suite.App.GetMemKey(dextypes.MemStoreKey)))
+	suite.Ctx = suite.Ctx.WithContext(goCtx)
+	suite.App.DexKeeper.SetTriggeredOrder(suite.Ctx, suite.contract, types.Order{
+		Id:                1,
+		Account:           suite.creator,
+		ContractAddr:      suite.contract,
+		Price:             sdk.MustNewDecFromStr("10"),
+		Quantity:          sdk.MustNewDecFromStr("10"),
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_STOPLIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.MustNewDecFromStr("10"),
+	})
+	msg := &dextypes.MsgCancelReplace{
+		Creator:      suite.creator,
+		ContractAddr: suite.contract,
+		Cancellation: suite.msgCancelOrders.Cancellations[0],
+		Order:        suite.msgPlaceOrders.Orders[0],
+	}
+
+	handlerCtx, cms := aclutils.CacheTxContext(suite.Ctx)
+	_, err := suite.msgServer.CancelReplace(
+		sdk.WrapSDKContext(handlerCtx),
+		msg,
+	)
+	suite.Require().NoError(err)
+
+	depdenencies, err := dexacl.DexCancelReplaceDependencyGenerator(
+		suite.App.AccessControlKeeper,
+		handlerCtx,
+		msg,
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(acltypes.ValidateAccessOps(depdenencies))
+
+	missing := handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, cms.GetEvents())
+	suite.Require().Empty(missing)
+}
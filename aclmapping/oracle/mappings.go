@@ -18,6 +18,10 @@ var ErrorInvalidMsgType = fmt.Errorf("invalid message received for oracle module
 func GetOracleDependencyGenerator() aclkeeper.DependencyGeneratorMap {
 	dependencyGeneratorMap := make(aclkeeper.DependencyGeneratorMap)
 
+	// prevote
+	prevoteKey := acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRatePrevote{})
+	dependencyGeneratorMap[prevoteKey] = MsgPrevoteDependencyGenerator
+
 	// vote
 	voteKey := acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRateVote{})
 	dependencyGeneratorMap[voteKey] = MsgVoteDependencyGenerator
@@ -25,6 +29,44 @@ func GetOracleDependencyGenerator() aclkeeper.DependencyGeneratorMap {
 	return dependencyGeneratorMap
 }
 
+func MsgPrevoteDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+	msgPrevote, ok := msg.(*oracletypes.MsgAggregateExchangeRatePrevote)
+	if !ok {
+		return []sdkacltypes.AccessOperation{}, ErrorInvalidMsgType
+	}
+	valAddr, _ := sdk.ValAddressFromBech32(msgPrevote.Validator)
+
+	accessOperations := []sdkacltypes.AccessOperation{
+		// validate feeder
+		// read feeder delegation for val addr - READ
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_FEEDERS,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(oracletypes.GetFeederDelegationKey(valAddr)),
+		},
+		// read validator from staking - READ
+		// validator is bonded check - READ
+		// (both covered by below)
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_STAKING_VALIDATOR,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(stakingtypes.GetValidatorKey(valAddr)),
+		},
+
+		// set exchange rate prevote - WRITE
+		// prevotes don't have a dedicated resource type and share the aggregate votes one
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_AGGREGATE_VOTES,
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			IdentifierTemplate: hex.EncodeToString(oracletypes.GetAggregateExchangeRatePrevoteKey(valAddr)),
+		},
+
+		// Last Operation should always be a commit
+		*acltypes.CommitAccessOp(),
+	}
+	return accessOperations, nil
+}
+
 func MsgVoteDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
 	msgVote, ok := msg.(*oracletypes.MsgAggregateExchangeRateVote)
 	if !ok {
@@ -62,9 +104,27 @@ func MsgVoteDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg)
 			AccessType:         sdkacltypes.AccessType_WRITE,
 			IdentifierTemplate: hex.EncodeToString(oracletypes.GetAggregateExchangeRateVoteKey(valAddr)),
 		},
+	}
 
-		// Last Operation should always be a commit
-		*acltypes.CommitAccessOp(),
+	// a vote only reveals a prevote when it carries a salt, as the reveal rejects an empty one
+	if msgVote.Salt != "" {
+		prevoteID := hex.EncodeToString(oracletypes.GetAggregateExchangeRatePrevoteKey(valAddr))
+		// read and consume the revealed prevote - READ, WRITE
+		// prevotes don't have a dedicated resource type and share the aggregate votes one
+		accessOperations = append(accessOperations, []sdkacltypes.AccessOperation{
+			{
+				ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_AGGREGATE_VOTES,
+				AccessType:         sdkacltypes.AccessType_READ,
+				IdentifierTemplate: prevoteID,
+			},
+			{
+				ResourceType:       sdkacltypes.ResourceType_KV_ORACLE_AGGREGATE_VOTES,
+				AccessType:         sdkacltypes.AccessType_WRITE,
+				IdentifierTemplate: prevoteID,
+			},
+		}...)
 	}
-	return accessOperations, nil
+
+	// Last Operation should always be a commit
+	return append(accessOperations, *acltypes.CommitAccessOp()), nil
 }
@@ -1,6 +1,7 @@
 package acloraclemapping_test
 
 import (
+	"encoding/hex"
 	"fmt"
 	"testing"
 	"time"
@@ -125,6 +126,79 @@ func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
 		})
 	}
 }
+func (suite *KeeperTestSuite) TestMsgPrevoteDependencies() {
+	suite.PrepareTest()
+	params := suite.App.OracleKeeper.GetParams(suite.Ctx)
+	params.CommitRevealEnabled = true
+	suite.App.OracleKeeper.SetParams(suite.Ctx, params)
+
+	hash := oracletypes.GetAggregateVoteHash("1", suite.defaultExchangeRate, suite.validator)
+	msg := oracletypes.NewMsgAggregateExchangeRatePrevote(hash, suite.TestAccs[0], suite.validator)
+
+	handlerCtx, cms := utils.CacheTxContext(suite.Ctx)
+	_, err := suite.msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(handlerCtx), msg)
+	suite.Require().NoError(err)
+
+	depdenencies, err := oracleacl.MsgPrevoteDependencyGenerator(suite.App.AccessControlKeeper, handlerCtx, msg)
+	suite.Require().NoError(err)
+
+	missing := handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, cms.GetEvents())
+	suite.Require().Empty(missing)
+}
+
+func (suite *KeeperTestSuite) TestMsgRevealVoteDependencies() {
+	suite.PrepareTest()
+	params := suite.App.OracleKeeper.GetParams(suite.Ctx)
+	params.CommitRevealEnabled = true
+	suite.App.OracleKeeper.SetParams(suite.Ctx, params)
+
+	salt := "1234abcd"
+	hash := oracletypes.GetAggregateVoteHash(salt, suite.defaultExchangeRate, suite.validator)
+	prevote := oracletypes.NewMsgAggregateExchangeRatePrevote(hash, suite.TestAccs[0], suite.validator)
+	_, err := suite.msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(suite.Ctx), prevote)
+	suite.Require().NoError(err)
+
+	// the prevote is revealed in the next vote period
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + int64(params.VotePeriod))
+	vote := &oracletypes.MsgAggregateExchangeRateVote{
+		ExchangeRates: suite.defaultExchangeRate,
+		Feeder:        suite.TestAccs[0].String(),
+		Validator:     suite.validator.String(),
+		Salt:          salt,
+	}
+	handlerCtx, cms := utils.CacheTxContext(suite.Ctx)
+	_, err = suite.msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(handlerCtx), vote)
+	suite.Require().NoError(err)
+
+	depdenencies, err := oracleacl.MsgVoteDependencyGenerator(suite.App.AccessControlKeeper, handlerCtx, vote)
+	suite.Require().NoError(err)
+
+	missing := handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, cms.GetEvents())
+	suite.Require().Empty(missing)
+}
+
+func TestMsgPrevoteDependencyGenerator(t *testing.T) {
+	tm := time.Now().UTC()
+	valPub := secp256k1.GenPrivKey().PubKey()
+
+	testWrapper := app.NewTestWrapper(t, tm, valPub)
+
+	oraclePrevote := oracletypes.MsgAggregateExchangeRatePrevote{
+		Hash:      "hash",
+		Feeder:    "test",
+		Validator: "validator",
+	}
+
+	accessOps, err := oracleacl.MsgPrevoteDependencyGenerator(testWrapper.App.AccessControlKeeper, testWrapper.Ctx, &oraclePrevote)
+	require.NoError(t, err)
+	err = acltypes.ValidateAccessOps(accessOps)
+	require.NoError(t, err)
+	requirePrevoteOps(t, accessOps, []sdkacltypes.AccessType{sdkacltypes.AccessType_WRITE})
+
+	_, err = oracleacl.MsgPrevoteDependencyGenerator(testWrapper.App.AccessControlKeeper, testWrapper.Ctx, &banktypes.MsgSend{})
+	require.Error(t, err)
+}
+
 func TestMsgVoteDependencyGenerator(t *testing.T) {
 	tm := time.Now().UTC()
 	valPub := secp256k1.GenPrivKey().PubKey()
@@ -141,6 +215,30 @@ func TestMsgVoteDependencyGenerator(t *testing.T) {
 	require.NoError(t, err)
 	err = acltypes.ValidateAccessOps(accessOps)
 	require.NoError(t, err)
+	// a vote without a salt doesn't touch any prevote
+	requirePrevoteOps(t, accessOps, nil)
+
+	oracleVote.Salt = "1234abcd"
+	accessOps, err = oracleacl.MsgVoteDependencyGenerator(testWrapper.App.AccessControlKeeper, testWrapper.Ctx, &oracleVote)
+	require.NoError(t, err)
+	err = acltypes.ValidateAccessOps(accessOps)
+	require.NoError(t, err)
+	requirePrevoteOps(t, accessOps, []sdkacltypes.AccessType{sdkacltypes.AccessType_READ, sdkacltypes.AccessType_WRITE})
+}
+
+// requirePrevoteOps checks that the prevote is only accessed through its exact key with the
+// expected access types, and that nothing is declared on the whole oracle store
+func requirePrevoteOps(t *testing.T, accessOps []sdkacltypes.AccessOperation, expected []sdkacltypes.AccessType) {
+	prevoteID := hex.EncodeToString(oracletypes.GetAggregateExchangeRatePrevoteKey(sdk.ValAddress{}))
+	prevoteAccessTypes := []sdkacltypes.AccessType{}
+	for _, op := range accessOps {
+		require.NotEqual(t, sdkacltypes.ResourceType_KV_ORACLE, op.ResourceType)
+		if op.IdentifierTemplate == prevoteID {
+			require.Equal(t, sdkacltypes.ResourceType_KV_ORACLE_AGGREGATE_VOTES, op.ResourceType)
+			prevoteAccessTypes = append(prevoteAccessTypes, op.AccessType)
+		}
+	}
+	require.ElementsMatch(t, expected, prevoteAccessTypes)
 }
 
 func TestMsgVoteDependencyGeneratorInvalidMsgType(t *testing.T) {
@@ -154,9 +252,12 @@ func TestMsgVoteDependencyGeneratorInvalidMsgType(t *testing.T) {
 
 func TestOracleDependencyGenerator(t *testing.T) {
 	oracleDependencyGenerator := oracleacl.GetOracleDependencyGenerator()
-	// verify that there's one entry, for oracle aggregate vote
-	require.Equal(t, 1, len(oracleDependencyGenerator))
+	// verify that there are two entries, for oracle aggregate prevote and vote
+	require.Equal(t, 2, len(oracleDependencyGenerator))
+	// check that oracle prevote dep generator is in the map
+	_, ok := oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRatePrevote{})]
+	require.True(t, ok)
 	// check that oracle vote dep generator is in the map
-	_, ok := oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRateVote{})]
+	_, ok = oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRateVote{})]
 	require.True(t, ok)
 }
@@ -95,9 +95,11 @@ var StoreKeyToResourceTypePrefixMap = aclsdktypes.StoreKeyToResourceTypePrefixMa
 		aclsdktypes.ResourceType_KV_FEEGRANT_ALLOWANCE: feegranttypes.FeeAllowanceKeyPrefix,
 	},
 	oracletypes.StoreKey: {
-		aclsdktypes.ResourceType_KV_ORACLE:                      aclsdktypes.EmptyPrefix,
-		aclsdktypes.ResourceType_KV_ORACLE_VOTE_TARGETS:         oracletypes.VoteTargetKey,
-		aclsdktypes.ResourceType_KV_ORACLE_AGGREGATE_VOTES:      oracletypes.AggregateExchangeRateVoteKey,
+		aclsdktypes.ResourceType_KV_ORACLE:              aclsdktypes.EmptyPrefix,
+		aclsdktypes.ResourceType_KV_ORACLE_VOTE_TARGETS: oracletypes.VoteTargetKey,
+		// aggregate votes also cover aggregate prevotes, which don't have a dedicated resource type,
+		// so identifiers are the full vote or prevote keys
+		aclsdktypes.ResourceType_KV_ORACLE_AGGREGATE_VOTES:      aclsdktypes.EmptyPrefix,
 		aclsdktypes.ResourceType_KV_ORACLE_FEEDERS:              oracletypes.FeederDelegationKey,
 		aclsdktypes.ResourceType_KV_ORACLE_PRICE_SNAPSHOT:       oracletypes.PriceSnapshotKey,
 		aclsdktypes.ResourceType_KV_ORACLE_EXCHANGE_RATE:        oracletypes.ExchangeRateKey,
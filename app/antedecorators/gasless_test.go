@@ -200,6 +200,47 @@ func TestOracleVoteGasless(t *testing.T) {
 	require.True(t, gasless)
 }
 
+func TestOraclePrevoteGasless(t *testing.T) {
+	input := oraclekeeper.CreateTestInput(t)
+
+	addr := oraclekeeper.Addrs[0]
+	addr1 := oraclekeeper.Addrs[1]
+	valAddr, val := oraclekeeper.ValAddrs[0], oraclekeeper.ValPubKeys[0]
+	valAddr1, val1 := oraclekeeper.ValAddrs[1], oraclekeeper.ValPubKeys[1]
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx.WithIsCheckTx(true)
+
+	// Validator created
+	_, err := sh(ctx, oraclekeeper.NewTestMsgCreateValidator(valAddr, val, amt))
+	require.NoError(t, err)
+	_, err = sh(ctx, oraclekeeper.NewTestMsgCreateValidator(valAddr1, val1, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	// validator 0 already prevoted in the current vote period
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(ctx, valAddr, oracletypes.AggregateExchangeRatePrevote{SubmitBlock: uint64(ctx.BlockHeight())})
+
+	prevote1 := oracletypes.MsgAggregateExchangeRatePrevote{
+		Feeder:    addr.String(),
+		Validator: valAddr.String(),
+	}
+
+	prevote2 := oracletypes.MsgAggregateExchangeRatePrevote{
+		Feeder:    addr1.String(),
+		Validator: valAddr1.String(),
+	}
+
+	err = CallGaslessDecoratorWithMsg(ctx, &prevote1, input.OracleKeeper)
+	require.Error(t, err)
+
+	// reset gasless
+	gasless = true
+	err = CallGaslessDecoratorWithMsg(ctx, &prevote2, input.OracleKeeper)
+	require.NoError(t, err)
+	require.True(t, gasless)
+}
+
 func TestDexPlaceOrderGasless(t *testing.T) {
 	// this needs to be updated if its changed from constant true
 	// reset gasless
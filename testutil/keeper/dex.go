@@ -165,6 +165,16 @@ func SeedPriceSnapshot(ctx sdk.Context, k *keeper.Keeper, price string, timestam
 	k.SetPriceState(ctx, priceSnapshot, TestContract)
 }
 
+func SeedVolumeSnapshot(ctx sdk.Context, k *keeper.Keeper, quantity string, notional string, timestamp uint64) {
+	volumeSnapshot := types.Volume{
+		SnapshotTimestampInSeconds: timestamp,
+		Quantity:                   sdk.MustNewDecFromStr(quantity),
+		Notional:                   sdk.MustNewDecFromStr(notional),
+		Pair:                       &TestPair,
+	}
+	k.SetVolumeState(ctx, volumeSnapshot, TestContract)
+}
+
 func CreateNLongBook(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.LongBook {
 	items := make([]types.LongBook, n)
 	for i := range items {
@@ -41,8 +41,19 @@ func (qp QueryPlugin) HandleOracleQuery(ctx sdk.Context, queryData json.RawMessa
 		return nil, oracletypes.ErrParsingOracleQuery
 	}
 	switch {
+	case parsedQuery.ExchangeRate != nil:
+		res, err := qp.oracleHandler.GetExchangeRate(ctx, parsedQuery.ExchangeRate)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingExchangeRates
+		}
+
+		return bz, nil
 	case parsedQuery.ExchangeRates != nil:
-		res, err := qp.oracleHandler.GetExchangeRates(ctx)
+		res, err := qp.oracleHandler.GetExchangeRates(ctx, parsedQuery.ExchangeRates)
 		if err != nil {
 			return nil, err
 		}
@@ -62,6 +73,28 @@ func (qp QueryPlugin) HandleOracleQuery(ctx sdk.Context, queryData json.RawMessa
 			return nil, oracletypes.ErrEncodingOracleTwaps
 		}
 
+		return bz, nil
+	case parsedQuery.PriceHistory != nil:
+		res, err := qp.oracleHandler.GetPriceHistory(ctx, parsedQuery.PriceHistory)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingPriceHistory
+		}
+
+		return bz, nil
+	case parsedQuery.HistoricalTwaps != nil:
+		res, err := qp.oracleHandler.GetHistoricalTwaps(ctx, parsedQuery.HistoricalTwaps)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingOracleTwaps
+		}
+
 		return bz, nil
 	default:
 		return nil, oracletypes.ErrUnknownSeiOracleQuery
@@ -117,6 +150,17 @@ func (qp QueryPlugin) HandleDexQuery(ctx sdk.Context, queryData json.RawMessage)
 			return nil, dextypes.ErrEncodingOrderSimulation
 		}
 
+		return bz, nil
+	case parsedQuery.GetOrderSimulations != nil:
+		res, err := qp.dexHandler.GetOrderSimulations(ctx, parsedQuery.GetOrderSimulations)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, dextypes.ErrEncodingOrderSimulations
+		}
+
 		return bz, nil
 	case parsedQuery.GetLatestPrice != nil:
 		res, err := qp.dexHandler.GetLatestPrice(ctx, parsedQuery.GetLatestPrice)
@@ -128,6 +172,39 @@ func (qp QueryPlugin) HandleDexQuery(ctx sdk.Context, queryData json.RawMessage)
 			return nil, dextypes.ErrEncodingLatestPrice
 		}
 
+		return bz, nil
+	case parsedQuery.GetCandles != nil:
+		res, err := qp.dexHandler.GetCandles(ctx, parsedQuery.GetCandles)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, dextypes.ErrEncodingCandles
+		}
+
+		return bz, nil
+	case parsedQuery.GetAccountTrades != nil:
+		res, err := qp.dexHandler.GetAccountTrades(ctx, parsedQuery.GetAccountTrades)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, dextypes.ErrEncodingAccountTrades
+		}
+
+		return bz, nil
+	case parsedQuery.GetOrderBookDepth != nil:
+		res, err := qp.dexHandler.GetOrderBookDepth(ctx, parsedQuery.GetOrderBookDepth)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, dextypes.ErrEncodingOrderBookDepth
+		}
+
 		return bz, nil
 	default:
 		return nil, dextypes.ErrUnknownSeiDexQuery
@@ -112,6 +112,59 @@ func TestWasmGetOracleExchangeRates(t *testing.T) {
 	err = json.Unmarshal(res, &parsedRes2)
 	require.NoError(t, err)
 	require.Equal(t, oracletypes.QueryExchangeRatesResponse{DenomOracleExchangeRatePairs: oracletypes.DenomOracleExchangeRatePairs{oracletypes.NewDenomOracleExchangeRatePair(oracleutils.MicroAtomDenom, sdk.NewDec(12), sdk.NewInt(11), testWrapper.Ctx.BlockTime().UnixMilli())}}, parsedRes2)
+
+	// the rate goes stale past the max staleness of the denom, which strict queries refuse
+	testWrapper.App.OracleKeeper.SetVoteTargetDenom(testWrapper.Ctx, oracletypes.Denom{Name: oracleutils.MicroAtomDenom, MaxStalenessBlocks: 5})
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(20)
+
+	res, err = customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes3 oracletypes.QueryExchangeRatesResponse
+	err = json.Unmarshal(res, &parsedRes3)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), parsedRes3.DenomOracleExchangeRatePairs[0].AgeBlocks)
+	require.True(t, parsedRes3.DenomOracleExchangeRatePairs[0].Stale)
+
+	req = oraclebinding.SeiOracleQuery{ExchangeRates: &oracletypes.QueryExchangeRatesRequest{Strict: true}}
+	queryData, err = json.Marshal(req)
+	require.NoError(t, err)
+	rawQuery, err = json.Marshal(wasmbinding.SeiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData})
+	require.NoError(t, err)
+
+	_, err = customQuerier(testWrapper.Ctx, rawQuery)
+	require.ErrorIs(t, err, oracletypes.ErrStaleExchangeRate)
+}
+
+func TestWasmGetOracleExchangeRate(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(11)
+	testWrapper.App.OracleKeeper.SetBaseExchangeRate(testWrapper.Ctx, oracleutils.MicroAtomDenom, sdk.NewDec(12))
+	testWrapper.App.OracleKeeper.SetVoteTargetDenom(testWrapper.Ctx, oracletypes.Denom{Name: oracleutils.MicroAtomDenom, MaxStalenessBlocks: 5})
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(20)
+	testWrapper.App.OracleKeeper.SetBaseExchangeRate(testWrapper.Ctx, oracleutils.MicroEthDenom, sdk.NewDec(1500))
+
+	exchangeRateQuery := func(denom string) []byte {
+		req := oraclebinding.SeiOracleQuery{ExchangeRate: &oracletypes.QueryExchangeRateRequest{Denom: denom, Strict: true}}
+		queryData, err := json.Marshal(req)
+		require.NoError(t, err)
+		rawQuery, err := json.Marshal(wasmbinding.SeiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData})
+		require.NoError(t, err)
+		return rawQuery
+	}
+
+	// a strict query of a fresh rate succeeds even though another denom is stale
+	res, err := customQuerier(testWrapper.Ctx, exchangeRateQuery(oracleutils.MicroEthDenom))
+	require.NoError(t, err)
+	var parsedRes oracletypes.QueryExchangeRateResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1500), parsedRes.OracleExchangeRate.ExchangeRate)
+	require.False(t, parsedRes.Stale)
+
+	_, err = customQuerier(testWrapper.Ctx, exchangeRateQuery(oracleutils.MicroAtomDenom))
+	require.ErrorIs(t, err, oracletypes.ErrStaleExchangeRate)
 }
 
 func TestWasmGetOracleTwaps(t *testing.T) {
@@ -178,6 +231,49 @@ func TestWasmGetOracleTwapsErrorHandling(t *testing.T) {
 	require.Equal(t, err, oracletypes.ErrInvalidTwapLookback)
 }
 
+func TestWasmGetOraclePriceHistory(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(11).WithBlockTime(time.Unix(3600, 0))
+	atomRate := oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(20), LastUpdate: sdk.NewInt(10)}
+	testWrapper.App.OracleKeeper.AddPriceSnapshot(testWrapper.Ctx, oracletypes.PriceSnapshot{SnapshotTimestamp: 3600, PriceSnapshotItems: oracletypes.PriceSnapshotItems{
+		oracletypes.NewPriceSnapshotItem(oracleutils.MicroAtomDenom, atomRate),
+	}})
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(14).WithBlockTime(time.Unix(3700, 0))
+
+	req := oraclebinding.SeiOracleQuery{PriceHistory: &oracletypes.QueryPriceHistoryRequest{Denom: oracleutils.MicroAtomDenom, StartTimestamp: 3000}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	rawQuery, err := json.Marshal(wasmbinding.SeiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData})
+	require.NoError(t, err)
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes oracletypes.QueryPriceHistoryResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, []oracletypes.PriceHistoryEntry{{SnapshotTimestamp: 3600, OracleExchangeRate: atomRate}}, parsedRes.PriceHistory)
+
+	// the window of the historical twap ends before the block time
+	req = oraclebinding.SeiOracleQuery{HistoricalTwaps: &oracletypes.QueryHistoricalTwapsRequest{EndTimestamp: 3650, LookbackSeconds: 100}}
+	queryData, err = json.Marshal(req)
+	require.NoError(t, err)
+	rawQuery, err = json.Marshal(wasmbinding.SeiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData})
+	require.NoError(t, err)
+
+	testWrapper.App.OracleKeeper.SetVoteTarget(testWrapper.Ctx, oracleutils.MicroAtomDenom)
+	res, err = customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes2 oracletypes.QueryHistoricalTwapsResponse
+	err = json.Unmarshal(res, &parsedRes2)
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.OracleTwaps{
+		oracletypes.OracleTwap{Denom: oracleutils.MicroAtomDenom, Twap: sdk.NewDec(20), LookbackSeconds: 50},
+	}, parsedRes2.OracleTwaps)
+}
+
 func TestWasmGetDexTwaps(t *testing.T) {
 	testWrapper, customQuerier := SetupWasmbindingTest(t)
 
@@ -288,6 +384,172 @@ func TestWasmGetOrderSimulation(t *testing.T) {
 	require.Equal(t, sdk.NewDec(0), *parsedRes.ExecutedQuantity)
 }
 
+func TestWasmGetOrderSimulations(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	order := dextypes.Order{
+		PositionDirection: dextypes.PositionDirection_LONG,
+		OrderType:         dextypes.OrderType_LIMIT,
+		PriceDenom:        "sei",
+		AssetDenom:        "atom",
+		Price:             sdk.MustNewDecFromStr("10"),
+		Quantity:          sdk.NewDec(2),
+	}
+	testWrapper.App.DexKeeper.SetShortBook(testWrapper.Ctx, app.TestContract, dextypes.ShortBook{
+		Price: sdk.NewDec(9),
+		Entry: &dextypes.OrderEntry{
+			Price:      sdk.NewDec(9),
+			Quantity:   sdk.NewDec(3),
+			PriceDenom: "sei",
+			AssetDenom: "atom",
+		},
+	})
+
+	req := dexbinding.SeiDexQuery{GetOrderSimulations: &dextypes.QueryOrderSimulationsRequest{
+		Orders:       []*dextypes.Order{&order, &order},
+		ContractAddr: app.TestContract,
+	}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.DexRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithContext(context.WithValue(testWrapper.Ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(testWrapper.App.GetMemKey(dextypes.MemStoreKey))))
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes dextypes.QueryOrderSimulationsResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(parsedRes.Results))
+	require.Equal(t, sdk.NewDec(2), *parsedRes.Results[0].ExecutedQuantity)
+	require.Equal(t, sdk.NewDec(9), *parsedRes.Results[0].AverageFillPrice)
+	require.Equal(t, sdk.OneDec(), *parsedRes.Results[1].ExecutedQuantity)
+	require.Equal(t, sdk.OneDec(), *parsedRes.Results[1].RemainingQuantity)
+}
+
+func TestWasmGetOrderBookDepth(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := dexbinding.SeiDexQuery{GetOrderBookDepth: &dextypes.QueryGetOrderBookDepthRequest{
+		ContractAddr: app.TestContract,
+		PriceDenom:   "sei",
+		AssetDenom:   "atom",
+		Levels:       5,
+	}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.DexRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	testWrapper.App.DexKeeper.SetLongBook(testWrapper.Ctx, app.TestContract, dextypes.LongBook{
+		Price: sdk.NewDec(9),
+		Entry: &dextypes.OrderEntry{
+			Price:      sdk.NewDec(9),
+			Quantity:   sdk.NewDec(3),
+			PriceDenom: "sei",
+			AssetDenom: "atom",
+		},
+	})
+	testWrapper.App.DexKeeper.SetShortBook(testWrapper.Ctx, app.TestContract, dextypes.ShortBook{
+		Price: sdk.NewDec(10),
+		Entry: &dextypes.OrderEntry{
+			Price:      sdk.NewDec(10),
+			Quantity:   sdk.NewDec(2),
+			PriceDenom: "sei",
+			AssetDenom: "atom",
+		},
+	})
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes dextypes.QueryGetOrderBookDepthResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(parsedRes.Bids))
+	require.Equal(t, sdk.NewDec(3), parsedRes.Bids[0].Quantity)
+	require.Equal(t, 1, len(parsedRes.Asks))
+	require.Equal(t, sdk.NewDec(10), parsedRes.Asks[0].Price)
+	require.Equal(t, sdk.OneDec(), *parsedRes.Spread)
+}
+
+func TestWasmGetCandles(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := dexbinding.SeiDexQuery{GetCandles: &dextypes.QueryGetCandlesRequest{
+		ContractAddr: app.TestContract,
+		PriceDenom:   "sei",
+		AssetDenom:   "atom",
+		Interval:     dextypes.CandleInterval_ONE_MINUTE,
+		NumOfCandles: 10,
+	}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.DexRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	pair := dextypes.Pair{PriceDenom: "sei", AssetDenom: "atom"}
+	testWrapper.App.DexKeeper.UpdateCandles(testWrapper.Ctx, app.TestContract, pair, 3600, sdk.NewDec(20), sdk.NewDec(1))
+	testWrapper.App.DexKeeper.UpdateCandles(testWrapper.Ctx, app.TestContract, pair, 3610, sdk.NewDec(22), sdk.NewDec(2))
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes dextypes.QueryGetCandlesResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(parsedRes.Candles))
+	require.Equal(t, sdk.NewDec(20), *parsedRes.Candles[0].Open)
+	require.Equal(t, sdk.NewDec(22), *parsedRes.Candles[0].Close)
+	require.Equal(t, sdk.NewDec(3), *parsedRes.Candles[0].Volume)
+}
+
+func TestWasmGetAccountTrades(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := dexbinding.SeiDexQuery{GetAccountTrades: &dextypes.QueryGetAccountTradesRequest{
+		ContractAddr: app.TestContract,
+		Account:      app.TestUser,
+	}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.DexRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	testWrapper.App.DexKeeper.AddAccountTrades(testWrapper.Ctx, app.TestContract, []*dextypes.SettlementEntry{
+		{
+			Account:                app.TestUser,
+			PriceDenom:             "sei",
+			AssetDenom:             "atom",
+			Quantity:               sdk.NewDec(2),
+			ExecutionCostOrProceed: sdk.NewDec(20),
+			ExpectedCostOrProceed:  sdk.NewDec(20),
+			OrderId:                1,
+			Height:                 uint64(testWrapper.Ctx.BlockHeight()),
+			Timestamp:              uint64(testWrapper.Ctx.BlockTime().Unix()),
+		},
+	})
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes dextypes.QueryGetAccountTradesResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(parsedRes.Trades))
+	require.Equal(t, uint64(1), parsedRes.Trades[0].OrderId)
+	require.Equal(t, sdk.NewDec(2), parsedRes.Trades[0].Quantity)
+}
+
 func TestWasmGetEpoch(t *testing.T) {
 	testWrapper, customQuerier := SetupWasmbindingTest(t)
 
@@ -1,6 +1,7 @@
 package dex
 
 import (
+	"encoding/hex"
 	"errors"
 	"math/big"
 
@@ -14,7 +15,7 @@ import (
 )
 
 // TickSizeMultipleDecorator check if the place order tx's price is multiple of
-// tick size
+// tick size, and within the oracle price band of the pair for limit orders
 type TickSizeMultipleDecorator struct {
 	dexKeeper keeper.Keeper
 }
@@ -45,50 +46,65 @@ func (tsmd TickSizeMultipleDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 // CheckTickSizeMultiple checks whether the msgs comply with ticksize
 func (tsmd TickSizeMultipleDecorator) CheckTickSizeMultiple(ctx sdk.Context, msgs []sdk.Msg) error {
 	for _, msg := range msgs {
-		switch msg.(type) { //nolint:gocritic,gosimple // the linter is telling us we can make this faster, and this should be addressed later.
+		switch m := msg.(type) {
 		case *types.MsgPlaceOrders:
-			msgPlaceOrders := msg.(*types.MsgPlaceOrders) //nolint:gosimple // the linter is telling us we can make this faster, and this should be addressed later.
-			contractAddr := msgPlaceOrders.ContractAddr
-			for _, order := range msgPlaceOrders.Orders {
-				priceTickSize, found := tsmd.dexKeeper.GetPriceTickSizeForPair(ctx, contractAddr,
-					types.Pair{
-						PriceDenom: order.PriceDenom,
-						AssetDenom: order.AssetDenom,
-					})
-				if !found {
-					return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no price ticksize configured", order.PriceDenom, order.AssetDenom)
-				}
-				if !IsDecimalMultipleOf(order.Price, priceTickSize) {
-					// Allow Market Orders with Price 0
-					if !(IsMarketOrder(order) && order.Price.IsZero()) {
-						return sdkerrors.Wrapf(errors.New("ErrPriceNotMultipleOfTickSize"), "price needs to be non-zero and multiple of price tick size")
-					}
-				}
-				quantityTickSize, found := tsmd.dexKeeper.GetQuantityTickSizeForPair(ctx, contractAddr,
-					types.Pair{
-						PriceDenom: order.PriceDenom,
-						AssetDenom: order.AssetDenom,
-					})
-				if !found {
-					return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no quantity ticksize configured", order.PriceDenom, order.AssetDenom)
-				}
-				if !IsDecimalMultipleOf(order.Quantity, quantityTickSize) {
-					return sdkerrors.Wrapf(errors.New("ErrQuantityNotMultipleOfTickSize"), "quantity needs to be non-zero and multiple of quantity tick size")
-				}
+			if err := tsmd.checkOrders(ctx, m.ContractAddr, m.Orders); err != nil {
+				return err
+			}
+		case *types.MsgCancelReplace:
+			if err := tsmd.checkOrders(ctx, m.ContractAddr, m.GetPlaceOrders().Orders); err != nil {
+				return err
 			}
-			continue
 		default:
 			// e.g. liquidation order don't come with price so always pass this check
-			return nil
+			continue
 		}
 	}
 
 	return nil
 }
 
-// Check whether order is market order type
+func (tsmd TickSizeMultipleDecorator) checkOrders(ctx sdk.Context, contractAddr string, orders []*types.Order) error {
+	oraclePriceBands := tsmd.dexKeeper.NewOraclePriceBands(contractAddr)
+	for _, order := range orders {
+		if order == nil {
+			continue
+		}
+		priceTickSize, found := tsmd.dexKeeper.GetPriceTickSizeForPair(ctx, contractAddr,
+			types.Pair{
+				PriceDenom: order.PriceDenom,
+				AssetDenom: order.AssetDenom,
+			})
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no price ticksize configured", order.PriceDenom, order.AssetDenom)
+		}
+		if !IsDecimalMultipleOf(order.Price, priceTickSize) {
+			// Allow Market Orders with Price 0
+			if !(IsMarketOrder(order) && order.Price.IsZero()) {
+				return sdkerrors.Wrapf(errors.New("ErrPriceNotMultipleOfTickSize"), "price needs to be non-zero and multiple of price tick size")
+			}
+		}
+		quantityTickSize, found := tsmd.dexKeeper.GetQuantityTickSizeForPair(ctx, contractAddr,
+			types.Pair{
+				PriceDenom: order.PriceDenom,
+				AssetDenom: order.AssetDenom,
+			})
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no quantity ticksize configured", order.PriceDenom, order.AssetDenom)
+		}
+		if !IsDecimalMultipleOf(order.Quantity, quantityTickSize) {
+			return sdkerrors.Wrapf(errors.New("ErrQuantityNotMultipleOfTickSize"), "quantity needs to be non-zero and multiple of quantity tick size")
+		}
+		if err := oraclePriceBands.ValidateOrder(ctx, order); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check whether order is market order type. Stop loss orders become market orders once triggered.
 func IsMarketOrder(order *types.Order) bool {
-	return order.OrderType == types.OrderType_MARKET || order.OrderType == types.OrderType_FOKMARKET || order.OrderType == types.OrderType_FOKMARKETBYVALUE
+	return order.OrderType == types.OrderType_MARKET || order.OrderType == types.OrderType_FOKMARKET || order.OrderType == types.OrderType_FOKMARKETBYVALUE || order.OrderType == types.OrderType_STOPLOSS
 }
 
 // Check whether decimal a is multiple of decimal b
@@ -138,6 +154,16 @@ func (d CheckDexGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		case *types.MsgCancelOrders:
 			numDependencies := len(memState.GetContractToDependencies(ctx, m.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
 			dexGasRequired += params.DefaultGasPerCancel * uint64(len(m.Cancellations)*numDependencies)
+		case *types.MsgCancelReplace:
+			numDependencies := len(memState.GetContractToDependencies(ctx, m.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
+			dexGasRequired += (params.DefaultGasPerOrder + params.DefaultGasPerCancel) * uint64(numDependencies)
+			if m.Order != nil {
+				dexGasRequired += params.DefaultGasPerOrderDataByte * uint64(len(m.Order.Data))
+			}
+		case *types.MsgCancelAll:
+			// every order cancelled by the message is sent to the contract like an individual cancellation
+			numDependencies := len(memState.GetContractToDependencies(ctx, m.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
+			dexGasRequired += params.DefaultGasPerCancel * uint64(len(d.dexKeeper.GetOrdersToCancelAll(ctx, m))*numDependencies)
 		}
 	}
 	if dexGasRequired == 0 {
@@ -160,8 +186,8 @@ func (d CheckDexGasDecorator) AnteDeps(txDeps []sdkacltypes.AccessOperation, tx
 	deps := []sdkacltypes.AccessOperation{}
 	for _, msg := range tx.GetMsgs() {
 		// Error checking will be handled in AnteHandler
-		switch msg.(type) {
-		case *types.MsgPlaceOrders, *types.MsgCancelOrders:
+		switch m := msg.(type) {
+		case *types.MsgPlaceOrders, *types.MsgCancelOrders, *types.MsgCancelReplace, *types.MsgCancelAll:
 			deps = append(deps, []sdkacltypes.AccessOperation{
 				// read the dex contract info
 				{
@@ -170,9 +196,47 @@ func (d CheckDexGasDecorator) AnteDeps(txDeps []sdkacltypes.AccessOperation, tx
 					IdentifierTemplate: "*",
 				},
 			}...)
+			if cancelAll, ok := m.(*types.MsgCancelAll); ok {
+				deps = append(deps, cancelAllOrdersDeps(cancelAll)...)
+			}
 		default:
 			continue
 		}
 	}
 	return next(append(txDeps, deps...), tx, txIndex)
 }
+
+// cancelAllOrdersDeps are the reads needed to find the orders cancelled by a MsgCancelAll
+func cancelAllOrdersDeps(msg *types.MsgCancelAll) []sdkacltypes.AccessOperation {
+	if pair, ok := msg.GetPair(); ok {
+		return []sdkacltypes.AccessOperation{
+			{
+				ResourceType:       sdkacltypes.ResourceType_KV_DEX_ACCOUNT_ACTIVE_ORDERS,
+				AccessType:         sdkacltypes.AccessType_READ,
+				IdentifierTemplate: hex.EncodeToString(types.AccountActiveOrdersPrefix(msg.ContractAddr, pair.PriceDenom, pair.AssetDenom, msg.Creator)),
+			},
+			{
+				ResourceType:       sdkacltypes.ResourceType_KV_DEX_PAIR_PREFIX,
+				AccessType:         sdkacltypes.AccessType_READ,
+				IdentifierTemplate: hex.EncodeToString(types.TriggerOrderBookPrefix(msg.ContractAddr, pair.PriceDenom, pair.AssetDenom)),
+			},
+		}
+	}
+	return []sdkacltypes.AccessOperation{
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_REGISTERED_PAIR,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(types.RegisteredPairPrefix(msg.ContractAddr)),
+		},
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_ACCOUNT_ACTIVE_ORDERS,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(types.AccountActiveOrdersAccountPrefix(msg.ContractAddr, msg.Creator)),
+		},
+		{
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_PAIR_PREFIX,
+			AccessType:         sdkacltypes.AccessType_READ,
+			IdentifierTemplate: hex.EncodeToString(types.TriggerOrderBookContractPrefix(msg.ContractAddr)),
+		},
+	}
+}
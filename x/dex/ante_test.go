@@ -4,12 +4,15 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkacltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+	aclutils "github.com/sei-protocol/sei-chain/aclmapping/utils"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex"
 	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 type TestTx struct {
@@ -125,6 +128,107 @@ func TestCheckDexGasDecorator(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestCheckDexGasDecoratorCancelReplaceAndCancelAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	decorator := dex.NewCheckDexGasDecorator(*keeper, dexcache.NewMemState(keeper.GetMemStoreKey()))
+	terminator := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) { return ctx, nil }
+
+	// a cancel-replace pays for both the cancellation and the placed order
+	cancelReplace := types.NewMsgCancelReplace("someone", keepertest.TestContract, &types.Cancellation{}, &types.Order{Data: "data"}, sdk.NewCoins())
+	tx := TestTx{
+		msgs: []sdk.Msg{cancelReplace},
+		fee:  sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10811))),
+	}
+	_, err := decorator.AnteHandle(ctx, tx, false, terminator)
+	require.NotNil(t, err)
+	tx.fee = sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10812)))
+	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
+	require.Nil(t, err)
+
+	// a cancel-all pays for every order it cancels
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	for i := uint64(1); i <= 2; i++ {
+		keeper.SetAccountActiveOrder(ctx, keepertest.TestContract, types.Order{
+			Id:                i,
+			Account:           "someone",
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+			PositionDirection: types.PositionDirection_LONG,
+		})
+	}
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, types.Order{
+		Id:                3,
+		Account:           "someone",
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		PositionDirection: types.PositionDirection_SHORT,
+	})
+	cancelAll := types.NewMsgCancelAll("someone", keepertest.TestContract, "", "", nil)
+	tx = TestTx{
+		msgs: []sdk.Msg{cancelAll},
+		fee:  sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(15899))),
+	}
+	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
+	require.NotNil(t, err)
+	tx.fee = sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(15900)))
+	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
+	require.Nil(t, err)
+
+	// only the orders in the cancelled directions are charged
+	cancelAll = types.NewMsgCancelAll("someone", keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, []types.PositionDirection{types.PositionDirection_LONG})
+	tx = TestTx{
+		msgs: []sdk.Msg{cancelAll},
+		fee:  sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10600))),
+	}
+	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
+	require.Nil(t, err)
+}
+
+func TestCheckDexGasDecoratorAnteDeps(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	decorator := dex.NewCheckDexGasDecorator(*keeper, dexcache.NewMemState(keeper.GetMemStoreKey()))
+	anteHandler, depGen := sdk.ChainAnteDecorators(decorator)
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	keeper.SetAccountActiveOrder(ctx, keepertest.TestContract, types.Order{
+		Id:         1,
+		Account:    keepertest.TestAccount,
+		PriceDenom: keepertest.TestPriceDenom,
+		AssetDenom: keepertest.TestAssetDenom,
+	})
+
+	for _, tc := range []struct {
+		msg              sdk.Msg
+		readsOrdersIndex bool
+	}{
+		{types.NewMsgCancelAll(keepertest.TestAccount, keepertest.TestContract, "", "", nil), true},
+		{types.NewMsgCancelAll(keepertest.TestAccount, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, nil), true},
+		{types.NewMsgCancelReplace(keepertest.TestAccount, keepertest.TestContract, &types.Cancellation{}, &types.Order{}, sdk.NewCoins()), false},
+	} {
+		msg := tc.msg
+		msCache := ctx.MultiStore().CacheMultiStore()
+		txCtx := ctx.WithMultiStore(msCache).WithMsgValidator(sdkacltypes.NewMsgValidator(aclutils.StoreKeyToResourceTypePrefixMap))
+		tx := TestTx{
+			msgs: []sdk.Msg{msg},
+			fee:  sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1000000))),
+		}
+		_, err := anteHandler(txCtx, tx, false)
+		require.NoError(t, err)
+
+		deps, err := depGen([]sdkacltypes.AccessOperation{}, tx, 0)
+		require.NoError(t, err)
+		missing := txCtx.MsgValidator().ValidateAccessOperations(deps, msCache.GetEvents())
+		require.Empty(t, missing)
+
+		readsContract, readsOrdersIndex := false, false
+		for _, dep := range deps {
+			readsContract = readsContract || dep.ResourceType == sdkacltypes.ResourceType_KV_DEX_CONTRACT
+			readsOrdersIndex = readsOrdersIndex || dep.ResourceType == sdkacltypes.ResourceType_KV_DEX_ACCOUNT_ACTIVE_ORDERS
+		}
+		require.True(t, readsContract)
+		require.Equal(t, tc.readsOrdersIndex, readsOrdersIndex)
+	}
+}
+
 func TestTickSizeMultipleDecorator(t *testing.T) {
 	keeper, ctx := keepertest.DexKeeper(t)
 	ctx = ctx.WithIsCheckTx(true)
@@ -281,3 +385,45 @@ func TestTickSizeMultipleDecorator(t *testing.T) {
 	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
 	require.NotNil(t, err)
 }
+
+func TestTickSizeMultipleDecoratorCancelReplace(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).WithIsCheckTx(true)
+	keeper := testApp.DexKeeper
+	decorator := dex.NewTickSizeMultipleDecorator(keeper)
+	terminator := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) { return ctx, nil }
+
+	band := sdk.MustNewDecFromStr("0.1")
+	pair := keepertest.TestPair
+	pair.OracleDenom = "uatom"
+	pair.OraclePriceBand = &band
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
+	keeper.SetPriceTickSizeForPair(ctx, keepertest.TestContract, pair, *pair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, keepertest.TestContract, pair, *pair.QuantityTicksize)
+	testApp.OracleKeeper.SetBaseExchangeRate(ctx, "uatom", sdk.NewDec(10))
+
+	newCancelReplace := func(price sdk.Dec, quantity sdk.Dec) TestTx {
+		return TestTx{msgs: []sdk.Msg{
+			types.NewMsgCancelOrders("someone", []*types.Cancellation{}, keepertest.TestContract),
+			types.NewMsgCancelReplace("someone", keepertest.TestContract, &types.Cancellation{Id: 1}, &types.Order{
+				PriceDenom: pair.PriceDenom,
+				AssetDenom: pair.AssetDenom,
+				Price:      price,
+				Quantity:   quantity,
+				OrderType:  types.OrderType_LIMIT,
+			}, sdk.NewCoins()),
+		}}
+	}
+
+	_, err := decorator.AnteHandle(ctx, newCancelReplace(sdk.NewDec(10), sdk.NewDec(5)), false, terminator)
+	require.Nil(t, err)
+	// price isn't a multiple of the tick size
+	_, err = decorator.AnteHandle(ctx, newCancelReplace(sdk.MustNewDecFromStr("10.01"), sdk.NewDec(5)), false, terminator)
+	require.NotNil(t, err)
+	// quantity isn't a multiple of the tick size
+	_, err = decorator.AnteHandle(ctx, newCancelReplace(sdk.NewDec(10), sdk.MustNewDecFromStr("0.01")), false, terminator)
+	require.NotNil(t, err)
+	// price is outside of the oracle price band
+	_, err = decorator.AnteHandle(ctx, newCancelReplace(sdk.NewDec(12), sdk.NewDec(5)), false, terminator)
+	require.ErrorIs(t, err, types.ErrPriceOutsideOracleBand)
+}
@@ -123,6 +123,12 @@ func (s *MemState) Clear(ctx sdk.Context) {
 	s.contractsToProcess = &newContractToDependencies
 }
 
+// ClearOrdersForPair removes the orders placed for a pair in the current block
+func (s *MemState) ClearOrdersForPair(ctx sdk.Context, contractAddr types.ContractAddress, pair types.Pair) {
+	s.SynchronizeAccess(ctx, contractAddr)
+	DeepDelete(ctx.KVStore(s.storeKey), types.MemOrderPrefixForPair(string(contractAddr), pair.PriceDenom, pair.AssetDenom), func(_ []byte) bool { return true })
+}
+
 func (s *MemState) ClearCancellationForPair(ctx sdk.Context, contractAddr types.ContractAddress, pair types.Pair) {
 	s.SynchronizeAccess(ctx, contractAddr)
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemCancelKey), func(v []byte) bool {
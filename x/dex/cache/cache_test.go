@@ -128,6 +128,25 @@ func TestClearCancellationForPair(t *testing.T) {
 	require.Equal(t, uint64(3), stateOne.GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), keepertest.TestPair).Get()[0].Id)
 }
 
+func TestClearOrdersForPair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	stateOne := dex.NewMemState(keeper.GetMemStoreKey())
+	otherPair := types.Pair{PriceDenom: "USDC", AssetDenom: "SEI"}
+	stateOne.GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), keepertest.TestPair).Add(&types.Order{
+		Id:           1,
+		Account:      "test",
+		ContractAddr: TEST_CONTRACT,
+	})
+	stateOne.GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), otherPair).Add(&types.Order{
+		Id:           2,
+		Account:      "test",
+		ContractAddr: TEST_CONTRACT,
+	})
+	stateOne.ClearOrdersForPair(ctx, TEST_CONTRACT, keepertest.TestPair)
+	require.Equal(t, 0, len(stateOne.GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), keepertest.TestPair).Get()))
+	require.Equal(t, 1, len(stateOne.GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), otherPair).Get()))
+}
+
 func TestSynchronization(t *testing.T) {
 	k, ctx := keepertest.DexKeeper(t)
 	stateOne := dex.NewMemState(k.GetMemStoreKey())
@@ -57,7 +57,21 @@ func (o *BlockOrders) MarkFailedToPlace(failedOrders []types.UnsuccessfulOrder)
 		failedOrdersMap[failedOrder.ID] = failedOrder
 	}
 
-	keys, vals := o.getKVsToSet(failedOrdersMap)
+	keys, vals := o.getKVsToSet(failedOrdersMap, types.OrderStatus_FAILED_TO_PLACE)
+	for i, key := range keys {
+		o.orderStore.Set(key, vals[i])
+	}
+}
+
+// MarkCancelled marks orders that have been accepted by the contract but are cancelled natively
+// in this block, e.g. rejected post-only orders and unfilled immediate-or-cancel orders.
+func (o *BlockOrders) MarkCancelled(cancelledOrders []types.UnsuccessfulOrder) {
+	cancelledOrdersMap := map[uint64]types.UnsuccessfulOrder{}
+	for _, cancelledOrder := range cancelledOrders {
+		cancelledOrdersMap[cancelledOrder.ID] = cancelledOrder
+	}
+
+	keys, vals := o.getKVsToSet(cancelledOrdersMap, types.OrderStatus_CANCELLED)
 	for i, key := range keys {
 		o.orderStore.Set(key, vals[i])
 	}
@@ -65,7 +79,7 @@ func (o *BlockOrders) MarkFailedToPlace(failedOrders []types.UnsuccessfulOrder)
 
 // getKVsToSet iterate through the kvstore and append the key,val items to a list.
 // We should avoid writing or reading from the store directly within the iterator.
-func (o *BlockOrders) getKVsToSet(failedOrdersMap map[uint64]types.UnsuccessfulOrder) ([][]byte, [][]byte) {
+func (o *BlockOrders) getKVsToSet(ordersMap map[uint64]types.UnsuccessfulOrder, status types.OrderStatus) ([][]byte, [][]byte) {
 	iterator := sdk.KVStorePrefixIterator(o.orderStore, []byte{})
 
 	defer iterator.Close()
@@ -77,9 +91,9 @@ func (o *BlockOrders) getKVsToSet(failedOrdersMap map[uint64]types.UnsuccessfulO
 		if err := val.Unmarshal(iterator.Value()); err != nil {
 			panic(err)
 		}
-		if failedOrder, ok := failedOrdersMap[val.Id]; ok {
-			val.Status = types.OrderStatus_FAILED_TO_PLACE
-			val.StatusDescription = failedOrder.Reason
+		if order, ok := ordersMap[val.Id]; ok {
+			val.Status = status
+			val.StatusDescription = order.Reason
 		}
 		bz, err := val.Marshal()
 		if err != nil {
@@ -41,6 +41,16 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetOrdersByID())
 	cmd.AddCommand(CmdGetMatchResult())
 	cmd.AddCommand(CmdGetOrderCount())
+	cmd.AddCommand(CmdGetTriggeredOrders())
+	cmd.AddCommand(CmdGetVolume())
+	cmd.AddCommand(CmdGetCandles())
+	cmd.AddCommand(CmdGetAccountFeeTier())
+	cmd.AddCommand(CmdGetAccountTrades())
+	cmd.AddCommand(CmdGetOrderBookDepth())
+	cmd.AddCommand(CmdGetAccountOpenOrders())
+	cmd.AddCommand(CmdGetHaltedPairs())
+	cmd.AddCommand(CmdGetContractRent())
+	cmd.AddCommand(CmdGetContractSuspensions())
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,47 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetAccountFeeTier() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-account-fee-tier [contract-address] [price-denom] [asset-denom] [account]",
+		Short: "Query the fee tier of an account",
+		Long: strings.TrimSpace(`
+			Get the maker and taker fee rates that currently apply to [account] on a pair of an orderbook specified by [contract-address],
+			along with the account's 30-day notional volume on the pair that the tier is based on.
+		`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetAccountFeeTierRequest{
+				ContractAddr: args[0],
+				PriceDenom:   args[1],
+				AssetDenom:   args[2],
+				Account:      args[3],
+			}
+
+			res, err := queryClient.GetAccountFeeTier(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,50 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetAccountOpenOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-account-open-orders [contract-address] [account]",
+		Short: "Query the open orders of an account",
+		Long: strings.TrimSpace(`
+			Get the orders of [account] resting in the order books of all pairs of the orderbook specified by [contract-address],
+			along with their remaining and filled quantities.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetAccountOpenOrdersRequest{
+				ContractAddr: args[0],
+				Account:      args[1],
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.GetAccountOpenOrders(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
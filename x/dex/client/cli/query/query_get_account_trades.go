@@ -0,0 +1,81 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagStartHeight    = "start-height"
+	flagEndHeight      = "end-height"
+	flagStartTimestamp = "start-timestamp"
+	flagEndTimestamp   = "end-timestamp"
+)
+
+func CmdGetAccountTrades() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-account-trades [contract-address] [account]",
+		Short: "Query the trades of an account",
+		Long: strings.TrimSpace(`
+			Get the fills of [account] on the orderbook specified by [contract-address], oldest first unless --reverse is set.
+			Results can be restricted to an inclusive range of heights and/or unix timestamps. Only the most recent trades are retained.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			startHeight, err := cmd.Flags().GetUint64(flagStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetUint64(flagEndHeight)
+			if err != nil {
+				return err
+			}
+			startTimestamp, err := cmd.Flags().GetUint64(flagStartTimestamp)
+			if err != nil {
+				return err
+			}
+			endTimestamp, err := cmd.Flags().GetUint64(flagEndTimestamp)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetAccountTradesRequest{
+				ContractAddr:   args[0],
+				Account:        args[1],
+				StartHeight:    startHeight,
+				EndHeight:      endHeight,
+				StartTimestamp: startTimestamp,
+				EndTimestamp:   endTimestamp,
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.GetAccountTrades(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagStartHeight, 0, "Only return trades at or after this height")
+	cmd.Flags().Uint64(flagEndHeight, 0, "Only return trades at or before this height")
+	cmd.Flags().Uint64(flagStartTimestamp, 0, "Only return trades at or after this unix timestamp")
+	cmd.Flags().Uint64(flagEndTimestamp, 0, "Only return trades at or before this unix timestamp")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-candles [contract-address] [price-denom] [asset-denom] [interval] [num-of-candles]",
+		Short: "Query price candles",
+		Long: strings.TrimSpace(`
+			Get the latest [num-of-candles] OHLCV candles for a pair of an orderbook specified by [contract-address].
+			[interval] is one of ONE_MINUTE, FIVE_MINUTES, ONE_HOUR and ONE_DAY. Intervals without any trade have no candle.
+		`),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqInterval, err := types.GetCandleIntervalFromStr(args[3])
+			if err != nil {
+				return err
+			}
+			reqNumOfCandles, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetCandlesRequest{
+				ContractAddr: args[0],
+				PriceDenom:   args[1],
+				AssetDenom:   args[2],
+				Interval:     reqInterval,
+				NumOfCandles: reqNumOfCandles,
+			}
+
+			res, err := queryClient.GetCandles(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
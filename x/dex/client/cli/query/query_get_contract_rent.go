@@ -0,0 +1,44 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetContractRent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-contract-rent [contract-address]",
+		Short: "Query the rent balance and rent ledger of a contract",
+		Long: strings.TrimSpace(`
+			Get the rent balance of the contract specified by [contract-address], its rent ledger and rent config,
+			the average rent charged per block recently and the projected number of blocks until the rent balance runs out.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetContractRentRequest{
+				ContractAddr: args[0],
+			}
+
+			res, err := queryClient.GetContractRent(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetContractSuspensions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-contract-suspensions [contract-address]",
+		Short: "Query the suspension history of a contract",
+		Long: strings.TrimSpace(`
+			Get the records of the most recent suspensions of the contract specified by [contract-address] by the dex EndBlock, oldest first,
+			including the phase the contract failed in, the full error, the gas it used and the number of orders, cancellations and deposits it had in the block.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetContractSuspensionsRequest{
+				ContractAddr: args[0],
+			}
+
+			res, err := queryClient.GetContractSuspensions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetHaltedPairs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-halted-pairs [contract-address]",
+		Short: "Query the pairs halted by their circuit breaker",
+		Long: strings.TrimSpace(`
+			Get the pairs of an orderbook specified by [contract-address] whose matching is currently halted by their circuit breaker,
+			along with the price that tripped the circuit breaker and when matching resumes.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetHaltedPairsRequest{
+				ContractAddr: args[0],
+			}
+
+			res, err := queryClient.GetHaltedPairs(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const flagBucketTicks = "bucket-ticks"
+
+func CmdGetOrderBookDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-order-book-depth [contract-address] [price-denom] [asset-denom] [levels]",
+		Short: "Query aggregated order book depth",
+		Long: strings.TrimSpace(`
+			Get up to [levels] aggregated price levels on each side of a pair of an orderbook specified by [contract-address],
+			along with the best bid, best ask and spread. Set --bucket-ticks to group levels into buckets of that many price ticks.
+		`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqLevels, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			bucketTicks, err := cmd.Flags().GetUint64(flagBucketTicks)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetOrderBookDepthRequest{
+				ContractAddr: args[0],
+				PriceDenom:   args[1],
+				AssetDenom:   args[2],
+				Levels:       reqLevels,
+				BucketTicks:  bucketTicks,
+			}
+
+			res, err := queryClient.GetOrderBookDepth(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagBucketTicks, 0, "Group price levels into buckets of this many price ticks")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
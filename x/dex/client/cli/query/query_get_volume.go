@@ -0,0 +1,52 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetVolume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-volume [contract-address] [price-denom] [asset-denom] [lookback]",
+		Short: "Query traded volume",
+		Long: strings.TrimSpace(`
+			Get the total quantity and notional traded for a pair of an orderbook specified by [contract-address] within the [lookback] seconds.
+		`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqLookback, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetVolumeRequest{
+				ContractAddr:      args[0],
+				PriceDenom:        args[1],
+				AssetDenom:        args[2],
+				LookbackInSeconds: reqLookback,
+			}
+
+			res, err := queryClient.GetVolume(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
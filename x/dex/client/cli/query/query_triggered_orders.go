@@ -0,0 +1,50 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetTriggeredOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-triggered-orders [contract-address] [price-denom] [asset-denom] [account]",
+		Short: "Query stop orders of a pair",
+		Long: strings.TrimSpace(`
+			Get all stop loss/limit orders of a pair that are pending trigger or waiting to be matched.
+			Only orders of the specified account are returned if account is provided.
+		`),
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetTriggeredOrdersRequest{
+				ContractAddr: args[0],
+				PriceDenom:   args[1],
+				AssetDenom:   args[2],
+			}
+			if len(args) == 4 {
+				params.Account = args[3]
+			}
+
+			res, err := queryClient.GetTriggeredOrders(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
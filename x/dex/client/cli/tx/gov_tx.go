@@ -58,3 +58,318 @@ func NewAddAssetProposalTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewUpdateFeeScheduleProposalTxCmd returns a CLI command handler for creating
+// an update fee schedule proposal governance transaction.
+func NewUpdateFeeScheduleProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-fee-schedule-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update fee schedule proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to set the maker and taker fee rates, and optionally volume based fee tiers, of a list of pairs.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseUpdateFeeScheduleProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.UpdateFeeScheduleProposal{Title: proposal.Title, Description: proposal.Description, FeeSchedules: proposal.FeeSchedules}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUpdateCircuitBreakerProposalTxCmd returns a CLI command handler for creating
+// an update circuit breaker proposal governance transaction.
+func NewUpdateCircuitBreakerProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-circuit-breaker-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update circuit breaker proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to set the price deviation from TWAP that halts matching of a list of pairs, and for how long.
+			A circuit breaker with a zero max price deviation removes the circuit breaker of its pair.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseUpdateCircuitBreakerProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.UpdateCircuitBreakerProposal{Title: proposal.Title, Description: proposal.Description, CircuitBreakers: proposal.CircuitBreakers}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterPairsProposalTxCmd returns a CLI command handler for creating
+// a register pairs proposal governance transaction.
+func NewRegisterPairsProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-pairs-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a register pairs proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to register pairs of contracts regardless of who created the contracts.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseRegisterPairsProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+			batchContractPairs, err := proposal.BatchContractPair.ToMultipleBatchContractPair()
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.RegisterPairsProposal{Title: proposal.Title, Description: proposal.Description, Batchcontractpair: batchContractPairs}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUpdateTickSizeProposalTxCmd returns a CLI command handler for creating
+// an update tick size proposal governance transaction.
+func NewUpdateTickSizeProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-tick-size-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update tick size proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to update the price and quantity tick sizes of registered pairs regardless of who created their contracts.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseUpdateTickSizeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+			priceTickSizes, err := proposal.PriceTickSizes.ToTickSizes()
+			if err != nil {
+				return err
+			}
+			quantityTickSizes, err := proposal.QuantityTickSizes.ToTickSizes()
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.UpdateTickSizeProposal{Title: proposal.Title, Description: proposal.Description, PriceTickSizes: priceTickSizes, QuantityTickSizes: quantityTickSizes}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDelistPairsProposalTxCmd returns a CLI command handler for creating
+// a delist pairs proposal governance transaction.
+func NewDelistPairsProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delist-pairs-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a delist pairs proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to delist registered pairs. All resting orders of the pairs are cancelled and refunded by their contracts
+			before the order books and configuration of the pairs are removed.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseDelistPairsProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.DelistPairsProposal{Title: proposal.Title, Description: proposal.Description, Batchcontractpair: proposal.BatchContractPair}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSuspendContractProposalTxCmd returns a CLI command handler for creating
+// a suspend contract proposal governance transaction.
+func NewSuspendContractProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suspend-contract-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a suspend contract proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to suspend a registered contract so that none of its orders are matched.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseSuspendContractProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.SuspendContractProposal{Title: proposal.Title, Description: proposal.Description, ContractAddr: proposal.ContractAddr, Reason: proposal.Reason}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnsuspendContractProposalTxCmd returns a CLI command handler for creating
+// an unsuspend contract proposal governance transaction.
+func NewUnsuspendContractProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unsuspend-contract-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an unsuspend contract proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to unsuspend a suspended contract without charging it the unsuspend cost.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseUnsuspendContractProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.UnsuspendContractProposal{Title: proposal.Title, Description: proposal.Description, ContractAddr: proposal.ContractAddr}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -33,7 +33,16 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdatePriceTickSize())
 	cmd.AddCommand(CmdUpdateQuantityTickSize())
 	cmd.AddCommand(NewAddAssetProposalTxCmd())
+	cmd.AddCommand(NewUpdateFeeScheduleProposalTxCmd())
+	cmd.AddCommand(NewUpdateCircuitBreakerProposalTxCmd())
+	cmd.AddCommand(NewRegisterPairsProposalTxCmd())
+	cmd.AddCommand(NewUpdateTickSizeProposalTxCmd())
+	cmd.AddCommand(NewDelistPairsProposalTxCmd())
+	cmd.AddCommand(NewSuspendContractProposalTxCmd())
+	cmd.AddCommand(NewUnsuspendContractProposalTxCmd())
 	cmd.AddCommand(CmdUnsuspendContract())
+	cmd.AddCommand(CmdSetRentLowBalanceThresholds())
+	cmd.AddCommand(CmdSetRentAutoTopup())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,54 @@
+package tx
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetRentAutoTopup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rent-auto-topup [contract address] [threshold] [amount]",
+		Short: "Set rent auto top-up",
+		Long: strings.TrimSpace(`
+			Top up the rent balance of a contract by amount from the sender at the end of every block that the rent balance is below threshold in. An amount of 0 disables auto top-ups. An existing funding account can only be replaced or removed by itself or by the creator of the contract.
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractAddr := args[0]
+			argThreshold, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argAmount, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRentAutoTopup(
+				clientCtx.GetFromAddress().String(),
+				argContractAddr,
+				argThreshold,
+				argAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
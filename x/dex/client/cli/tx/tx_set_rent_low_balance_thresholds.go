@@ -0,0 +1,56 @@
+package tx
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetRentLowBalanceThresholds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rent-low-balance-thresholds [contract address] [thresholds]",
+		Short: "Set rent low balance thresholds",
+		Long: strings.TrimSpace(`
+			Set the comma-separated rent balance thresholds of a contract below which a low rent balance event is emitted. An empty list removes all thresholds. Only the creator of the contract may set them.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractAddr := args[0]
+			thresholds := []uint64{}
+			for _, arg := range strings.Split(args[1], ",") {
+				if arg = strings.TrimSpace(arg); arg == "" {
+					continue
+				}
+				threshold, err := cast.ToUint64E(arg)
+				if err != nil {
+					return err
+				}
+				thresholds = append(thresholds, threshold)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRentLowBalanceThresholds(
+				clientCtx.GetFromAddress().String(),
+				argContractAddr,
+				thresholds,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -11,10 +11,17 @@ import (
 
 type (
 	PairJSON struct {
-		PriceDenom       string `json:"price_denom" yaml:"price_denom"`
-		AssetDenom       string `json:"asset_denom" yaml:"asset_denom"`
-		PriceTickSize    string `json:"price_tick_size" yaml:"tick_size"`
-		QuantityTickSize string `json:"quantity_tick_size" yaml:"tick_size"`
+		PriceDenom                string `json:"price_denom" yaml:"price_denom"`
+		AssetDenom                string `json:"asset_denom" yaml:"asset_denom"`
+		PriceTickSize             string `json:"price_tick_size" yaml:"tick_size"`
+		QuantityTickSize          string `json:"quantity_tick_size" yaml:"tick_size"`
+		MatchingPolicy            string `json:"matching_policy,omitempty" yaml:"matching_policy"`
+		TopOfQueueBonus           string `json:"top_of_queue_bonus,omitempty" yaml:"top_of_queue_bonus"`
+		SelfTradePrevention       string `json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
+		OracleDenom               string `json:"oracle_denom,omitempty" yaml:"oracle_denom"`
+		OraclePriceBand           string `json:"oracle_price_band,omitempty" yaml:"oracle_price_band"`
+		OracleTwapLookbackSeconds uint64 `json:"oracle_twap_lookback_seconds,omitempty" yaml:"oracle_twap_lookback_seconds"`
+		BatchAuction              bool   `json:"batch_auction,omitempty" yaml:"batch_auction"`
 	}
 
 	TickSizeJSON struct {
@@ -52,6 +59,57 @@ type (
 		AssetList   AssetListJSON `json:"asset_list" yaml:"asset_list"`
 		Deposit     string        `json:"deposit" yaml:"deposit"`
 	}
+
+	UpdateFeeScheduleProposalJSON struct {
+		Title        string                 `json:"title" yaml:"title"`
+		Description  string                 `json:"description" yaml:"description"`
+		FeeSchedules []dextypes.FeeSchedule `json:"fee_schedules" yaml:"fee_schedules"`
+		Deposit      string                 `json:"deposit" yaml:"deposit"`
+	}
+
+	UpdateCircuitBreakerProposalJSON struct {
+		Title           string                    `json:"title" yaml:"title"`
+		Description     string                    `json:"description" yaml:"description"`
+		CircuitBreakers []dextypes.CircuitBreaker `json:"circuit_breakers" yaml:"circuit_breakers"`
+		Deposit         string                    `json:"deposit" yaml:"deposit"`
+	}
+
+	RegisterPairsProposalJSON struct {
+		Title             string                        `json:"title" yaml:"title"`
+		Description       string                        `json:"description" yaml:"description"`
+		BatchContractPair MultipleBatchContractPairJSON `json:"batch_contract_pair" yaml:"batch_contract_pair"`
+		Deposit           string                        `json:"deposit" yaml:"deposit"`
+	}
+
+	UpdateTickSizeProposalJSON struct {
+		Title             string        `json:"title" yaml:"title"`
+		Description       string        `json:"description" yaml:"description"`
+		PriceTickSizes    TickSizesJSON `json:"price_tick_sizes" yaml:"price_tick_sizes"`
+		QuantityTickSizes TickSizesJSON `json:"quantity_tick_sizes" yaml:"quantity_tick_sizes"`
+		Deposit           string        `json:"deposit" yaml:"deposit"`
+	}
+
+	DelistPairsProposalJSON struct {
+		Title             string                       `json:"title" yaml:"title"`
+		Description       string                       `json:"description" yaml:"description"`
+		BatchContractPair []dextypes.BatchContractPair `json:"batch_contract_pair" yaml:"batch_contract_pair"`
+		Deposit           string                       `json:"deposit" yaml:"deposit"`
+	}
+
+	SuspendContractProposalJSON struct {
+		Title        string `json:"title" yaml:"title"`
+		Description  string `json:"description" yaml:"description"`
+		ContractAddr string `json:"contract_addr" yaml:"contract_addr"`
+		Reason       string `json:"reason" yaml:"reason"`
+		Deposit      string `json:"deposit" yaml:"deposit"`
+	}
+
+	UnsuspendContractProposalJSON struct {
+		Title        string `json:"title" yaml:"title"`
+		Description  string `json:"description" yaml:"description"`
+		ContractAddr string `json:"contract_addr" yaml:"contract_addr"`
+		Deposit      string `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // TODO: ADD utils to convert Each type to dex/type (string to denom)
@@ -72,7 +130,39 @@ func NewPair(pair PairJSON) (dextypes.Pair, error) {
 	if quantityTicksize.LTE(sdk.ZeroDec()) {
 		return dextypes.Pair{}, errors.New("quantity ticksize: value cannot be zero or negative")
 	}
-	return dextypes.Pair{PriceDenom: PriceDenom, AssetDenom: AssetDenom, PriceTicksize: &priceTicksize, QuantityTicksize: &quantityTicksize}, nil
+	newPair := dextypes.Pair{PriceDenom: PriceDenom, AssetDenom: AssetDenom, PriceTicksize: &priceTicksize, QuantityTicksize: &quantityTicksize}
+	if pair.MatchingPolicy != "" {
+		matchingPolicy, ok := dextypes.MatchingPolicy_value[pair.MatchingPolicy]
+		if !ok {
+			return dextypes.Pair{}, errors.New("matching policy: unknown policy")
+		}
+		newPair.MatchingPolicy = dextypes.MatchingPolicy(matchingPolicy)
+	}
+	if pair.TopOfQueueBonus != "" {
+		topOfQueueBonus, err := sdk.NewDecFromStr(pair.TopOfQueueBonus)
+		if err != nil {
+			return dextypes.Pair{}, errors.New("top of queue bonus: str to decimal conversion err")
+		}
+		newPair.TopOfQueueBonus = &topOfQueueBonus
+	}
+	if pair.SelfTradePrevention != "" {
+		selfTradePrevention, ok := dextypes.SelfTradePrevention_value[pair.SelfTradePrevention]
+		if !ok {
+			return dextypes.Pair{}, errors.New("self-trade prevention: unknown mode")
+		}
+		newPair.SelfTradePrevention = dextypes.SelfTradePrevention(selfTradePrevention)
+	}
+	newPair.OracleDenom = pair.OracleDenom
+	if pair.OraclePriceBand != "" {
+		oraclePriceBand, err := sdk.NewDecFromStr(pair.OraclePriceBand)
+		if err != nil {
+			return dextypes.Pair{}, errors.New("oracle price band: str to decimal conversion err")
+		}
+		newPair.OraclePriceBand = &oraclePriceBand
+	}
+	newPair.OracleTwapLookbackSeconds = pair.OracleTwapLookbackSeconds
+	newPair.BatchAuction = pair.BatchAuction
+	return newPair, nil
 }
 
 // ToParamChange converts a ParamChangeJSON object to ParamChange.
@@ -201,3 +291,134 @@ func ParseAddAssetMetadataProposalJSON(cdc *codec.LegacyAmino, proposalFile stri
 
 	return proposal, nil
 }
+
+// ParseUpdateFeeScheduleProposalJSON reads and parses an UpdateFeeScheduleProposalJSON from
+// a file.
+func ParseUpdateFeeScheduleProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (UpdateFeeScheduleProposalJSON, error) {
+	proposal := UpdateFeeScheduleProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	for _, feeSchedule := range proposal.FeeSchedules {
+		if err := feeSchedule.Validate(); err != nil {
+			return UpdateFeeScheduleProposalJSON{}, err
+		}
+	}
+
+	return proposal, nil
+}
+
+// ParseUpdateCircuitBreakerProposalJSON reads and parses an UpdateCircuitBreakerProposalJSON from
+// a file.
+func ParseUpdateCircuitBreakerProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (UpdateCircuitBreakerProposalJSON, error) {
+	proposal := UpdateCircuitBreakerProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	for _, circuitBreaker := range proposal.CircuitBreakers {
+		if err := circuitBreaker.Validate(); err != nil {
+			return UpdateCircuitBreakerProposalJSON{}, err
+		}
+	}
+
+	return proposal, nil
+}
+
+// ParseRegisterPairsProposalJSON reads and parses a RegisterPairsProposalJSON from
+// a file.
+func ParseRegisterPairsProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (RegisterPairsProposalJSON, error) {
+	proposal := RegisterPairsProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseUpdateTickSizeProposalJSON reads and parses an UpdateTickSizeProposalJSON from
+// a file.
+func ParseUpdateTickSizeProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (UpdateTickSizeProposalJSON, error) {
+	proposal := UpdateTickSizeProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseDelistPairsProposalJSON reads and parses a DelistPairsProposalJSON from
+// a file.
+func ParseDelistPairsProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (DelistPairsProposalJSON, error) {
+	proposal := DelistPairsProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseSuspendContractProposalJSON reads and parses a SuspendContractProposalJSON from
+// a file.
+func ParseSuspendContractProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (SuspendContractProposalJSON, error) {
+	proposal := SuspendContractProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseUnsuspendContractProposalJSON reads and parses an UnsuspendContractProposalJSON from
+// a file.
+func ParseUnsuspendContractProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (UnsuspendContractProposalJSON, error) {
+	proposal := UnsuspendContractProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
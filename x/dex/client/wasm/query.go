@@ -41,8 +41,32 @@ func (handler DexWasmQueryHandler) GetOrderSimulation(ctx sdk.Context, req *type
 	return wrapper.GetOrderSimulation(c, req)
 }
 
+func (handler DexWasmQueryHandler) GetOrderSimulations(ctx sdk.Context, req *types.QueryOrderSimulationsRequest) (*types.QueryOrderSimulationsResponse, error) {
+	c := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: &handler.dexKeeper}
+	return wrapper.GetOrderSimulations(c, req)
+}
+
 func (handler DexWasmQueryHandler) GetLatestPrice(ctx sdk.Context, req *types.QueryGetLatestPriceRequest) (*types.QueryGetLatestPriceResponse, error) {
 	c := sdk.WrapSDKContext(ctx)
 	wrapper := query.KeeperWrapper{Keeper: &handler.dexKeeper}
 	return wrapper.GetLatestPrice(c, req)
 }
+
+func (handler DexWasmQueryHandler) GetCandles(ctx sdk.Context, req *types.QueryGetCandlesRequest) (*types.QueryGetCandlesResponse, error) {
+	c := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: &handler.dexKeeper}
+	return wrapper.GetCandles(c, req)
+}
+
+func (handler DexWasmQueryHandler) GetAccountTrades(ctx sdk.Context, req *types.QueryGetAccountTradesRequest) (*types.QueryGetAccountTradesResponse, error) {
+	c := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: &handler.dexKeeper}
+	return wrapper.GetAccountTrades(c, req)
+}
+
+func (handler DexWasmQueryHandler) GetOrderBookDepth(ctx sdk.Context, req *types.QueryGetOrderBookDepthRequest) (*types.QueryGetOrderBookDepthResponse, error) {
+	c := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: &handler.dexKeeper}
+	return wrapper.GetOrderBookDepth(c, req)
+}
@@ -32,7 +32,7 @@ const LogExecSigSendAfter = 2 * time.Second
 
 type environment struct {
 	validContractsInfo              []types.ContractInfoV2
-	failedContractAddressesToErrors *datastructures.TypedSyncMap[string, error]
+	failedContractAddressesToErrors *datastructures.TypedSyncMap[string, contractFailure]
 	outOfRentContractAddresses      datastructures.SyncSet[string]
 	settlementsByContract           *datastructures.TypedSyncMap[string, []*types.SettlementEntry]
 	executionTerminationSignals     *datastructures.TypedSyncMap[string, chan struct{}]
@@ -43,6 +43,12 @@ type environment struct {
 	eventManagerMutex *sync.Mutex
 }
 
+// contractFailure is an error that fails a contract in the EndBlock and the phase it occurred in
+type contractFailure struct {
+	phase types.ContractFailurePhase
+	err   error
+}
+
 func EndBlockerAtomic(ctx sdk.Context, keeper *keeper.Keeper, validContractsInfo []types.ContractInfoV2, tracingInfo *tracing.Info) ([]types.ContractInfoV2, []types.ContractInfoV2, map[string]string, sdk.Context, bool) {
 	tracer := tracingInfo.Tracer
 	spanCtx, span := tracingInfo.Start("DexEndBlockerAtomic")
@@ -86,7 +92,7 @@ func EndBlockerAtomic(ctx sdk.Context, keeper *keeper.Keeper, validContractsInfo
 	failedContractsPreRents := map[string]uint64{}
 	failedContractsPostRents := map[string]uint64{}
 	// persistent contract rent charges for failed contracts and discard everything else
-	env.failedContractAddressesToErrors.Range(func(failedContractAddress string, failedReason error) bool {
+	env.failedContractAddressesToErrors.Range(func(failedContractAddress string, failure contractFailure) bool {
 		cachedContract, err := keeper.GetContract(cachedCtx, failedContractAddress)
 		if err != nil {
 			ctx.Logger().Error(fmt.Sprintf("error %s when getting updated contract %s to persist rent balance", err, failedContractAddress))
@@ -105,7 +111,10 @@ func EndBlockerAtomic(ctx sdk.Context, keeper *keeper.Keeper, validContractsInfo
 			ctx.Logger().Error(fmt.Sprintf("error %s when persisting contract %s's rent balance", err, failedContractAddress))
 			return true
 		}
-		failedContractsToReasons[failedContractAddress] = dexutils.GetTruncatedErrors(failedReason)
+		// the record compares the rent ledger before and after the run, so it must be created first
+		keeper.AddSuspensionRecord(ctx, newSuspensionRecord(ctx, cachedCtx, env, keeper, failedContractAddress, failure))
+		keeper.SetRentLedger(ctx, keeper.GetRentLedger(cachedCtx, failedContractAddress))
+		failedContractsToReasons[failedContractAddress] = dexutils.GetTruncatedErrors(failure.err)
 		return true
 	})
 	TransferRentFromDexToCollector(ctx, keeper.BankKeeper, failedContractsPreRents, failedContractsPostRents)
@@ -131,7 +140,7 @@ func newEnv(ctx sdk.Context, validContractsInfo []types.ContractInfoV2, keeper *
 	orderBooks := dexkeeperutils.PopulateAllOrderbooks(ctx, keeper, allContractAndPairs)
 	return &environment{
 		validContractsInfo:              validContractsInfo,
-		failedContractAddressesToErrors: datastructures.NewTypedSyncMap[string, error](),
+		failedContractAddressesToErrors: datastructures.NewTypedSyncMap[string, contractFailure](),
 		outOfRentContractAddresses:      datastructures.NewSyncSet([]string{}),
 		settlementsByContract:           settlementsByContract,
 		executionTerminationSignals:     executionTerminationSignals,
@@ -142,12 +151,37 @@ func newEnv(ctx sdk.Context, validContractsInfo []types.ContractInfoV2, keeper *
 	}
 }
 
-func (e *environment) addError(contractAddr string, err error) {
+func (e *environment) addError(contractAddr string, phase types.ContractFailurePhase, err error) {
 	if err == types.ErrInsufficientRent {
 		e.outOfRentContractAddresses.Add(contractAddr)
 		return
 	}
-	e.failedContractAddressesToErrors.Store(contractAddr, err)
+	// later phases still run for a failed contract and may fail too, but the first failure is the
+	// one that caused the suspension
+	e.failedContractAddressesToErrors.LoadOrStore(contractAddr, contractFailure{phase: phase, err: err})
+}
+
+// newSuspensionRecord records the state of a failed contract in the block it failed in. `ctx` is
+// the context before the EndBlock ran and `cachedCtx` is the context the EndBlock ran in.
+func newSuspensionRecord(ctx sdk.Context, cachedCtx sdk.Context, env *environment, keeper *keeper.Keeper, contractAddr string, failure contractFailure) types.SuspensionRecord {
+	memState := dexutils.GetMemState(ctx.Context())
+	typedContractAddr := types.ContractAddress(contractAddr)
+	cancellationCount := 0
+	if pairs, found := env.registeredPairs.Load(contractAddr); found {
+		for _, pair := range pairs {
+			cancellationCount += len(memState.GetBlockCancels(ctx, typedContractAddr, pair).Get())
+		}
+	}
+	return types.SuspensionRecord{
+		ContractAddr:      contractAddr,
+		Height:            ctx.BlockHeight(),
+		Phase:             failure.phase,
+		Error:             failure.err.Error(),
+		GasUsed:           keeper.GetRentLedger(cachedCtx, contractAddr).TotalSudoGasConsumed() - keeper.GetRentLedger(ctx, contractAddr).TotalSudoGasConsumed(),
+		OrderCount:        uint64(len(memState.GetAllBlockOrders(ctx, typedContractAddr))),
+		CancellationCount: uint64(cancellationCount),
+		DepositCount:      uint64(len(memState.GetDepositInfo(ctx, typedContractAddr).Get())),
+	}
 }
 
 func cacheContext(ctx sdk.Context, env *environment) (sdk.Context, sdk.CacheMultiStore) {
@@ -180,7 +214,7 @@ func handleDeposits(spanCtx context.Context, ctx sdk.Context, env *environment,
 			continue
 		}
 		if err := keeperWrapper.HandleEBDeposit(spanCtx, ctx, tracer, contract.ContractAddr); err != nil {
-			env.addError(contract.ContractAddr, err)
+			env.addError(contract.ContractAddr, types.ContractFailurePhase_DEPOSIT, err)
 		}
 	}
 }
@@ -201,7 +235,7 @@ func handleSettlements(ctx context.Context, sdkCtx sdk.Context, env *environment
 		}
 		if err := HandleSettlements(sdkCtx, contractAddr, keeper, settlements); err != nil {
 			sdkCtx.Logger().Error(fmt.Sprintf("Error handling settlements for %s", contractAddr))
-			env.addError(contractAddr, err)
+			env.addError(contractAddr, types.ContractFailurePhase_SETTLEMENT, err)
 		}
 		return true
 	})
@@ -221,7 +255,7 @@ func handleUnfulfilledMarketOrders(ctx context.Context, sdkCtx sdk.Context, env
 			}
 			if err := CancelUnfulfilledMarketOrders(ctx, sdkCtx, contract.ContractAddr, keeper, registeredPairs, tracer); err != nil {
 				sdkCtx.Logger().Error(fmt.Sprintf("Error cancelling unfulfilled market orders for %s", contract.ContractAddr))
-				env.addError(contract.ContractAddr, err)
+				env.addError(contract.ContractAddr, types.ContractFailurePhase_MARKET_ORDER_CANCELLATION, err)
 			}
 		}
 	}
@@ -234,7 +268,7 @@ func OrderMatchingRunnable(ctx context.Context, sdkContext sdk.Context, env *env
 			msg := fmt.Sprintf("PANIC RECOVERED during order matching: %s", err)
 			sdkContext.Logger().Error(msg)
 			if env != nil {
-				env.addError(contractInfo.ContractAddr, errors.New(msg))
+				env.addError(contractInfo.ContractAddr, types.ContractFailurePhase_PANIC, errors.New(msg))
 			}
 		}
 	}()
@@ -267,10 +301,10 @@ func OrderMatchingRunnable(ctx context.Context, sdkContext sdk.Context, env *env
 
 	if !pairFound || !found {
 		sdkContext.Logger().Error(fmt.Sprintf("No pair or order book for %s", contractInfo.ContractAddr))
-		env.addError(contractInfo.ContractAddr, errors.New("no pair found (internal error)"))
-	} else if settlements, err := HandleExecutionForContract(ctx, sdkContext, contractInfo, keeper, pairs, orderBooks, tracer); err != nil {
+		env.addError(contractInfo.ContractAddr, types.ContractFailurePhase_INTERNAL, errors.New("no pair found (internal error)"))
+	} else if settlements, phase, err := HandleExecutionForContract(ctx, sdkContext, contractInfo, keeper, pairs, orderBooks, tracer); err != nil {
 		sdkContext.Logger().Error(fmt.Sprintf("Error for EndBlock of %s", contractInfo.ContractAddr))
-		env.addError(contractInfo.ContractAddr, err)
+		env.addError(contractInfo.ContractAddr, phase, err)
 	} else {
 		env.settlementsByContract.Store(contractInfo.ContractAddr, settlements)
 	}
@@ -288,7 +322,7 @@ func filterNewValidContracts(ctx sdk.Context, env *environment) []types.Contract
 			newValidContracts = append(newValidContracts, contract)
 		}
 	}
-	env.failedContractAddressesToErrors.Range(func(failedContractAddress string, _ error) bool {
+	env.failedContractAddressesToErrors.Range(func(failedContractAddress string, _ contractFailure) bool {
 		dexutils.GetMemState(ctx.Context()).DeepFilterAccount(ctx, failedContractAddress)
 		return true
 	})
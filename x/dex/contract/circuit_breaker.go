@@ -0,0 +1,61 @@
+package contract
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// isPairHalted returns whether matching of a pair is halted by its circuit breaker in this block,
+// and removes the halt of the pair once it has run out.
+func isPairHalted(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+) bool {
+	halt, found := dexkeeper.GetPairHalt(ctx, string(contractAddr), pair.PriceDenom, pair.AssetDenom)
+	if !found {
+		return false
+	}
+	if halt.IsActive(uint64(ctx.BlockTime().Unix())) {
+		return true
+	}
+	dexkeeper.RemovePairHalt(ctx, string(contractAddr), pair.PriceDenom, pair.AssetDenom)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeResumePair,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, string(contractAddr)),
+		sdk.NewAttribute(types.AttributeKeyPriceDenom, pair.PriceDenom),
+		sdk.NewAttribute(types.AttributeKeyAssetDenom, pair.AssetDenom),
+	))
+	return false
+}
+
+// executeHaltedPair processes the orders of a pair whose matching is halted without matching
+// anything. Cancellations still take effect and limit and stop orders are queued to be matched
+// once the halt is over, while orders that can't wait for matching to resume are cancelled.
+func executeHaltedPair(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+) {
+	cancelForPair(ctx, dexkeeper, contractAddr, pair)
+	dexkeeperutils.PruneExpiredOrders(ctx, dexkeeper, contractAddr, pair)
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, contractAddr, pair)
+	limitBuys := orders.GetLimitOrders(types.PositionDirection_LONG)
+	limitSells := orders.GetLimitOrders(types.PositionDirection_SHORT)
+	rejectedPostOnlyOrders := exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, limitBuys, limitSells)
+	markNativelyCancelledOrders(ctx, orders, rejectedPostOnlyOrders, types.EventTypeRejectOrder, types.PostOnlyRejectionReason)
+	unfilledIOCOrders := exchange.CancelUnfilledImmediateOrCancelOrders(ctx, dexkeeper, contractAddr, pair, append(limitBuys, limitSells...))
+	markNativelyCancelledOrders(ctx, orders, unfilledIOCOrders, types.EventTypeCancelOrder, types.HaltedPairReason)
+	marketOrders := append(orders.GetSortedMarketOrders(types.PositionDirection_LONG), orders.GetSortedMarketOrders(types.PositionDirection_SHORT)...)
+	markNativelyCancelledOrders(ctx, orders, marketOrders, types.EventTypeCancelOrder, types.HaltedPairReason)
+	dexkeeperutils.UpdateTriggerBookFromExecutionOutcome(ctx, dexkeeper, contractAddr, pair, orders.Get(), exchange.ExecutionOutcome{
+		TotalNotional: sdk.ZeroDec(),
+		TotalQuantity: sdk.ZeroDec(),
+	})
+}
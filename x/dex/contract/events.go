@@ -0,0 +1,83 @@
+package contract
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// emitFillEvents emits a `fill_order` event for each side of each fill. The last fill of an order
+// in the block is marked as a full fill if nothing of the order is left after matching, and every
+// other fill as a partial fill. It must be called after matching and before the remainders of
+// any order are cancelled. Orders in `cancelledIDs` were cancelled during matching, so none of their
+// fills are full.
+func emitFillEvents(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	blockOrders *dexcache.BlockOrders,
+	fills []exchange.Fill,
+	cancelledIDs map[uint64]struct{},
+) {
+	lastFillIndices := map[uint64]int{}
+	for i, fill := range fills {
+		lastFillIndices[fill.Maker.OrderId] = i
+		lastFillIndices[fill.Taker.OrderId] = i
+	}
+	fillType := func(entry types.SettlementEntry, i int) string {
+		if _, cancelled := cancelledIDs[entry.OrderId]; cancelled {
+			return types.AttributeValuePartialFill
+		}
+		if lastFillIndices[entry.OrderId] == i && isFullyFilled(ctx, dexkeeper, contractAddr, pair, blockOrders, entry) {
+			return types.AttributeValueFullFill
+		}
+		return types.AttributeValuePartialFill
+	}
+	events := []sdk.Event{}
+	for i, fill := range fills {
+		price := fill.Maker.ExecutionCostOrProceed
+		events = append(events,
+			types.NewFillOrderEvent(string(contractAddr), fill.Maker, fill.Taker.OrderId, price, types.AttributeValueMaker, fillType(fill.Maker, i)),
+			types.NewFillOrderEvent(string(contractAddr), fill.Taker, fill.Maker.OrderId, price, types.AttributeValueTaker, fillType(fill.Taker, i)),
+		)
+	}
+	ctx.EventManager().EmitEvents(events)
+}
+
+// isFullyFilled returns whether nothing is left of the order of a settlement after matching.
+// Market orders are never added to the book, so their state in the block orders is checked
+// instead.
+func isFullyFilled(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	blockOrders *dexcache.BlockOrders,
+	entry types.SettlementEntry,
+) bool {
+	if order := blockOrders.GetByID(entry.OrderId); order.Account == entry.Account && order.OrderType != types.OrderType_LIMIT {
+		return order.Status == types.OrderStatus_FULFILLED
+	}
+	direction, err := types.GetPositionDirectionFromStr(entry.PositionDirection)
+	if err != nil {
+		return false
+	}
+	getter := dexkeeper.GetLongAllocationForOrderID
+	if direction == types.PositionDirection_SHORT {
+		getter = dexkeeper.GetShortAllocationForOrderID
+	}
+	// the expected price of a limit order's settlement is the order's own price
+	_, stillResting := getter(ctx, string(contractAddr), pair.PriceDenom, pair.AssetDenom, entry.ExpectedCostOrProceed, entry.OrderId)
+	return !stillResting
+}
+
+func emitCancellationEvents(ctx sdk.Context, cancellations []*types.Cancellation) {
+	events := []sdk.Event{}
+	for _, cancellation := range cancellations {
+		events = append(events, types.NewCancellationEvent(*cancellation))
+	}
+	ctx.EventManager().EmitEvents(events)
+}
@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/store/whitelist/multi"
 	"github.com/sei-protocol/sei-chain/utils/datastructures"
+	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	"github.com/sei-protocol/sei-chain/x/dex/exchange"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	dexkeeperabci "github.com/sei-protocol/sei-chain/x/dex/keeper/abci"
@@ -21,6 +22,9 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
+// CallPreExecutionHooks sends the block's cancellations and then its order placements to the
+// contract. The returned phase is the one of the last sudo call made, which is the failing one if
+// an error is returned.
 func CallPreExecutionHooks(
 	ctx context.Context,
 	sdkCtx sdk.Context,
@@ -28,15 +32,15 @@ func CallPreExecutionHooks(
 	dexkeeper *keeper.Keeper,
 	registeredPairs []types.Pair,
 	tracer *otrace.Tracer,
-) error {
+) (types.ContractFailurePhase, error) {
 	spanCtx, span := (*tracer).Start(ctx, "PreExecutionHooks")
 	defer span.End()
 	span.SetAttributes(attribute.String("contract", contractAddr))
 	abciWrapper := dexkeeperabci.KeeperWrapper{Keeper: dexkeeper}
 	if err := abciWrapper.HandleEBCancelOrders(spanCtx, sdkCtx, tracer, contractAddr, registeredPairs); err != nil {
-		return err
+		return types.ContractFailurePhase_CANCELLATION, err
 	}
-	return abciWrapper.HandleEBPlaceOrders(spanCtx, sdkCtx, tracer, contractAddr, registeredPairs)
+	return types.ContractFailurePhase_ORDER_PLACEMENT, abciWrapper.HandleEBPlaceOrders(spanCtx, sdkCtx, tracer, contractAddr, registeredPairs)
 }
 
 func ExecutePair(
@@ -48,24 +52,77 @@ func ExecutePair(
 ) []*types.SettlementEntry {
 	typedContractAddr := types.ContractAddress(contractAddr)
 
-	// First cancel orders
+	if isPairHalted(ctx, dexkeeper, typedContractAddr, pair) {
+		executeHaltedPair(ctx, dexkeeper, typedContractAddr, pair)
+		return []*types.SettlementEntry{}
+	}
+
+	// First cancel orders, including the ones that expired and were queued in BeginBlock
 	cancelForPair(ctx, dexkeeper, typedContractAddr, pair)
+	dexkeeperutils.PruneExpiredOrders(ctx, dexkeeper, typedContractAddr, pair)
 	// Add all limit orders to the orderbook
 	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
 	limitBuys := orders.GetLimitOrders(types.PositionDirection_LONG)
 	limitSells := orders.GetLimitOrders(types.PositionDirection_SHORT)
-	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, limitBuys, limitSells)
-	// Fill market orders
-	marketOrderOutcome := matchMarketOrderForPair(ctx, typedContractAddr, pair, orderbook)
-	// Fill limit orders
-	limitOrderOutcome := exchange.MatchLimitOrders(ctx, orderbook)
-	totalOutcome := marketOrderOutcome.Merge(&limitOrderOutcome)
+	rejectedPostOnlyOrders := exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, limitBuys, limitSells)
+	markNativelyCancelledOrders(ctx, orders, rejectedPostOnlyOrders, types.EventTypeRejectOrder, types.PostOnlyRejectionReason)
+	fees := dexkeeperutils.GetFeeCalculator(ctx, dexkeeper, typedContractAddr, pair)
+	var totalOutcome exchange.ExecutionOutcome
+	if pair.BatchAuction {
+		totalOutcome = matchBatchAuctionForPair(ctx, typedContractAddr, pair, orderbook, fees)
+	} else {
+		// Fill market orders
+		marketOrderOutcome := matchMarketOrderForPair(ctx, typedContractAddr, pair, orderbook, fees)
+		// Fill limit orders
+		limitOrderOutcome := exchange.MatchLimitOrders(ctx, orderbook, fees)
+		totalOutcome = marketOrderOutcome.Merge(&limitOrderOutcome)
+	}
+	selfTradeCancelledIDs := handleSelfTradeCancellations(ctx, dexkeeper, typedContractAddr, pair, orderbook, orders)
+	emitFillEvents(ctx, dexkeeper, typedContractAddr, pair, orders, totalOutcome.Fills, selfTradeCancelledIDs)
+	// Remove what is left of immediate-or-cancel orders from the book
+	unfilledIOCOrders := exchange.CancelUnfilledImmediateOrCancelOrders(ctx, dexkeeper, typedContractAddr, pair, append(limitBuys, limitSells...))
+	markNativelyCancelledOrders(ctx, orders, unfilledIOCOrders, types.EventTypeCancelOrder, types.ImmediateOrCancelRemainderReason)
 
+	dexkeeperutils.TripCircuitBreakerFromExecutionOutcome(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
 	dexkeeperutils.SetPriceStateFromExecutionOutcome(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
+	dexkeeperutils.SetVolumeStateFromExecutionOutcome(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
+	dexkeeperutils.UpdateAccountVolumesFromSettlements(ctx, dexkeeper, typedContractAddr, pair, totalOutcome.Settlements)
+	dexkeeperutils.UpdateTriggerBookFromExecutionOutcome(ctx, dexkeeper, typedContractAddr, pair, orders.Get(), totalOutcome)
+	dexkeeperutils.RemoveFilledAccountActiveOrders(ctx, dexkeeper, typedContractAddr, pair, totalOutcome.Settlements)
 
 	return totalOutcome.Settlements
 }
 
+// markNativelyCancelledOrders marks orders that the contract has accepted but that are cancelled
+// by the order matching itself as cancelled, so that the contract is notified of the cancellation
+// along with unfulfilled market orders.
+func markNativelyCancelledOrders(
+	ctx sdk.Context,
+	blockOrders *dexcache.BlockOrders,
+	cancelledOrders []*types.Order,
+	eventType string,
+	reason string,
+) {
+	if len(cancelledOrders) == 0 {
+		return
+	}
+	unsuccessfulOrders := []types.UnsuccessfulOrder{}
+	events := []sdk.Event{}
+	for _, order := range cancelledOrders {
+		unsuccessfulOrders = append(unsuccessfulOrders, types.UnsuccessfulOrder{ID: order.Id, Reason: reason})
+		events = append(events, sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(order.Id)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, order.ContractAddr),
+			sdk.NewAttribute(types.AttributeKeyPriceDenom, order.PriceDenom),
+			sdk.NewAttribute(types.AttributeKeyAssetDenom, order.AssetDenom),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		))
+	}
+	blockOrders.MarkCancelled(unsuccessfulOrders)
+	ctx.EventManager().EmitEvents(events)
+}
+
 func cancelForPair(
 	ctx sdk.Context,
 	keeper *keeper.Keeper,
@@ -73,7 +130,10 @@ func cancelForPair(
 	pair types.Pair,
 ) {
 	cancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, contractAddress, pair)
-	exchange.CancelOrders(ctx, keeper, contractAddress, pair, cancels.Get())
+	emitCancellationEvents(ctx, exchange.CancelOrders(ctx, keeper, contractAddress, pair, cancels.Get()))
+	for _, cancel := range cancels.Get() {
+		keeper.RemoveTriggeredOrder(ctx, string(contractAddress), cancel.Id, pair.PriceDenom, pair.AssetDenom)
+	}
 }
 
 func matchMarketOrderForPair(
@@ -81,6 +141,7 @@ func matchMarketOrderForPair(
 	typedContractAddr types.ContractAddress,
 	pair types.Pair,
 	orderbook *types.OrderBook,
+	fees *exchange.FeeCalculator,
 ) exchange.ExecutionOutcome {
 	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
 	marketBuys := orders.GetSortedMarketOrders(types.PositionDirection_LONG)
@@ -91,6 +152,7 @@ func matchMarketOrderForPair(
 		orderbook.Shorts,
 		types.PositionDirection_LONG,
 		orders,
+		fees,
 	)
 	marketSellOutcome := exchange.MatchMarketOrders(
 		ctx,
@@ -98,10 +160,39 @@ func matchMarketOrderForPair(
 		orderbook.Longs,
 		types.PositionDirection_SHORT,
 		orders,
+		fees,
 	)
 	return marketBuyOutcome.Merge(&marketSellOutcome)
 }
 
+// matchBatchAuctionForPair fills the crossing limit and market orders of a batch auction pair at a
+// single clearing price. Fill-or-kill market orders are cancelled since a batch auction can't
+// guarantee that they are filled in full.
+func matchBatchAuctionForPair(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	orderbook *types.OrderBook,
+	fees *exchange.FeeCalculator,
+) exchange.ExecutionOutcome {
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	marketBuys, fillOrKillBuys := splitFillOrKillOrders(orders.GetSortedMarketOrders(types.PositionDirection_LONG))
+	marketSells, fillOrKillSells := splitFillOrKillOrders(orders.GetSortedMarketOrders(types.PositionDirection_SHORT))
+	markNativelyCancelledOrders(ctx, orders, append(fillOrKillBuys, fillOrKillSells...), types.EventTypeCancelOrder, types.FillOrKillBatchAuctionReason)
+	return exchange.MatchBatchAuction(ctx, orderbook, marketBuys, marketSells, orders, fees)
+}
+
+func splitFillOrKillOrders(marketOrders []*types.Order) (others []*types.Order, fillOrKill []*types.Order) {
+	for _, order := range marketOrders {
+		if order.OrderType == types.OrderType_FOKMARKET || order.OrderType == types.OrderType_FOKMARKETBYVALUE {
+			fillOrKill = append(fillOrKill, order)
+		} else {
+			others = append(others, order)
+		}
+	}
+	return others, fillOrKill
+}
+
 func GetMatchResults(
 	ctx sdk.Context,
 	typedContractAddr types.ContractAddress,
@@ -166,6 +257,8 @@ func ExecutePairsInParallel(ctx sdk.Context, contractAddr string, dexkeeper *kee
 	return settlements
 }
 
+// HandleExecutionForContract calls the contract's pre-execution hooks and matches its pairs. If a
+// sudo call to the contract fails, the phase it failed in is returned along with the error.
 func HandleExecutionForContract(
 	ctx context.Context,
 	sdkCtx sdk.Context,
@@ -174,19 +267,29 @@ func HandleExecutionForContract(
 	registeredPairs []types.Pair,
 	orderBooks *datastructures.TypedSyncMap[types.PairString, *types.OrderBook],
 	tracer *otrace.Tracer,
-) ([]*types.SettlementEntry, error) {
+) ([]*types.SettlementEntry, types.ContractFailurePhase, error) {
 	executionStart := time.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, executionStart, "handle_execution_for_contract_ms")
 	contractAddr := contract.ContractAddr
 
+	// Orders triggered in the previous block are matched as regular orders in this block, unless
+	// matching of their pair is halted
+	for _, pair := range registeredPairs {
+		if dexkeeper.IsPairHalted(sdkCtx, contractAddr, pair.PriceDenom, pair.AssetDenom) {
+			continue
+		}
+		dexkeeperutils.MoveTriggeredOrdersToBlockOrders(sdkCtx, dexkeeper, types.ContractAddress(contractAddr), pair)
+	}
+
 	// Call contract hooks so that contracts can do internal bookkeeping
-	if err := CallPreExecutionHooks(ctx, sdkCtx, contractAddr, dexkeeper, registeredPairs, tracer); err != nil {
-		return []*types.SettlementEntry{}, err
+	phase, err := CallPreExecutionHooks(ctx, sdkCtx, contractAddr, dexkeeper, registeredPairs, tracer)
+	if err != nil {
+		return []*types.SettlementEntry{}, phase, err
 	}
 	settlements := ExecutePairsInParallel(sdkCtx, contractAddr, dexkeeper, registeredPairs, orderBooks)
 	defer EmitSettlementMetrics(settlements)
 
-	return settlements, nil
+	return settlements, phase, nil
 }
 
 // Emit metrics for settlements
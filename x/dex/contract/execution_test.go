@@ -168,6 +168,13 @@ func TestExecutePair(t *testing.T) {
 	require.Equal(t, 2, len(settlements))
 	require.Equal(t, uint64(7), settlements[0].OrderId)
 	require.Equal(t, uint64(3), settlements[1].OrderId)
+	volume, found := dexkeeper.GetVolumeState(ctx, TEST_CONTRACT, TestTimestamp, pair)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(1), volume.Quantity)
+	require.Equal(t, sdk.NewDec(101), volume.Notional)
+	candles := dexkeeper.GetLatestCandles(ctx, TEST_CONTRACT, pair, types.CandleInterval_ONE_MINUTE, 1)
+	require.Equal(t, 1, len(candles))
+	require.Equal(t, sdk.NewDec(1), *candles[0].Volume)
 
 	// get match results
 	matches, cancels := contract.GetMatchResults(
@@ -179,6 +186,587 @@ func TestExecutePair(t *testing.T) {
 	require.Equal(t, 0, len(cancels))
 }
 
+func TestExecutePairWithStopOrders(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortOrderBookEntry(ctx, TEST_CONTRACT, &types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(101),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  1,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders.Add(&types.Order{
+		Id:                2,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.MustNewDecFromStr("200"),
+		Quantity:          sdk.MustNewDecFromStr("1"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+	// triggers once the price falls to 102
+	blockOrders.Add(&types.Order{
+		Id:                3,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.ZeroDec(),
+		Quantity:          sdk.MustNewDecFromStr("1"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_STOPLOSS,
+		PositionDirection: types.PositionDirection_SHORT,
+		TriggerPrice:      sdk.MustNewDecFromStr("102"),
+	})
+	// triggers once the price rises to 110
+	blockOrders.Add(&types.Order{
+		Id:                4,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.MustNewDecFromStr("111"),
+		Quantity:          sdk.MustNewDecFromStr("1"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_STOPLIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.MustNewDecFromStr("110"),
+	})
+
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	settlements := contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Equal(t, 2, len(settlements))
+	triggerBook := dexkeeper.GetAllTriggeredOrdersForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 2, len(triggerBook))
+	require.Equal(t, uint64(3), triggerBook[0].Id)
+	require.True(t, triggerBook[0].TriggerStatus)
+	require.Equal(t, uint64(4), triggerBook[1].Id)
+	require.False(t, triggerBook[1].TriggerStatus)
+
+	// the triggered order is matched as a market order in the next block
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	keeperutil.MoveTriggeredOrdersToBlockOrders(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	orders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair).Get()
+	require.Equal(t, 1, len(orders))
+	require.Equal(t, uint64(3), orders[0].Id)
+	require.Equal(t, types.OrderType_MARKET, orders[0].OrderType)
+	require.True(t, orders[0].TriggerStatus)
+
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	triggerBook = dexkeeper.GetAllTriggeredOrdersForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 1, len(triggerBook))
+	require.Equal(t, uint64(4), triggerBook[0].Id)
+
+	// cancelled stop orders are removed from the trigger book
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).Add(&types.Cancellation{
+		Id:                4,
+		Creator:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.MustNewDecFromStr("111"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Empty(t, dexkeeper.GetAllTriggeredOrdersForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom))
+}
+
+func TestExecutePairWithTimeInForce(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortOrderBookEntry(ctx, TEST_CONTRACT, &types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(101),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  1,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	// partially filled, with the remainder cancelled
+	blockOrders.Add(&types.Order{
+		Id:                2,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.MustNewDecFromStr("101"),
+		Quantity:          sdk.MustNewDecFromStr("8"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		TimeInForce:       types.TimeInForce_IMMEDIATE_OR_CANCEL,
+	})
+	// would cross order 2
+	blockOrders.Add(&types.Order{
+		Id:                3,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.MustNewDecFromStr("100"),
+		Quantity:          sdk.MustNewDecFromStr("1"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_SHORT,
+		PostOnly:          true,
+	})
+	blockOrders.Add(&types.Order{
+		Id:                4,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.MustNewDecFromStr("99"),
+		Quantity:          sdk.MustNewDecFromStr("1"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		TimeInForce:       types.TimeInForce_GOOD_TILL_HEIGHT,
+		ExpiryHeight:      5,
+	})
+	blockOrders.Add(&types.Order{
+		Id:                5,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.MustNewDecFromStr("98"),
+		Quantity:          sdk.MustNewDecFromStr("1"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		TimeInForce:       types.TimeInForce_GOOD_TILL_TIME,
+		ExpiryTimestamp:   TestTimestamp + 60,
+	})
+
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	settlements := contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Equal(t, 2, len(settlements))
+	require.Equal(t, types.OrderStatus_CANCELLED, blockOrders.GetByID(2).Status)
+	require.Equal(t, types.ImmediateOrCancelRemainderReason, blockOrders.GetByID(2).StatusDescription)
+	require.Equal(t, types.OrderStatus_CANCELLED, blockOrders.GetByID(3).Status)
+	require.Equal(t, types.PostOnlyRejectionReason, blockOrders.GetByID(3).StatusDescription)
+	require.Equal(t, types.OrderStatus_PLACED, blockOrders.GetByID(4).Status)
+	require.Equal(t, types.OrderStatus_PLACED, blockOrders.GetByID(5).Status)
+	longBook := dexkeeper.GetAllLongBookForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 2, len(longBook))
+	require.Equal(t, sdk.NewDec(98), longBook[0].GetPrice())
+	require.Equal(t, sdk.NewDec(99), longBook[1].GetPrice())
+	require.Empty(t, dexkeeper.GetAllShortBookForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom))
+
+	// natively cancelled orders are cancelled in the contract as well
+	contract.PrepareCancelUnfulfilledMarketOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair, contract.GetOrderIDToSettledQuantities(settlements))
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).GetIdsToCancel()
+	require.Equal(t, []uint64{2, 3}, cancels)
+
+	// the good-till-height order is cancelled once its expiry height has passed
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	ctx = ctx.WithBlockHeight(5)
+	require.False(t, keeperutil.CancelExpiredOrders(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair))
+	ctx = ctx.WithBlockHeight(6)
+	require.True(t, keeperutil.CancelExpiredOrders(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair))
+	expired := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).Get()
+	require.Equal(t, 1, len(expired))
+	require.Equal(t, uint64(4), expired[0].Id)
+	require.Equal(t, types.CancellationInitiator_EXPIRED, expired[0].Initiator)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Equal(t, 1, len(ctx.EventManager().Events()))
+	require.Equal(t, types.EventTypeExpireOrder, ctx.EventManager().Events()[0].Type)
+	require.Equal(t, "4", getEventAttribute(ctx.EventManager().Events()[0], types.AttributeKeyOrderID))
+	require.Equal(t, types.ExpiredOrderReason, getEventAttribute(ctx.EventManager().Events()[0], types.AttributeKeyReason))
+	longBook = dexkeeper.GetAllLongBookForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 1, len(longBook))
+	require.Equal(t, sdk.NewDec(98), longBook[0].GetPrice())
+	require.Equal(t, 1, len(dexkeeper.GetAllExpiringOrders(ctx, TEST_CONTRACT)))
+
+	// the good-till-time order is cancelled once its expiry time has passed
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	ctx = ctx.WithBlockHeight(7).WithBlockTime(time.Unix(int64(TestTimestamp+60), 0))
+	require.False(t, keeperutil.CancelExpiredOrders(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair))
+	ctx = ctx.WithBlockHeight(8).WithBlockTime(time.Unix(int64(TestTimestamp+61), 0))
+	require.True(t, keeperutil.CancelExpiredOrders(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair))
+	expired = dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).Get()
+	require.Equal(t, 1, len(expired))
+	require.Equal(t, uint64(5), expired[0].Id)
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Empty(t, dexkeeper.GetAllLongBookForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom))
+	require.Empty(t, dexkeeper.GetAllExpiringOrders(ctx, TEST_CONTRACT))
+	require.Equal(t, uint64(0), dexkeeper.GetOrderCountState(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom, types.PositionDirection_LONG, sdk.NewDec(98)))
+}
+
+func TestExecutePairEvents(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortOrderBookEntry(ctx, TEST_CONTRACT, &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(100),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  1,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	dexkeeper.SetLongOrderBookEntry(ctx, TEST_CONTRACT, &types.LongBook{
+		Price: sdk.NewDec(90),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(90),
+			Quantity: sdk.NewDec(1),
+			Allocations: []*types.Allocation{{
+				OrderId:  2,
+				Account:  "def",
+				Quantity: sdk.NewDec(1),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).Add(&types.Cancellation{
+		Id:                2,
+		Initiator:         types.CancellationInitiator_USER,
+		Creator:           "def",
+		ContractAddr:      TEST_CONTRACT,
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		PositionDirection: types.PositionDirection_LONG,
+		Price:             sdk.NewDec(90),
+	})
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders.Add(&types.Order{
+		Id:                3,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.MustNewDecFromStr("100"),
+		Quantity:          sdk.MustNewDecFromStr("2"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+	blockOrders.Add(&types.Order{
+		Id:                4,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.MustNewDecFromStr("100"),
+		Quantity:          sdk.MustNewDecFromStr("4"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, 5, len(events))
+	require.Equal(t, types.EventTypeCancelOrder, events[0].Type)
+	require.Equal(t, "2", getEventAttribute(events[0], types.AttributeKeyOrderID))
+	require.Equal(t, types.UserCancellationReason, getEventAttribute(events[0], types.AttributeKeyReason))
+	for i, expected := range []struct {
+		orderID      string
+		counterparty string
+		quantity     string
+		liquidity    string
+		fillType     string
+	}{
+		// the market order takes 2 of order 1, and order 4 takes the remaining 3
+		{"1", "3", "2.000000000000000000", types.AttributeValueMaker, types.AttributeValuePartialFill},
+		{"3", "1", "2.000000000000000000", types.AttributeValueTaker, types.AttributeValueFullFill},
+		{"1", "4", "3.000000000000000000", types.AttributeValueMaker, types.AttributeValueFullFill},
+		{"4", "1", "3.000000000000000000", types.AttributeValueTaker, types.AttributeValuePartialFill},
+	} {
+		event := events[i+1]
+		require.Equal(t, types.EventTypeFillOrder, event.Type)
+		require.Equal(t, expected.orderID, getEventAttribute(event, types.AttributeKeyOrderID))
+		require.Equal(t, expected.counterparty, getEventAttribute(event, types.AttributeKeyCounterpartyOrderID))
+		require.Equal(t, "100.000000000000000000", getEventAttribute(event, types.AttributeKeyPrice))
+		require.Equal(t, expected.quantity, getEventAttribute(event, types.AttributeKeyQuantity))
+		require.Equal(t, expected.liquidity, getEventAttribute(event, types.AttributeKeyLiquidity))
+		require.Equal(t, expected.fillType, getEventAttribute(event, types.AttributeKeyFillType))
+	}
+}
+
+func TestExecutePairWithSelfTradePrevention(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom:          "USDC",
+		AssetDenom:          "ATOM",
+		SelfTradePrevention: types.SelfTradePrevention_CANCEL_MAKER,
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortOrderBookEntry(ctx, TEST_CONTRACT, &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(100),
+			Quantity: sdk.NewDec(10),
+			Allocations: []*types.Allocation{{
+				OrderId:  1,
+				Account:  TEST_ACCOUNT,
+				Quantity: sdk.NewDec(5),
+			}, {
+				OrderId:  2,
+				Account:  "def",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders.Add(&types.Order{
+		Id:                3,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.MustNewDecFromStr("100"),
+		Quantity:          sdk.MustNewDecFromStr("2"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	settlements := contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+
+	// the resting order of the same account is cancelled and the market order fills against "def"
+	require.Equal(t, 2, len(settlements))
+	for _, settlement := range settlements {
+		require.NotEqual(t, uint64(1), settlement.OrderId)
+	}
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(1), cancels[0].Id)
+	require.Equal(t, types.CancellationInitiator_SELF_TRADE_PREVENTION, cancels[0].Initiator)
+	require.Equal(t, TEST_ACCOUNT, cancels[0].Creator)
+	shortBook := dexkeeper.GetAllShortBookForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 1, len(shortBook))
+	require.Equal(t, sdk.NewDec(3), shortBook[0].GetOrderEntry().Quantity)
+
+	cancelEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCancelOrder {
+			cancelEvents++
+			require.Equal(t, "1", getEventAttribute(event, types.AttributeKeyOrderID))
+			require.Equal(t, types.SelfTradePreventionReason, getEventAttribute(event, types.AttributeKeyReason))
+		}
+	}
+	require.Equal(t, 1, cancelEvents)
+}
+
+func TestExecutePairWithPartialSelfTradeDecrement(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom:          "USDC",
+		AssetDenom:          "ATOM",
+		SelfTradePrevention: types.SelfTradePrevention_DECREMENT_AND_CANCEL,
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortOrderBookEntry(ctx, TEST_CONTRACT, &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(100),
+			Quantity: sdk.NewDec(10),
+			Allocations: []*types.Allocation{{
+				OrderId:  1,
+				Account:  TEST_ACCOUNT,
+				Quantity: sdk.NewDec(5),
+			}, {
+				OrderId:  2,
+				Account:  "def",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders.Add(&types.Order{
+		Id:                3,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      TEST_CONTRACT,
+		Price:             sdk.MustNewDecFromStr("100"),
+		Quantity:          sdk.MustNewDecFromStr("2"),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	settlements := contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+
+	// the market order is decremented by 2 against the resting order of the same account, which
+	// keeps the rest of its quantity and is reported to the contract as reduced
+	require.Empty(t, settlements)
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(TEST_CONTRACT), pair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(1), cancels[0].Id)
+	require.Equal(t, types.CancellationInitiator_SELF_TRADE_PREVENTION, cancels[0].Initiator)
+	require.Equal(t, TEST_ACCOUNT, cancels[0].Creator)
+	require.Equal(t, types.PositionDirection_SHORT, cancels[0].PositionDirection)
+	require.Equal(t, sdk.NewDec(100), cancels[0].Price)
+	require.NotNil(t, cancels[0].Quantity)
+	require.Equal(t, sdk.NewDec(2), *cancels[0].Quantity)
+	shortBook := dexkeeper.GetAllShortBookForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 1, len(shortBook))
+	require.Equal(t, sdk.NewDec(8), shortBook[0].GetOrderEntry().Quantity)
+	require.Equal(t, []*types.Allocation{{
+		OrderId:  1,
+		Account:  TEST_ACCOUNT,
+		Quantity: sdk.NewDec(3),
+	}, {
+		OrderId:  2,
+		Account:  "def",
+		Quantity: sdk.NewDec(5),
+	}}, shortBook[0].GetOrderEntry().Allocations)
+	cancelEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCancelOrder && getEventAttribute(event, types.AttributeKeyOrderID) == "1" {
+			cancelEvents++
+			require.Equal(t, "2.000000000000000000", getEventAttribute(event, types.AttributeKeyQuantity))
+		}
+	}
+	require.Equal(t, 1, cancelEvents)
+}
+
+func TestExecutePairWithCircuitBreaker(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetPriceState(ctx, types.Price{
+		SnapshotTimestampInSeconds: TestTimestamp - 100,
+		Price:                      sdk.NewDec(100),
+		Pair:                       &pair,
+	}, TEST_CONTRACT)
+	dexkeeper.SetCircuitBreaker(ctx, types.CircuitBreaker{
+		ContractAddr:        TEST_CONTRACT,
+		PriceDenom:          pair.PriceDenom,
+		AssetDenom:          pair.AssetDenom,
+		MaxPriceDeviation:   sdk.MustNewDecFromStr("0.2"),
+		TwapLookbackSeconds: 600,
+		CooldownSeconds:     60,
+	})
+	dexkeeper.SetShortOrderBookEntry(ctx, TEST_CONTRACT, &types.ShortBook{
+		Price: sdk.NewDec(150),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(150),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  1,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	newOrder := func(id uint64, orderType types.OrderType) *types.Order {
+		return &types.Order{
+			Id:                id,
+			Account:           TEST_ACCOUNT,
+			ContractAddr:      TEST_CONTRACT,
+			Price:             sdk.NewDec(150),
+			Quantity:          sdk.NewDec(1),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			OrderType:         orderType,
+			PositionDirection: types.PositionDirection_LONG,
+		}
+	}
+
+	// a fill 50% above the TWAP trips the circuit breaker, but the fill itself goes through
+	dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair).Add(newOrder(2, types.OrderType_MARKET))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	settlements := contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Equal(t, 2, len(settlements))
+	halt, found := dexkeeper.GetPairHalt(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(150), halt.Price)
+	require.Equal(t, sdk.NewDec(100), halt.Twap)
+	require.Equal(t, TestTimestamp+60, halt.HaltedUntilTimestamp)
+	haltEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeHaltPair {
+			haltEvents++
+			require.Equal(t, "10060", getEventAttribute(event, types.AttributeKeyHaltedUntilTimestamp))
+		}
+	}
+	require.Equal(t, 1, haltEvents)
+
+	// while halted, limit orders queue on the book and market orders are cancelled
+	ctx = ctx.WithBlockTime(time.Unix(int64(TestTimestamp)+10, 0)).WithEventManager(sdk.NewEventManager())
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	blockOrders.Add(newOrder(3, types.OrderType_LIMIT))
+	blockOrders.Add(newOrder(4, types.OrderType_MARKET))
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	settlements = contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Empty(t, settlements)
+	longBook := dexkeeper.GetAllLongBookForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 1, len(longBook))
+	require.Equal(t, sdk.NewDec(150), longBook[0].GetPrice())
+	require.Equal(t, types.OrderStatus_CANCELLED, blockOrders.GetByID(4).Status)
+	require.NotEqual(t, types.OrderStatus_CANCELLED, blockOrders.GetByID(3).Status)
+
+	// matching resumes once the cooldown is over
+	ctx = ctx.WithBlockTime(time.Unix(int64(TestTimestamp)+60, 0)).WithEventManager(sdk.NewEventManager())
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	settlements = contract.ExecutePair(ctx, TEST_CONTRACT, pair, dexkeeper, orderbook)
+	require.Equal(t, 2, len(settlements))
+	require.Equal(t, types.EventTypeResumePair, ctx.EventManager().Events()[0].Type)
+}
+
+func getEventAttribute(event sdk.Event, key string) string {
+	for _, attribute := range event.Attributes {
+		if string(attribute.Key) == key {
+			return string(attribute.Value)
+		}
+	}
+	return ""
+}
+
 func TestExecutePairInParallel(t *testing.T) {
 	pair := types.Pair{
 		PriceDenom: "USDC",
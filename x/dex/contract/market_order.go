@@ -18,7 +18,18 @@ func PrepareCancelUnfulfilledMarketOrders(
 	pair types.Pair,
 	orderIDToSettledQuantities map[uint64]sdk.Dec,
 ) {
+	// resting orders cancelled by self-trade prevention during matching still need to be reported
+	blockCancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair)
+	selfTradeCancellations := []*types.Cancellation{}
+	for _, cancellation := range blockCancels.Get() {
+		if cancellation.Initiator == types.CancellationInitiator_SELF_TRADE_PREVENTION {
+			selfTradeCancellations = append(selfTradeCancellations, cancellation)
+		}
+	}
 	dexutils.GetMemState(ctx.Context()).ClearCancellationForPair(ctx, typedContractAddr, pair)
+	for _, cancellation := range selfTradeCancellations {
+		dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Add(cancellation)
+	}
 	for _, marketOrderID := range getUnfulfilledPlacedMarketOrderIds(ctx, typedContractAddr, pair, orderIDToSettledQuantities) {
 		dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Add(&types.Cancellation{
 			Id:        marketOrderID,
@@ -38,6 +49,12 @@ func getUnfulfilledPlacedMarketOrderIds(
 		if order.Status == types.OrderStatus_FAILED_TO_PLACE {
 			continue
 		}
+		// limit orders cancelled during matching (e.g. post-only or immediate-or-cancel) also
+		// need to be cancelled in the contract
+		if order.Status == types.OrderStatus_CANCELLED {
+			res = append(res, order.Id)
+			continue
+		}
 		if order.OrderType == types.OrderType_MARKET || order.OrderType == types.OrderType_FOKMARKET {
 			if settledQuantity, ok := orderIDToSettledQuantities[order.Id]; !ok || settledQuantity.LT(order.Quantity) {
 				res = append(res, order.Id)
@@ -0,0 +1,68 @@
+package contract
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// handleSelfTradeCancellations reports the orders removed from the order book by self-trade
+// prevention during matching. Orders placed in this block are marked as cancelled like other
+// orders cancelled by the matching itself, while resting orders from earlier blocks are added to
+// the block's cancellations. Orders that are only reduced are added to the block's cancellations
+// with the quantity they were reduced by. Returns the IDs of all removed orders.
+func handleSelfTradeCancellations(
+	ctx sdk.Context,
+	dexkeeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	orderbook *types.OrderBook,
+	blockOrders *dexcache.BlockOrders,
+) map[uint64]struct{} {
+	blockOrdersByID := map[uint64]*types.Order{}
+	for _, order := range blockOrders.Get() {
+		blockOrdersByID[order.Id] = order
+	}
+	cancelledIDs := map[uint64]struct{}{}
+	cancelledBlockOrders := []*types.Order{}
+	cancellations := []*types.Cancellation{}
+	collect := func(direction types.PositionDirection, entries *types.CachedSortedOrderBookEntries) {
+		for _, cancelled := range entries.TakeSelfTradeCancellations() {
+			cancellation := &types.Cancellation{
+				Id:                cancelled.OrderID,
+				Initiator:         types.CancellationInitiator_SELF_TRADE_PREVENTION,
+				Creator:           cancelled.Account,
+				ContractAddr:      string(contractAddr),
+				PriceDenom:        pair.PriceDenom,
+				AssetDenom:        pair.AssetDenom,
+				PositionDirection: direction,
+				Price:             cancelled.Price,
+			}
+			if cancelled.Partial {
+				reduced := cancelled.Amount
+				cancellation.Quantity = &reduced
+				cancellations = append(cancellations, cancellation)
+				continue
+			}
+			cancelledIDs[cancelled.OrderID] = struct{}{}
+			dexkeeper.RemoveAccountActiveOrder(ctx, string(contractAddr), pair, cancelled.Account, cancelled.OrderID)
+			if order, ok := blockOrdersByID[cancelled.OrderID]; ok {
+				cancelledBlockOrders = append(cancelledBlockOrders, order)
+				continue
+			}
+			cancellations = append(cancellations, cancellation)
+		}
+	}
+	collect(types.PositionDirection_LONG, orderbook.Longs)
+	collect(types.PositionDirection_SHORT, orderbook.Shorts)
+
+	markNativelyCancelledOrders(ctx, blockOrders, cancelledBlockOrders, types.EventTypeCancelOrder, types.SelfTradePreventionReason)
+	blockCancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, contractAddr, pair)
+	for _, cancellation := range cancellations {
+		blockCancels.Add(cancellation)
+	}
+	emitCancellationEvents(ctx, cancellations)
+	return cancelledIDs
+}
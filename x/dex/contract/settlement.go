@@ -13,7 +13,36 @@ func HandleSettlements(
 	dexkeeper *keeper.Keeper,
 	settlements []*types.SettlementEntry,
 ) error {
-	return callSettlementHook(ctx, contractAddr, dexkeeper, settlements)
+	if err := callSettlementHook(ctx, contractAddr, dexkeeper, settlements); err != nil {
+		return err
+	}
+	if err := collectFees(ctx, contractAddr, dexkeeper, settlements); err != nil {
+		return err
+	}
+	dexkeeper.AddAccountTrades(ctx, contractAddr, settlements)
+	return nil
+}
+
+// collectFees moves the fees charged in settlements, which the contract deducts from the
+// accounts it settles, from the contract to the dex fee collector
+func collectFees(
+	ctx sdk.Context,
+	contractAddr string,
+	dexkeeper *keeper.Keeper,
+	settlements []*types.SettlementEntry,
+) error {
+	fees, err := dexkeeperutils.GetFeesToCollect(settlements)
+	if err != nil {
+		return err
+	}
+	if fees.IsZero() {
+		return nil
+	}
+	contract, err := sdk.AccAddressFromBech32(contractAddr)
+	if err != nil {
+		return err
+	}
+	return dexkeeper.BankKeeper.SendCoinsFromAccountToModule(ctx, contract, types.FeeCollectorName, fees)
 }
 
 func callSettlementHook(
@@ -12,15 +12,26 @@ import (
 var DexWhitelistedKeys = []string{
 	types.LongBookKey,
 	types.ShortBookKey,
+	types.TriggerBookKey,
+	types.TriggeredOrderKey,
+	types.ExpiringOrderKey,
+	types.ExpiringOrderByTimeKey,
 	types.OrderKey,
 	types.AccountActiveOrdersKey,
 	types.CancelKey,
 	types.TwapKey,
 	types.PriceKey,
+	types.VolumeKey,
+	types.CandleKey,
 	types.NextOrderIDKey,
 	types.MatchResultKey,
 	types.LongOrderCountKey,
 	types.ShortOrderCountKey,
+	types.FeeScheduleKey,
+	types.AccountVolumeKey,
+	types.CircuitBreakerKey,
+	types.PairHaltKey,
+	types.RentLedgerKey,
 	keeper.ContractPrefixKey,
 }
 
@@ -76,9 +87,13 @@ func GetWasmWhitelistedPrefixes(contractAddr string) []string {
 
 func GetDexPerPairWhitelistedPrefixes(contractAddr string, pair types.Pair) []string {
 	return utils.Map(DexWhitelistedKeys, func(key string) string {
-		return string(append(append(
-			types.KeyPrefix(key), types.AddressKeyPrefix(contractAddr)...,
-		), types.PairPrefix(pair.PriceDenom, pair.AssetDenom)...))
+		contractPrefix := append(types.KeyPrefix(key), types.AddressKeyPrefix(contractAddr)...)
+		// the account active orders index is keyed by account before pair, so the entries of a pair
+		// can't be singled out by prefix
+		if key == types.AccountActiveOrdersKey {
+			return string(contractPrefix)
+		}
+		return string(append(contractPrefix, types.PairPrefix(pair.PriceDenom, pair.AssetDenom)...))
 	})
 }
 
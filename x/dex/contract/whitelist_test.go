@@ -2,10 +2,12 @@ package contract_test
 
 import (
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,3 +28,19 @@ func TestGetDexPrefixes(t *testing.T) {
 		require.Equal(t, string(prefix), dexWhitelistedPrefixes[i])
 	}
 }
+
+func TestGetDexPerPairPrefixes(t *testing.T) {
+	contractAddr := "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m"
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}
+	dexWhitelistedPrefixes := contract.GetDexPerPairWhitelistedPrefixes(contractAddr, pair)
+
+	for i, dexKey := range contract.DexWhitelistedKeys {
+		prefix := append(types.KeyPrefix(dexKey), types.AddressKeyPrefix(contractAddr)...)
+		if dexKey == types.AccountActiveOrdersKey {
+			require.Equal(t, string(prefix), dexWhitelistedPrefixes[i])
+			require.True(t, strings.HasPrefix(string(types.AccountActiveOrdersPrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, contractAddr)), dexWhitelistedPrefixes[i]))
+			continue
+		}
+		require.Equal(t, string(append(prefix, types.PairPrefix(pair.PriceDenom, pair.AssetDenom)...)), dexWhitelistedPrefixes[i])
+	}
+}
@@ -0,0 +1,249 @@
+package exchange
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// MatchBatchAuction matches all crossing limit and market orders of a pair at a single clearing
+// price. Buy and sell interest is crossed in price-time priority the same way the continuous
+// matching crosses it, which executes the largest possible quantity, and every fill is then
+// settled at the midpoint between the lowest price any filled buyer accepted and the highest price
+// any filled seller accepted, at which all filled orders are willing to trade and no unfilled
+// orders cross. Market orders without a worst price don't bound the price, so nothing is filled if
+// only such orders cross. Fill-or-kill market orders are not supported and must not be passed in.
+// Self-trade prevention doesn't apply: pairs matched by batch auction are required to have it
+// disabled, and orders of the same account are filled against each other like any others.
+func MatchBatchAuction(
+	ctx sdk.Context,
+	orderbook *types.OrderBook,
+	marketBuys []*types.Order,
+	marketSells []*types.Order,
+	blockOrders *cache.BlockOrders,
+	fees *FeeCalculator,
+) ExecutionOutcome {
+	buys := newAuctionSide(types.PositionDirection_LONG, marketBuys, orderbook.Longs)
+	sells := newAuctionSide(types.PositionDirection_SHORT, marketSells, orderbook.Shorts)
+	matches := []auctionMatch{}
+	totalExecuted := sdk.ZeroDec()
+	var lowestBuyPrice, highestSellPrice *sdk.Dec
+	for buy, sell := buys.head(ctx), sells.head(ctx); buy != nil && sell != nil && buy.crosses(sell); buy, sell = buys.head(ctx), sells.head(ctx) {
+		executed := sdk.MinDec(buy.quantity, sell.quantity)
+		totalExecuted = totalExecuted.Add(executed)
+		if !buy.price.IsZero() {
+			lowestBuyPrice = &buy.price
+		}
+		if !sell.price.IsZero() {
+			highestSellPrice = &sell.price
+		}
+		matches = append(matches, pairAuctionFills(buys.take(ctx, buy, executed), sells.take(ctx, sell, executed))...)
+	}
+
+	var clearingPrice sdk.Dec
+	switch {
+	case lowestBuyPrice != nil && highestSellPrice != nil:
+		clearingPrice = lowestBuyPrice.Add(*highestSellPrice).Quo(sdk.NewDec(2))
+	case lowestBuyPrice != nil:
+		clearingPrice = *lowestBuyPrice
+	case highestSellPrice != nil:
+		clearingPrice = *highestSellPrice
+	default:
+		// only market orders without a worst price crossed, which left the order book untouched
+		orderbook.Longs.Flush(ctx)
+		orderbook.Shorts.Flush(ctx)
+		return ExecutionOutcome{
+			TotalNotional: sdk.ZeroDec(),
+			TotalQuantity: sdk.ZeroDec(),
+			Settlements:   []*types.SettlementEntry{},
+			Fills:         []Fill{},
+			MinPrice:      sdk.OneDec().Neg(),
+			MaxPrice:      sdk.OneDec().Neg(),
+		}
+	}
+
+	settlements := []*types.SettlementEntry{}
+	fills := []Fill{}
+	for _, match := range matches {
+		longSettlement := match.buy.settlementEntry(ctx, orderbook.Pair, types.PositionDirection_LONG, match.quantity, clearingPrice)
+		shortSettlement := match.sell.settlementEntry(ctx, orderbook.Pair, types.PositionDirection_SHORT, match.quantity, clearingPrice)
+		// of the two orders in each fill, the one placed earlier is charged the maker fee
+		maker, taker := longSettlement, shortSettlement
+		if shortSettlement.OrderId < longSettlement.OrderId {
+			maker, taker = shortSettlement, longSettlement
+		}
+		notional := match.quantity.Mul(clearingPrice)
+		maker.Fee = fees.MakerFee(maker.Account, notional)
+		taker.Fee = fees.TakerFee(taker.Account, notional)
+		settlements = append(settlements, longSettlement, shortSettlement)
+		fills = append(fills, Fill{Maker: *maker, Taker: *taker})
+	}
+	buys.updateMarketOrderData(blockOrders)
+	sells.updateMarketOrderData(blockOrders)
+	orderbook.Longs.Flush(ctx)
+	orderbook.Shorts.Flush(ctx)
+	return ExecutionOutcome{
+		TotalNotional: totalExecuted.Mul(clearingPrice),
+		TotalQuantity: totalExecuted,
+		Settlements:   settlements,
+		Fills:         fills,
+		MinPrice:      clearingPrice,
+		MaxPrice:      clearingPrice,
+	}
+}
+
+// auctionSide iterates over the buy or sell interest of a batch auction in priority order: market
+// orders without a worst price first, then market orders and order book entries from the best
+// price, with market orders ahead of order book entries at the same price.
+type auctionSide struct {
+	direction    types.PositionDirection
+	marketOrders []*types.Order
+	executed     []sdk.Dec
+	marketPtr    int
+	book         *types.CachedSortedOrderBookEntries
+}
+
+// auctionParticipant is a market order or an order book entry at the head of an auction side.
+// A zero price means that the participant is a market order without a worst price.
+type auctionParticipant struct {
+	price       sdk.Dec
+	quantity    sdk.Dec
+	marketOrder *types.Order
+}
+
+// auctionFill is the part of an order that is filled in a batch auction
+type auctionFill struct {
+	types.ToSettle
+	orderType types.OrderType
+	// the limit price of the order, or the worst price of a market order
+	price sdk.Dec
+}
+
+type auctionMatch struct {
+	buy      auctionFill
+	sell     auctionFill
+	quantity sdk.Dec
+}
+
+func newAuctionSide(
+	direction types.PositionDirection,
+	marketOrders []*types.Order,
+	book *types.CachedSortedOrderBookEntries,
+) *auctionSide {
+	executed := make([]sdk.Dec, len(marketOrders))
+	for i := range executed {
+		executed[i] = sdk.ZeroDec()
+	}
+	return &auctionSide{
+		direction:    direction,
+		marketOrders: marketOrders,
+		executed:     executed,
+		book:         book,
+	}
+}
+
+func (s *auctionSide) head(ctx sdk.Context) *auctionParticipant {
+	for s.marketPtr < len(s.marketOrders) && s.executed[s.marketPtr].Equal(s.marketOrders[s.marketPtr].Quantity) {
+		s.marketPtr++
+	}
+	entry := s.book.Next(ctx)
+	if s.marketPtr < len(s.marketOrders) {
+		order := s.marketOrders[s.marketPtr]
+		if entry == nil || order.Price.IsZero() || !s.isBetter(entry.GetPrice(), order.Price) {
+			return &auctionParticipant{
+				price:       order.Price,
+				quantity:    order.Quantity.Sub(s.executed[s.marketPtr]),
+				marketOrder: order,
+			}
+		}
+	}
+	if entry == nil {
+		return nil
+	}
+	return &auctionParticipant{price: entry.GetPrice(), quantity: entry.GetOrderEntry().Quantity}
+}
+
+// take fills `quantity` of the participant at the head of the side, which must be `participant`
+func (s *auctionSide) take(ctx sdk.Context, participant *auctionParticipant, quantity sdk.Dec) []auctionFill {
+	if participant.marketOrder != nil {
+		s.executed[s.marketPtr] = s.executed[s.marketPtr].Add(quantity)
+		return []auctionFill{{
+			ToSettle: types.ToSettle{
+				OrderID: participant.marketOrder.Id,
+				Account: participant.marketOrder.Account,
+				Amount:  quantity,
+			},
+			orderType: participant.marketOrder.OrderType,
+			price:     participant.price,
+		}}
+	}
+	toSettle, _ := s.book.SettleQuantity(ctx, quantity)
+	fills := []auctionFill{}
+	for _, settled := range toSettle {
+		fills = append(fills, auctionFill{ToSettle: settled, orderType: types.OrderType_LIMIT, price: participant.price})
+	}
+	return fills
+}
+
+func (s *auctionSide) isBetter(price sdk.Dec, other sdk.Dec) bool {
+	if s.direction == types.PositionDirection_LONG {
+		return price.GT(other)
+	}
+	return price.LT(other)
+}
+
+// updateMarketOrderData records the filled quantities of market orders in the memstate
+func (s *auctionSide) updateMarketOrderData(blockOrders *cache.BlockOrders) {
+	for i, order := range s.marketOrders {
+		if s.executed[i].IsPositive() {
+			UpdateOrderData(order, s.executed[i], blockOrders)
+		}
+	}
+}
+
+// crosses returns whether the buyer `p` and the seller `other` are willing to trade with each
+// other
+func (p *auctionParticipant) crosses(other *auctionParticipant) bool {
+	return p.price.IsZero() || other.price.IsZero() || p.price.GTE(other.price)
+}
+
+func (f auctionFill) settlementEntry(
+	ctx sdk.Context,
+	pair types.Pair,
+	direction types.PositionDirection,
+	quantity sdk.Dec,
+	clearingPrice sdk.Dec,
+) *types.SettlementEntry {
+	return types.NewSettlementEntry(
+		ctx,
+		f.OrderID,
+		f.Account,
+		direction,
+		pair.PriceDenom,
+		pair.AssetDenom,
+		quantity,
+		clearingPrice,
+		f.price,
+		f.orderType,
+	)
+}
+
+// pairAuctionFills pairs up buy and sell fills of the same total quantity in order
+func pairAuctionFills(buyFills []auctionFill, sellFills []auctionFill) []auctionMatch {
+	matches := []auctionMatch{}
+	buyPtr, sellPtr := 0, 0
+	for buyPtr < len(buyFills) && sellPtr < len(sellFills) {
+		buy, sell := buyFills[buyPtr], sellFills[sellPtr]
+		quantity := sdk.MinDec(buy.Amount, sell.Amount)
+		matches = append(matches, auctionMatch{buy: buy, sell: sell, quantity: quantity})
+		buyFills[buyPtr].Amount = buy.Amount.Sub(quantity)
+		sellFills[sellPtr].Amount = sell.Amount.Sub(quantity)
+		if buyFills[buyPtr].Amount.IsZero() {
+			buyPtr++
+		}
+		if sellFills[sellPtr].Amount.IsZero() {
+			sellPtr++
+		}
+	}
+	return matches
+}
@@ -0,0 +1,145 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func newBatchAuctionOrder(id uint64, account string, direction types.PositionDirection, orderType types.OrderType, price int64, quantity int64) *types.Order {
+	return &types.Order{
+		Id:                id,
+		Account:           account,
+		ContractAddr:      "test",
+		Price:             sdk.NewDec(price),
+		Quantity:          sdk.NewDec(quantity),
+		PriceDenom:        "USDC",
+		AssetDenom:        "ATOM",
+		OrderType:         orderType,
+		PositionDirection: direction,
+	}
+}
+
+func TestMatchBatchAuctionLimitOrders(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, []*types.Order{
+		newBatchAuctionOrder(1, "a", types.PositionDirection_LONG, types.OrderType_LIMIT, 110, 5),
+		newBatchAuctionOrder(2, "b", types.PositionDirection_LONG, types.OrderType_LIMIT, 100, 5),
+	}, []*types.Order{
+		newBatchAuctionOrder(3, "c", types.PositionDirection_SHORT, types.OrderType_LIMIT, 90, 4),
+		newBatchAuctionOrder(4, "d", types.PositionDirection_SHORT, types.OrderType_LIMIT, 105, 4),
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", pair)
+	fees := exchange.NewFeeCalculator(types.FeeSchedule{
+		Tiers: []types.FeeTier{{
+			MinVolume:    sdk.ZeroDec(),
+			MakerFeeRate: sdk.MustNewDecFromStr("0.001"),
+			TakerFeeRate: sdk.MustNewDecFromStr("0.002"),
+		}},
+	}, func(string) sdk.Dec { return sdk.ZeroDec() })
+
+	outcome := exchange.MatchBatchAuction(ctx, orderbook, nil, nil, blockOrders, fees)
+	// 4 of order 1 cross order 3 and 1 crosses order 4, so the price is between 105 and 110
+	clearingPrice := sdk.MustNewDecFromStr("107.5")
+	require.Equal(t, sdk.NewDec(5), outcome.TotalQuantity)
+	require.Equal(t, clearingPrice.MulInt64(5), outcome.TotalNotional)
+	require.Equal(t, clearingPrice, outcome.MinPrice)
+	require.Equal(t, clearingPrice, outcome.MaxPrice)
+	require.Equal(t, 4, len(outcome.Settlements))
+	for _, settlement := range outcome.Settlements {
+		require.Equal(t, clearingPrice, settlement.ExecutionCostOrProceed)
+	}
+	require.Equal(t, uint64(1), outcome.Settlements[0].OrderId)
+	require.Equal(t, sdk.NewDec(4), outcome.Settlements[0].Quantity)
+	require.Equal(t, sdk.NewDec(110), outcome.Settlements[0].ExpectedCostOrProceed)
+	require.Equal(t, uint64(3), outcome.Settlements[1].OrderId)
+	require.Equal(t, sdk.NewDec(90), outcome.Settlements[1].ExpectedCostOrProceed)
+	require.Equal(t, uint64(4), outcome.Settlements[3].OrderId)
+	require.Equal(t, sdk.NewDec(1), outcome.Settlements[3].Quantity)
+	// the order placed earlier in each fill is the maker
+	require.Equal(t, 2, len(outcome.Fills))
+	require.Equal(t, uint64(1), outcome.Fills[0].Maker.OrderId)
+	require.Equal(t, sdk.MustNewDecFromStr("0.43"), *outcome.Fills[0].Maker.Fee)
+	require.Equal(t, sdk.MustNewDecFromStr("0.86"), *outcome.Fills[0].Taker.Fee)
+
+	longBook := dexkeeper.GetAllLongBookForPair(ctx, "test", "USDC", "ATOM")
+	require.Equal(t, 1, len(longBook))
+	require.Equal(t, sdk.NewDec(100), longBook[0].GetPrice())
+	require.Equal(t, sdk.NewDec(5), longBook[0].GetOrderEntry().Quantity)
+	shortBook := dexkeeper.GetAllShortBookForPair(ctx, "test", "USDC", "ATOM")
+	require.Equal(t, 1, len(shortBook))
+	require.Equal(t, sdk.NewDec(105), shortBook[0].GetPrice())
+	require.Equal(t, sdk.NewDec(3), shortBook[0].GetOrderEntry().Quantity)
+}
+
+func TestMatchBatchAuctionMarketOrders(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, []*types.Order{
+		newBatchAuctionOrder(1, "a", types.PositionDirection_LONG, types.OrderType_LIMIT, 99, 1),
+	}, []*types.Order{
+		newBatchAuctionOrder(2, "b", types.PositionDirection_SHORT, types.OrderType_LIMIT, 100, 2),
+		newBatchAuctionOrder(3, "c", types.PositionDirection_SHORT, types.OrderType_LIMIT, 102, 2),
+	})
+	marketBuy := newBatchAuctionOrder(4, "d", types.PositionDirection_LONG, types.OrderType_MARKET, 0, 3)
+	marketSell := newBatchAuctionOrder(5, "e", types.PositionDirection_SHORT, types.OrderType_MARKET, 98, 1)
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", pair)
+	blockOrders.Add(marketBuy)
+	blockOrders.Add(marketSell)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+
+	outcome := exchange.MatchBatchAuction(ctx, orderbook, []*types.Order{marketBuy}, []*types.Order{marketSell}, blockOrders, nil)
+	// the market buy has no worst price, so the price is set by the sellers it is filled against.
+	// Order 1 doesn't buy at that price even though it crosses the market sell.
+	clearingPrice := sdk.NewDec(100)
+	require.Equal(t, sdk.NewDec(3), outcome.TotalQuantity)
+	require.Equal(t, clearingPrice, outcome.MinPrice)
+	require.Equal(t, 4, len(outcome.Settlements))
+	for _, settlement := range outcome.Settlements {
+		require.Equal(t, clearingPrice, settlement.ExecutionCostOrProceed)
+	}
+	require.Equal(t, uint64(4), outcome.Settlements[0].OrderId)
+	require.Equal(t, "Market", outcome.Settlements[0].OrderType)
+	require.Equal(t, uint64(5), outcome.Settlements[1].OrderId)
+	require.Equal(t, sdk.NewDec(1), outcome.Settlements[1].Quantity)
+	require.Equal(t, uint64(2), outcome.Settlements[3].OrderId)
+	require.Equal(t, sdk.NewDec(2), outcome.Settlements[3].Quantity)
+	for _, order := range blockOrders.Get() {
+		require.Equal(t, types.OrderStatus_FULFILLED, order.Status)
+	}
+
+	require.Equal(t, 1, len(dexkeeper.GetAllLongBookForPair(ctx, "test", "USDC", "ATOM")))
+	shortBook := dexkeeper.GetAllShortBookForPair(ctx, "test", "USDC", "ATOM")
+	require.Equal(t, 1, len(shortBook))
+	require.Equal(t, sdk.NewDec(102), shortBook[0].GetPrice())
+}
+
+func TestMatchBatchAuctionWithoutReferencePrice(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}
+	marketBuy := newBatchAuctionOrder(1, "a", types.PositionDirection_LONG, types.OrderType_MARKET, 0, 3)
+	marketSell := newBatchAuctionOrder(2, "b", types.PositionDirection_SHORT, types.OrderType_MARKET, 0, 3)
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", pair)
+	blockOrders.Add(marketBuy)
+	blockOrders.Add(marketSell)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+
+	outcome := exchange.MatchBatchAuction(ctx, orderbook, []*types.Order{marketBuy}, []*types.Order{marketSell}, blockOrders, nil)
+	require.True(t, outcome.TotalQuantity.IsZero())
+	require.Empty(t, outcome.Settlements)
+	for _, order := range blockOrders.Get() {
+		require.Equal(t, sdk.NewDec(3), order.Quantity)
+	}
+}
@@ -8,23 +8,29 @@ import (
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
 
+// CancelOrders removes the cancelled orders from the orderbook, and returns the cancellations that
+// actually removed an order (i.e. not the ones for orders that are already filled or cancelled)
 func CancelOrders(
 	ctx sdk.Context, keeper *keeper.Keeper, contract types.ContractAddress, pair types.Pair,
 	cancels []*types.Cancellation,
-) {
+) []*types.Cancellation {
+	applied := []*types.Cancellation{}
 	for _, cancel := range cancels {
-		cancelOrder(ctx, keeper, cancel, contract, pair)
+		if cancelOrder(ctx, keeper, cancel, contract, pair) {
+			applied = append(applied, cancel)
+		}
 	}
+	return applied
 }
 
-func cancelOrder(ctx sdk.Context, keeper *keeper.Keeper, cancellation *types.Cancellation, contract types.ContractAddress, pair types.Pair) {
+func cancelOrder(ctx sdk.Context, keeper *keeper.Keeper, cancellation *types.Cancellation, contract types.ContractAddress, pair types.Pair) bool {
 	getter, setter, deleter := keeper.GetLongOrderBookEntryByPrice, keeper.SetLongOrderBookEntry, keeper.RemoveLongBookByPrice
 	if cancellation.PositionDirection == types.PositionDirection_SHORT {
 		getter, setter, deleter = keeper.GetShortOrderBookEntryByPrice, keeper.SetShortOrderBookEntry, keeper.RemoveShortBookByPrice
 	}
 	entry, found := getter(ctx, string(contract), cancellation.Price, pair.PriceDenom, pair.AssetDenom)
 	if !found {
-		return
+		return false
 	}
 	newEntry := *entry.GetOrderEntry()
 	newAllocations := []*types.Allocation{}
@@ -33,6 +39,8 @@ func cancelOrder(ctx sdk.Context, keeper *keeper.Keeper, cancellation *types.Can
 		if allocation.OrderId != cancellation.Id {
 			newAllocations = append(newAllocations, allocation)
 			newQuantity = newQuantity.Add(allocation.Quantity)
+		} else {
+			keeper.RemoveAccountActiveOrder(ctx, string(contract), pair, allocation.Account, allocation.OrderId)
 		}
 	}
 	numAllocationsRemoved := len(newEntry.Allocations) - len(newAllocations)
@@ -44,10 +52,11 @@ func cancelOrder(ctx sdk.Context, keeper *keeper.Keeper, cancellation *types.Can
 	}
 	if newQuantity.IsZero() {
 		deleter(ctx, string(contract), entry.GetPrice(), pair.PriceDenom, pair.AssetDenom)
-		return
+		return numAllocationsRemoved > 0
 	}
 	newEntry.Quantity = newQuantity
 	newEntry.Allocations = newAllocations
 	entry.SetEntry(&newEntry)
 	setter(ctx, string(contract), entry)
+	return numAllocationsRemoved > 0
 }
@@ -0,0 +1,59 @@
+package exchange
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// FeeCalculator computes the fees charged on fills of a pair according to the pair's fee schedule
+// and the fee tier of each account. A nil calculator charges no fee.
+type FeeCalculator struct {
+	schedule     types.FeeSchedule
+	volumeGetter func(account string) sdk.Dec
+	tiers        map[string]types.FeeTier
+}
+
+// NewFeeCalculator returns a calculator for the given schedule. `volumeGetter` returns the 30-day
+// volume of an account, and is called at most once per account so that all fills of an account
+// in a block are charged the rates of the same tier.
+func NewFeeCalculator(schedule types.FeeSchedule, volumeGetter func(account string) sdk.Dec) *FeeCalculator {
+	return &FeeCalculator{
+		schedule:     schedule,
+		volumeGetter: volumeGetter,
+		tiers:        map[string]types.FeeTier{},
+	}
+}
+
+// MakerFee returns the fee charged to `account` for providing liquidity in a fill of the given
+// notional, or nil if no fee is charged
+func (f *FeeCalculator) MakerFee(account string, notional sdk.Dec) *sdk.Dec {
+	if f == nil {
+		return nil
+	}
+	return nonZeroFee(notional.Mul(f.getFeeTier(account).MakerFeeRate))
+}
+
+// TakerFee returns the fee charged to `account` for taking liquidity in a fill of the given
+// notional, or nil if no fee is charged
+func (f *FeeCalculator) TakerFee(account string, notional sdk.Dec) *sdk.Dec {
+	if f == nil {
+		return nil
+	}
+	return nonZeroFee(notional.Mul(f.getFeeTier(account).TakerFeeRate))
+}
+
+func (f *FeeCalculator) getFeeTier(account string) types.FeeTier {
+	if tier, ok := f.tiers[account]; ok {
+		return tier
+	}
+	tier := f.schedule.GetFeeTier(f.volumeGetter(account))
+	f.tiers[account] = tier
+	return tier
+}
+
+func nonZeroFee(fee sdk.Dec) *sdk.Dec {
+	if fee.IsZero() {
+		return nil
+	}
+	return &fee
+}
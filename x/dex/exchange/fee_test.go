@@ -0,0 +1,88 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testFeeCalculator() *exchange.FeeCalculator {
+	feeSchedule := types.FeeSchedule{
+		MakerFeeRate: sdk.MustNewDecFromStr("0.001"),
+		TakerFeeRate: sdk.MustNewDecFromStr("0.002"),
+		Tiers: []types.FeeTier{{
+			MinVolume:    sdk.NewDec(1000),
+			MakerFeeRate: sdk.ZeroDec(),
+			TakerFeeRate: sdk.MustNewDecFromStr("0.001"),
+		}},
+	}
+	volumes := map[string]sdk.Dec{"vip": sdk.NewDec(1000)}
+	return exchange.NewFeeCalculator(feeSchedule, func(account string) sdk.Dec {
+		if volume, ok := volumes[account]; ok {
+			return volume
+		}
+		return sdk.ZeroDec()
+	})
+}
+
+func TestFeeCalculator(t *testing.T) {
+	fees := testFeeCalculator()
+	require.Equal(t, sdk.NewDec(1), *fees.MakerFee("abc", sdk.NewDec(1000)))
+	require.Equal(t, sdk.NewDec(2), *fees.TakerFee("abc", sdk.NewDec(1000)))
+	require.Nil(t, fees.MakerFee("vip", sdk.NewDec(1000)))
+	require.Equal(t, sdk.NewDec(1), *fees.TakerFee("vip", sdk.NewDec(1000)))
+
+	var noFees *exchange.FeeCalculator
+	require.Nil(t, noFees.MakerFee("abc", sdk.NewDec(1000)))
+	require.Nil(t, noFees.TakerFee("abc", sdk.NewDec(1000)))
+}
+
+func TestMatchLimitOrdersWithFees(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	longOrders := []*types.Order{
+		{
+			Id:                1,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(10),
+			Account:           "abc",
+			PositionDirection: types.PositionDirection_LONG,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+		},
+	}
+	shortOrders := []*types.Order{
+		{
+			Id:                2,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(10),
+			Account:           "def",
+			PositionDirection: types.PositionDirection_SHORT,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+		},
+	}
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
+	outcome := exchange.MatchLimitOrders(ctx, orderbook, testFeeCalculator())
+	require.Equal(t, 2, len(outcome.Settlements))
+
+	// the long order was placed first so it is the maker of the fill
+	fees := map[uint64]sdk.Dec{}
+	for _, settlement := range outcome.Settlements {
+		require.NotNil(t, settlement.Fee)
+		fees[settlement.OrderId] = *settlement.Fee
+	}
+	require.Equal(t, sdk.NewDec(1), fees[1])
+	require.Equal(t, sdk.NewDec(2), fees[2])
+}
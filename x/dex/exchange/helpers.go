@@ -9,6 +9,7 @@ type ExecutionOutcome struct {
 	TotalNotional sdk.Dec
 	TotalQuantity sdk.Dec
 	Settlements   []*types.SettlementEntry
+	Fills         []Fill
 	MinPrice      sdk.Dec // deprecate?
 	MaxPrice      sdk.Dec // deprecate?
 }
@@ -18,7 +19,25 @@ func (o *ExecutionOutcome) Merge(other *ExecutionOutcome) ExecutionOutcome {
 		TotalNotional: o.TotalNotional.Add(other.TotalNotional),
 		TotalQuantity: o.TotalQuantity.Add(other.TotalQuantity),
 		Settlements:   append(o.Settlements, other.Settlements...),
+		Fills:         append(o.Fills, other.Fills...),
 		MinPrice:      sdk.MinDec(o.MinPrice, other.MinPrice),
 		MaxPrice:      sdk.MaxDec(o.MaxPrice, other.MaxPrice),
 	}
 }
+
+// Fill is a single match between a resting order (the maker) and an order that took its
+// liquidity (the taker). The settlement entries of both sides are copied at the time of the match,
+// so later adjustments of taker settlements (e.g. to the clearing price of market orders) are not
+// reflected, and the maker's execution price is the price of the fill.
+type Fill struct {
+	Maker types.SettlementEntry
+	Taker types.SettlementEntry
+}
+
+func newFills(takerSettlements []*types.SettlementEntry, makerSettlements []*types.SettlementEntry) []Fill {
+	fills := []Fill{}
+	for i := range takerSettlements {
+		fills = append(fills, Fill{Maker: *makerSettlements[i], Taker: *takerSettlements[i]})
+	}
+	return fills
+}
@@ -2,6 +2,7 @@ package exchange
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
@@ -11,12 +12,18 @@ import (
 func MatchLimitOrders(
 	ctx sdk.Context,
 	orderbook *types.OrderBook,
+	fees *FeeCalculator,
 ) ExecutionOutcome {
 	settlements := []*types.SettlementEntry{}
+	fills := []Fill{}
 	totalExecuted, totalPrice := sdk.ZeroDec(), sdk.ZeroDec()
 	minPrice, maxPrice := sdk.OneDec().Neg(), sdk.OneDec().Neg()
 
 	for longEntry, shortEntry := orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx); longEntry != nil && shortEntry != nil && longEntry.GetPrice().GTE(shortEntry.GetPrice()); longEntry, shortEntry = orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx) {
+		preventSelfTradeInBook(orderbook, longEntry)
+		if longEntry.GetOrderEntry().Quantity.IsZero() || shortEntry.GetOrderEntry().Quantity.IsZero() {
+			continue
+		}
 		var executed sdk.Dec
 		if longEntry.GetOrderEntry().Quantity.LT(shortEntry.GetOrderEntry().Quantity) {
 			executed = longEntry.GetOrderEntry().Quantity
@@ -40,8 +47,10 @@ func MatchLimitOrders(
 			executed,
 			longEntry.GetPrice(),
 			shortEntry.GetPrice(),
+			fees,
 		)
 		settlements = append(settlements, newSettlements...)
+		fills = append(fills, newFillsFromBook(newSettlements)...)
 	}
 
 	orderbook.Longs.Flush(ctx)
@@ -50,11 +59,26 @@ func MatchLimitOrders(
 		TotalNotional: totalPrice,
 		TotalQuantity: totalExecuted,
 		Settlements:   settlements,
+		Fills:         fills,
 		MinPrice:      minPrice,
 		MaxPrice:      maxPrice,
 	}
 }
 
+// newFillsFromBook pairs up the settlements returned by SettleFromBook into fills, where the order
+// placed earlier is the maker
+func newFillsFromBook(settlements []*types.SettlementEntry) []Fill {
+	fills := []Fill{}
+	for i := 0; i+1 < len(settlements); i += 2 {
+		maker, taker := settlements[i], settlements[i+1]
+		if taker.OrderId < maker.OrderId {
+			maker, taker = taker, maker
+		}
+		fills = append(fills, Fill{Maker: *maker, Taker: *taker})
+	}
+	return fills
+}
+
 func addOrderToOrderBookEntry(
 	ctx sdk.Context, keeper *keeper.Keeper,
 	order *types.Order,
@@ -87,17 +111,96 @@ func addOrderToOrderBookEntry(
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("error increasing order count: %s", err))
 	}
+	activeOrder := *order
+	activeOrder.PlacementHeight = ctx.BlockHeight()
+	keeper.SetAccountActiveOrder(ctx, order.ContractAddr, activeOrder)
+
+	if order.TimeInForce == types.TimeInForce_GOOD_TILL_HEIGHT || order.TimeInForce == types.TimeInForce_GOOD_TILL_TIME {
+		keeper.SetExpiringOrder(ctx, order.ContractAddr, types.ExpiringOrder{
+			ExpiryHeight:    order.ExpiryHeight,
+			ExpiryTimestamp: order.ExpiryTimestamp,
+			Cancellation: types.Cancellation{
+				Id:                order.Id,
+				Initiator:         types.CancellationInitiator_EXPIRED,
+				Creator:           order.Account,
+				ContractAddr:      order.ContractAddr,
+				PriceDenom:        order.PriceDenom,
+				AssetDenom:        order.AssetDenom,
+				PositionDirection: order.PositionDirection,
+				Price:             order.Price,
+			},
+		})
+	}
 }
 
+// AddOutstandingLimitOrdersToOrderbook adds limit orders to the orderbook. Post-only orders are
+// added after all other orders, in the order of their IDs, and are rejected if they would cross
+// the opposite side of the book at that point. Rejected post-only orders are returned.
 func AddOutstandingLimitOrdersToOrderbook(
 	ctx sdk.Context, keeper *keeper.Keeper,
 	limitBuys []*types.Order,
 	limitSells []*types.Order,
-) {
-	for _, order := range limitBuys {
+) []*types.Order {
+	postOnlyOrders := []*types.Order{}
+	for _, order := range append(append([]*types.Order{}, limitBuys...), limitSells...) {
+		if order.PostOnly {
+			postOnlyOrders = append(postOnlyOrders, order)
+			continue
+		}
 		addOrderToOrderBookEntry(ctx, keeper, order)
 	}
-	for _, order := range limitSells {
+	sort.SliceStable(postOnlyOrders, func(i, j int) bool {
+		return postOnlyOrders[i].Id < postOnlyOrders[j].Id
+	})
+	rejected := []*types.Order{}
+	for _, order := range postOnlyOrders {
+		if wouldCrossOrderbook(ctx, keeper, order) {
+			rejected = append(rejected, order)
+			continue
+		}
 		addOrderToOrderBookEntry(ctx, keeper, order)
 	}
+	return rejected
+}
+
+func wouldCrossOrderbook(ctx sdk.Context, keeper *keeper.Keeper, order *types.Order) bool {
+	if order.PositionDirection == types.PositionDirection_LONG {
+		bestShorts := keeper.GetTopNShortBooksForPair(ctx, order.ContractAddr, order.PriceDenom, order.AssetDenom, 1)
+		return len(bestShorts) > 0 && bestShorts[0].GetPrice().LTE(order.Price)
+	}
+	bestLongs := keeper.GetTopNLongBooksForPair(ctx, order.ContractAddr, order.PriceDenom, order.AssetDenom, 1)
+	return len(bestLongs) > 0 && bestLongs[0].GetPrice().GTE(order.Price)
+}
+
+// CancelUnfilledImmediateOrCancelOrders removes the unfilled remainder of immediate-or-cancel
+// orders from the orderbook after matching, and returns the orders that had a remainder.
+func CancelUnfilledImmediateOrCancelOrders(
+	ctx sdk.Context, keeper *keeper.Keeper, contract types.ContractAddress, pair types.Pair,
+	limitOrders []*types.Order,
+) []*types.Order {
+	cancelled := []*types.Order{}
+	for _, order := range limitOrders {
+		if order.TimeInForce != types.TimeInForce_IMMEDIATE_OR_CANCEL {
+			continue
+		}
+		getter := keeper.GetLongAllocationForOrderID
+		if order.PositionDirection == types.PositionDirection_SHORT {
+			getter = keeper.GetShortAllocationForOrderID
+		}
+		if _, found := getter(ctx, string(contract), pair.PriceDenom, pair.AssetDenom, order.Price, order.Id); !found {
+			continue
+		}
+		cancelOrder(ctx, keeper, &types.Cancellation{
+			Id:                order.Id,
+			Initiator:         types.CancellationInitiator_USER,
+			Creator:           order.Account,
+			ContractAddr:      string(contract),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			PositionDirection: order.PositionDirection,
+			Price:             order.Price,
+		}, contract, pair)
+		cancelled = append(cancelled, order)
+	}
+	return cancelled
 }
@@ -51,5 +51,5 @@ func fuzzTargetMatchLimitOrders(
 	sellOrders := fuzzing.GetPlacedOrders(types.PositionDirection_SHORT, types.OrderType_LIMIT, keepertest.TestPair, sellPrices, sellQuantities)
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, buyOrders, sellOrders)
 	orderBook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom})
-	require.NotPanics(t, func() { exchange.MatchLimitOrders(ctx, orderBook) })
+	require.NotPanics(t, func() { exchange.MatchLimitOrders(ctx, orderBook, nil) })
 }
@@ -52,9 +52,12 @@ func TestMatchSingleOrder(t *testing.T) {
 	longBook := []types.OrderBookEntry{}
 	shortBook := []types.OrderBookEntry{}
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
+	activeOrder, found := dexkeeper.GetAccountActiveOrder(ctx, "test", types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}, "abc", 1)
+	assert.True(t, found)
+	assert.Equal(t, int64(TestHeight), activeOrder.PlacementHeight)
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	outcome := exchange.MatchLimitOrders(
-		ctx, orderbook,
+		ctx, orderbook, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -216,7 +219,7 @@ func TestAddOrders(t *testing.T) {
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	outcome := exchange.MatchLimitOrders(
-		ctx, orderbook,
+		ctx, orderbook, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -353,7 +356,7 @@ func TestMatchSingleOrderFromShortBook(t *testing.T) {
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	outcome := exchange.MatchLimitOrders(
-		ctx, orderbook,
+		ctx, orderbook, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -436,7 +439,7 @@ func TestMatchSingleOrderFromLongBook(t *testing.T) {
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	outcome := exchange.MatchLimitOrders(
-		ctx, orderbook,
+		ctx, orderbook, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -537,7 +540,7 @@ func TestMatchSingleOrderFromMultipleShortBook(t *testing.T) {
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	outcome := exchange.MatchLimitOrders(
-		ctx, orderbook,
+		ctx, orderbook, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -680,7 +683,7 @@ func TestMatchSingleOrderFromMultipleLongBook(t *testing.T) {
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	outcome := exchange.MatchLimitOrders(
-		ctx, orderbook,
+		ctx, orderbook, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -845,7 +848,7 @@ func TestMatchMultipleOrderFromMultipleShortBook(t *testing.T) {
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	outcome := exchange.MatchLimitOrders(
-		ctx, orderbook,
+		ctx, orderbook, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -1044,7 +1047,7 @@ func TestMatchMultipleOrderFromMultipleLongBook(t *testing.T) {
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	outcome := exchange.MatchLimitOrders(
-		ctx, orderbook,
+		ctx, orderbook, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -1163,3 +1166,113 @@ func TestMatchMultipleOrderFromMultipleLongBook(t *testing.T) {
 		Height:                 TestHeight,
 	})
 }
+
+func TestAddPostOnlyLimitOrders(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	longOrders := []*types.Order{
+		{
+			Id:                1,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(5),
+			Account:           "abc",
+			PositionDirection: types.PositionDirection_LONG,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+		},
+		{
+			Id:                4,
+			Price:             sdk.NewDec(101),
+			Quantity:          sdk.NewDec(5),
+			Account:           "abc",
+			PositionDirection: types.PositionDirection_LONG,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+			PostOnly:          true,
+		},
+	}
+	shortOrders := []*types.Order{
+		{
+			// crosses the non post-only long order
+			Id:                2,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(5),
+			Account:           "def",
+			PositionDirection: types.PositionDirection_SHORT,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+			PostOnly:          true,
+		},
+		{
+			// crosses the post-only long order placed after it
+			Id:                3,
+			Price:             sdk.NewDec(101),
+			Quantity:          sdk.NewDec(5),
+			Account:           "def",
+			PositionDirection: types.PositionDirection_SHORT,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+			PostOnly:          true,
+		},
+	}
+	rejected := exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
+	assert.Equal(t, 2, len(rejected))
+	assert.Equal(t, uint64(2), rejected[0].Id)
+	assert.Equal(t, uint64(4), rejected[1].Id)
+	assert.Equal(t, 1, len(dexkeeper.GetAllLongBookForPair(ctx, "test", "USDC", "ATOM")))
+	shortBook := dexkeeper.GetAllShortBookForPair(ctx, "test", "USDC", "ATOM")
+	assert.Equal(t, 1, len(shortBook))
+	assert.Equal(t, uint64(3), shortBook[0].GetOrderEntry().Allocations[0].OrderId)
+}
+
+func TestCancelUnfilledImmediateOrCancelOrders(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	longOrders := []*types.Order{
+		{
+			Id:                1,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(5),
+			Account:           "abc",
+			PositionDirection: types.PositionDirection_LONG,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+			TimeInForce:       types.TimeInForce_IMMEDIATE_OR_CANCEL,
+		},
+	}
+	shortOrders := []*types.Order{
+		{
+			Id:                2,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(2),
+			Account:           "def",
+			PositionDirection: types.PositionDirection_SHORT,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+			TimeInForce:       types.TimeInForce_IMMEDIATE_OR_CANCEL,
+		},
+	}
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"}
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+	outcome := exchange.MatchLimitOrders(ctx, orderbook, nil)
+	assert.Equal(t, sdk.NewDec(4), outcome.TotalQuantity)
+
+	cancelled := exchange.CancelUnfilledImmediateOrCancelOrders(ctx, dexkeeper, types.ContractAddress("test"), pair, append(longOrders, shortOrders...))
+	assert.Equal(t, 1, len(cancelled))
+	assert.Equal(t, uint64(1), cancelled[0].Id)
+	assert.Equal(t, 0, len(dexkeeper.GetAllLongBookForPair(ctx, "test", "USDC", "ATOM")))
+	assert.Equal(t, 0, len(dexkeeper.GetAllShortBookForPair(ctx, "test", "USDC", "ATOM")))
+}
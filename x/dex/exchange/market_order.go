@@ -12,22 +12,24 @@ func MatchMarketOrders(
 	orderBookEntries *types.CachedSortedOrderBookEntries,
 	direction types.PositionDirection,
 	blockOrders *cache.BlockOrders,
+	fees *FeeCalculator,
 ) ExecutionOutcome {
 	totalExecuted, totalPrice := sdk.ZeroDec(), sdk.ZeroDec()
 	minPrice, maxPrice := sdk.OneDec().Neg(), sdk.OneDec().Neg()
 	settlements := []*types.SettlementEntry{}
 	allTakerSettlements := []*types.SettlementEntry{}
+	fills := []Fill{}
 	for _, marketOrder := range marketOrders {
 		switch marketOrder.OrderType {
 		case types.OrderType_FOKMARKETBYVALUE:
-			settlements, allTakerSettlements = MatchByValueFOKMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+			settlements, allTakerSettlements, fills = MatchByValueFOKMarketOrder(
+				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, fills, blockOrders, fees)
 		case types.OrderType_FOKMARKET:
-			settlements, allTakerSettlements = MatchFOKMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+			settlements, allTakerSettlements, fills = MatchFOKMarketOrder(
+				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, fills, blockOrders, fees)
 		default:
-			settlements, allTakerSettlements = MatchMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+			settlements, allTakerSettlements, fills = MatchMarketOrder(
+				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, fills, blockOrders, fees)
 		}
 	}
 
@@ -43,6 +45,7 @@ func MatchMarketOrders(
 		TotalNotional: totalPrice,
 		TotalQuantity: totalExecuted,
 		Settlements:   settlements,
+		Fills:         fills,
 		MinPrice:      minPrice,
 		MaxPrice:      maxPrice,
 	}
@@ -59,8 +62,10 @@ func MatchMarketOrder(
 	maxPrice *sdk.Dec,
 	settlements []*types.SettlementEntry,
 	allTakerSettlements []*types.SettlementEntry,
+	fills []Fill,
 	blockOrders *cache.BlockOrders,
-) ([]*types.SettlementEntry, []*types.SettlementEntry) {
+	fees *FeeCalculator,
+) ([]*types.SettlementEntry, []*types.SettlementEntry, []Fill) {
 	remainingQuantity := marketOrder.Quantity
 	for entry := orderBookEntries.Next(ctx); entry != nil; entry = orderBookEntries.Next(ctx) {
 		// If price is zero, it means the order sender
@@ -73,6 +78,14 @@ func MatchMarketOrder(
 				break
 			}
 		}
+		reduction, cancelTaker := PreventSelfTrade(marketOrder, remainingQuantity, orderBookEntries, false)
+		remainingQuantity = remainingQuantity.Sub(reduction)
+		if cancelTaker || (reduction.IsPositive() && remainingQuantity.IsZero()) {
+			break
+		}
+		if entry.GetOrderEntry().Quantity.IsZero() {
+			continue
+		}
 		var executed sdk.Dec
 		if remainingQuantity.LTE(entry.GetOrderEntry().Quantity) {
 			executed = remainingQuantity
@@ -96,12 +109,14 @@ func MatchMarketOrder(
 			orderBookEntries,
 			marketOrder.Price,
 			entry.GetPrice(),
+			fees,
 		)
 		// update the status of order in the memState
 		UpdateOrderData(marketOrder, executed, blockOrders)
 		settlements = append(settlements, makerSettlements...)
 		// taker settlements' clearing price will need to be adjusted after all market order executions finish
 		allTakerSettlements = append(allTakerSettlements, takerSettlements...)
+		fills = append(fills, newFills(takerSettlements, makerSettlements)...)
 		if remainingQuantity.IsZero() {
 			break
 		}
@@ -109,7 +124,7 @@ func MatchMarketOrder(
 
 	orderBookEntries.Flush(ctx)
 
-	return settlements, allTakerSettlements
+	return settlements, allTakerSettlements, fills
 }
 
 func MatchFOKMarketOrder(
@@ -123,11 +138,13 @@ func MatchFOKMarketOrder(
 	maxPrice *sdk.Dec,
 	settlements []*types.SettlementEntry,
 	allTakerSettlements []*types.SettlementEntry,
+	fills []Fill,
 	blockOrders *cache.BlockOrders,
-) ([]*types.SettlementEntry, []*types.SettlementEntry) {
+	fees *FeeCalculator,
+) ([]*types.SettlementEntry, []*types.SettlementEntry, []Fill) {
 	// check if there is enough liquidity for fill-or-kill market order, if not skip them
 	remainingQuantity := marketOrder.Quantity
-	newSettlements, newTakerSettlements := []*types.SettlementEntry{}, []*types.SettlementEntry{}
+	newSettlements, newTakerSettlements, newOrderFills := []*types.SettlementEntry{}, []*types.SettlementEntry{}, []Fill{}
 	orders, executedQuantities, entryPrices := []*types.Order{}, []sdk.Dec{}, []sdk.Dec{}
 	for entry := orderBookEntries.Next(ctx); entry != nil; entry = orderBookEntries.Next(ctx) {
 		if !marketOrder.Price.IsZero() {
@@ -136,6 +153,12 @@ func MatchFOKMarketOrder(
 				break
 			}
 		}
+		if _, cancelTaker := PreventSelfTrade(marketOrder, remainingQuantity, orderBookEntries, true); cancelTaker {
+			break
+		}
+		if entry.GetOrderEntry().Quantity.IsZero() {
+			continue
+		}
 
 		var executed sdk.Dec
 		if remainingQuantity.LTE(entry.GetOrderEntry().Quantity) {
@@ -152,8 +175,10 @@ func MatchFOKMarketOrder(
 			orderBookEntries,
 			marketOrder.Price,
 			entry.GetPrice(),
+			fees,
 		)
 		newSettlements = append(newSettlements, makerSettlements...)
+		newOrderFills = append(newOrderFills, newFills(takerSettlements, makerSettlements)...)
 		newTakerSettlements = append(newTakerSettlements, takerSettlements...)
 		orders = append(orders, marketOrder)
 		executedQuantities = append(executedQuantities, executed)
@@ -168,6 +193,7 @@ func MatchFOKMarketOrder(
 		orderBookEntries.Flush(ctx)
 		settlements = append(settlements, newSettlements...)
 		allTakerSettlements = append(allTakerSettlements, newTakerSettlements...)
+		fills = append(fills, newOrderFills...)
 		for i, order := range orders {
 			UpdateOrderData(order, executedQuantities[i], blockOrders)
 			*totalExecuted = totalExecuted.Add(executedQuantities[i])
@@ -183,7 +209,7 @@ func MatchFOKMarketOrder(
 		orderBookEntries.Refresh(ctx)
 	}
 
-	return settlements, allTakerSettlements
+	return settlements, allTakerSettlements, fills
 }
 
 func MatchByValueFOKMarketOrder(
@@ -197,11 +223,13 @@ func MatchByValueFOKMarketOrder(
 	maxPrice *sdk.Dec,
 	settlements []*types.SettlementEntry,
 	allTakerSettlements []*types.SettlementEntry,
+	fills []Fill,
 	blockOrders *cache.BlockOrders,
-) ([]*types.SettlementEntry, []*types.SettlementEntry) {
+	fees *FeeCalculator,
+) ([]*types.SettlementEntry, []*types.SettlementEntry, []Fill) {
 	remainingFund := marketOrder.Nominal
 	remainingQuantity := marketOrder.Quantity
-	newSettlements, newTakerSettlements := []*types.SettlementEntry{}, []*types.SettlementEntry{}
+	newSettlements, newTakerSettlements, newOrderFills := []*types.SettlementEntry{}, []*types.SettlementEntry{}, []Fill{}
 	orders, executedQuantities, entryPrices := []*types.Order{}, []sdk.Dec{}, []sdk.Dec{}
 	for entry := orderBookEntries.Next(ctx); entry != nil; entry = orderBookEntries.Next(ctx) {
 		if !marketOrder.Price.IsZero() {
@@ -210,6 +238,12 @@ func MatchByValueFOKMarketOrder(
 				break
 			}
 		}
+		if _, cancelTaker := PreventSelfTrade(marketOrder, remainingQuantity, orderBookEntries, true); cancelTaker {
+			break
+		}
+		if entry.GetOrderEntry().Quantity.IsZero() {
+			continue
+		}
 		var executed sdk.Dec
 		if remainingFund.LTE(entry.GetOrderEntry().Quantity.Mul(entry.GetPrice())) {
 			executed = remainingFund.Quo(entry.GetPrice())
@@ -227,8 +261,10 @@ func MatchByValueFOKMarketOrder(
 			orderBookEntries,
 			marketOrder.Price,
 			entry.GetPrice(),
+			fees,
 		)
 		newSettlements = append(newSettlements, makerSettlements...)
+		newOrderFills = append(newOrderFills, newFills(takerSettlements, makerSettlements)...)
 		newTakerSettlements = MergeByNominalTakerSettlements(append(newTakerSettlements, takerSettlements...))
 		orders = append(orders, marketOrder)
 		executedQuantities = append(executedQuantities, executed)
@@ -243,6 +279,7 @@ func MatchByValueFOKMarketOrder(
 		orderBookEntries.Flush(ctx)
 		settlements = append(settlements, newSettlements...)
 		allTakerSettlements = append(allTakerSettlements, newTakerSettlements...)
+		fills = append(fills, newOrderFills...)
 		for i, order := range orders {
 			UpdateOrderData(order, executedQuantities[i], blockOrders)
 			*totalExecuted = totalExecuted.Add(executedQuantities[i])
@@ -258,15 +295,23 @@ func MatchByValueFOKMarketOrder(
 		orderBookEntries.Refresh(ctx)
 	}
 
-	return settlements, allTakerSettlements
+	return settlements, allTakerSettlements, fills
 }
 
 func MergeByNominalTakerSettlements(settlements []*types.SettlementEntry) []*types.SettlementEntry {
 	aggregatedSettlement := types.SettlementEntry{Quantity: sdk.ZeroDec()}
 	for _, settlement := range settlements {
 		quantity := settlement.Quantity.Add(aggregatedSettlement.Quantity)
+		fee := aggregatedSettlement.Fee
+		if fee == nil {
+			fee = settlement.Fee
+		} else if settlement.Fee != nil {
+			sum := fee.Add(*settlement.Fee)
+			fee = &sum
+		}
 		aggregatedSettlement = *settlement
 		aggregatedSettlement.Quantity = quantity
+		aggregatedSettlement.Fee = fee
 	}
 
 	return []*types.SettlementEntry{&aggregatedSettlement}
@@ -72,7 +72,7 @@ func TestMatchFoKMarketOrderFromShortBookNotEnoughLiquidity(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -140,7 +140,7 @@ func TestMatchFoKMarketOrderFromShortBookHappyPath(t *testing.T) {
 		Data:              "{\"position_effect\":\"Open\",\"leverage\":\"1\"}",
 	})
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -236,7 +236,7 @@ func TestMatchByValueFOKMarketOrderFromShortBookNotEnoughLiquidity(t *testing.T)
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, &dex.BlockOrders{},
+		ctx, longOrders, entries, types.PositionDirection_LONG, &dex.BlockOrders{}, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -308,7 +308,7 @@ func TestMatchByValueFOKMarketOrderFromShortBookHappyPath(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -388,7 +388,7 @@ func TestMatchSingleMarketOrderFromShortBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -468,7 +468,7 @@ func TestMatchSingleMarketOrderFromLongBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Longs
 	outcome := exchange.MatchMarketOrders(
-		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders,
+		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -566,7 +566,7 @@ func TestMatchSingleMarketOrderFromMultipleShortBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -706,7 +706,7 @@ func TestMatchSingleMarketOrderFromMultipleLongBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Longs
 	outcome := exchange.MatchMarketOrders(
-		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders,
+		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -870,7 +870,7 @@ func TestMatchMultipleMarketOrderFromMultipleShortBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -1061,7 +1061,7 @@ func TestMatchMultipleMarketOrderFromMultipleLongBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Longs
 	outcome := exchange.MatchMarketOrders(
-		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders,
+		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders, nil,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -0,0 +1,91 @@
+package exchange
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// GetAllocator returns the allocator that implements the matching policy of a pair, i.e. how a
+// quantity taken from a price level is split among the makers resting at that level. FIFO is the
+// default behavior of the order book and needs no allocator.
+func GetAllocator(pair types.Pair) types.Allocator {
+	lot := sdk.SmallestDec()
+	if pair.QuantityTicksize != nil && pair.QuantityTicksize.IsPositive() {
+		lot = *pair.QuantityTicksize
+	}
+	switch pair.MatchingPolicy {
+	case types.MatchingPolicy_PRO_RATA:
+		return func(allocations []*types.Allocation, quantity sdk.Dec) []types.ToSettle {
+			return AllocateProRata(allocations, quantity, sdk.ZeroDec(), lot)
+		}
+	case types.MatchingPolicy_PRO_RATA_WITH_TOP_OF_QUEUE_BONUS:
+		bonus := sdk.ZeroDec()
+		if pair.TopOfQueueBonus != nil {
+			bonus = *pair.TopOfQueueBonus
+		}
+		return func(allocations []*types.Allocation, quantity sdk.Dec) []types.ToSettle {
+			return AllocateProRata(allocations, quantity, bonus, lot)
+		}
+	default:
+		return nil
+	}
+}
+
+// AllocateProRata splits `quantity` among `allocations`, which must have a total quantity larger
+// than `quantity`. The first allocation is given `bonus` (a fraction) of `quantity` up front, then
+// what remains is split in proportion to the remaining allocation sizes, rounded down to multiples
+// of `lot`. Any remainder left by rounding is handed out in FIFO order.
+func AllocateProRata(allocations []*types.Allocation, quantity sdk.Dec, bonus sdk.Dec, lot sdk.Dec) []types.ToSettle {
+	amounts := make([]sdk.Dec, len(allocations))
+	for i := range amounts {
+		amounts[i] = sdk.ZeroDec()
+	}
+	if len(allocations) == 0 {
+		return []types.ToSettle{}
+	}
+
+	remaining := quantity
+	if bonus.IsPositive() {
+		amounts[0] = sdk.MinDec(roundDownToLot(quantity.Mul(bonus), lot), allocations[0].Quantity)
+		remaining = remaining.Sub(amounts[0])
+	}
+
+	total := sdk.ZeroDec()
+	for i, a := range allocations {
+		total = total.Add(a.Quantity.Sub(amounts[i]))
+	}
+	if remaining.IsPositive() && total.IsPositive() {
+		allocated := sdk.ZeroDec()
+		for i, a := range allocations {
+			share := roundDownToLot(remaining.MulTruncate(a.Quantity.Sub(amounts[i])).QuoTruncate(total), lot)
+			amounts[i] = amounts[i].Add(share)
+			allocated = allocated.Add(share)
+		}
+		remaining = remaining.Sub(allocated)
+	}
+
+	for i, a := range allocations {
+		if !remaining.IsPositive() {
+			break
+		}
+		extra := sdk.MinDec(remaining, a.Quantity.Sub(amounts[i]))
+		amounts[i] = amounts[i].Add(extra)
+		remaining = remaining.Sub(extra)
+	}
+
+	res := []types.ToSettle{}
+	for i, a := range allocations {
+		if amounts[i].IsPositive() {
+			res = append(res, types.ToSettle{
+				OrderID: a.OrderId,
+				Account: a.Account,
+				Amount:  amounts[i],
+			})
+		}
+	}
+	return res
+}
+
+func roundDownToLot(quantity sdk.Dec, lot sdk.Dec) sdk.Dec {
+	return quantity.QuoTruncate(lot).TruncateDec().Mul(lot)
+}
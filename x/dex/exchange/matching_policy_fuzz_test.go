@@ -0,0 +1,113 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/testutil/fuzzing"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzMatchLimitOrdersProRata(f *testing.F) {
+	f.Fuzz(func(t *testing.T, buyPrices []byte, sellPrices []byte, buyQuantities []byte, sellQuantities []byte, buyEntryWeights []byte, sellEntryWeights []byte, buyAccountIndices []byte, sellAccountIndices []byte, buyAllocationWeights []byte, sellAllocationWeights []byte) {
+		fuzzTargetMatchLimitOrdersWithPolicy(t, types.MatchingPolicy_PRO_RATA, nil, buyPrices, sellPrices, buyQuantities, sellQuantities, buyEntryWeights, sellEntryWeights, buyAccountIndices, sellAccountIndices, buyAllocationWeights, sellAllocationWeights)
+	})
+}
+
+func FuzzMatchLimitOrdersProRataWithTopOfQueueBonus(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bonusI int64, buyPrices []byte, sellPrices []byte, buyQuantities []byte, sellQuantities []byte, buyEntryWeights []byte, sellEntryWeights []byte, buyAccountIndices []byte, sellAccountIndices []byte, buyAllocationWeights []byte, sellAllocationWeights []byte) {
+		// bonus in [0, 1] with a precision of 0.01
+		bonus := sdk.NewDecWithPrec(int64(uint64(bonusI)%101), 2)
+		fuzzTargetMatchLimitOrdersWithPolicy(t, types.MatchingPolicy_PRO_RATA_WITH_TOP_OF_QUEUE_BONUS, &bonus, buyPrices, sellPrices, buyQuantities, sellQuantities, buyEntryWeights, sellEntryWeights, buyAccountIndices, sellAccountIndices, buyAllocationWeights, sellAllocationWeights)
+	})
+}
+
+func FuzzAllocateProRata(f *testing.F) {
+	f.Fuzz(func(t *testing.T, quantityWeights []byte, quantityI int64, bonusI int64) {
+		allocations := []*types.Allocation{}
+		total := sdk.ZeroDec()
+		for i, weight := range quantityWeights {
+			if weight == 0 {
+				continue
+			}
+			allocations = append(allocations, &types.Allocation{
+				OrderId:  uint64(i),
+				Account:  fuzzing.GetAccount(i),
+				Quantity: sdk.NewDec(int64(weight)),
+			})
+			total = total.Add(sdk.NewDec(int64(weight)))
+		}
+		if total.IsZero() {
+			return
+		}
+		quantity := sdk.NewDec(int64(uint64(quantityI) % uint64(total.TruncateInt64())))
+		if quantity.IsZero() {
+			return
+		}
+		bonus := sdk.NewDecWithPrec(int64(uint64(bonusI)%101), 2)
+
+		toSettle := exchange.AllocateProRata(allocations, quantity, bonus, sdk.OneDec())
+		settled := sdk.ZeroDec()
+		for _, s := range toSettle {
+			require.True(t, s.Amount.IsPositive())
+			for _, a := range allocations {
+				if a.OrderId == s.OrderID {
+					require.True(t, s.Amount.LTE(a.Quantity))
+				}
+			}
+			settled = settled.Add(s.Amount)
+		}
+		require.Equal(t, quantity, settled)
+	})
+}
+
+func fuzzTargetMatchLimitOrdersWithPolicy(
+	t *testing.T,
+	policy types.MatchingPolicy,
+	bonus *sdk.Dec,
+	buyPrices []byte,
+	sellPrices []byte,
+	buyQuantities []byte,
+	sellQuantities []byte,
+	buyEntryWeights []byte,
+	sellEntryWeights []byte,
+	buyAccountIndices []byte,
+	sellAccountIndices []byte,
+	buyAllocationWeights []byte,
+	sellAllocationWeights []byte,
+) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+	buyEntries := fuzzing.GetOrderBookEntries(true, keepertest.TestPriceDenom, keepertest.TestAssetDenom, buyEntryWeights, buyAccountIndices, buyAllocationWeights)
+	for _, entry := range buyEntries {
+		dexkeeper.SetLongOrderBookEntry(ctx, keepertest.TestContract, entry)
+	}
+	sellEntries := fuzzing.GetOrderBookEntries(false, keepertest.TestPriceDenom, keepertest.TestAssetDenom, sellEntryWeights, sellAccountIndices, sellAllocationWeights)
+	for _, entry := range sellEntries {
+		dexkeeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, entry)
+	}
+	buyOrders := fuzzing.GetPlacedOrders(types.PositionDirection_LONG, types.OrderType_LIMIT, keepertest.TestPair, buyPrices, buyQuantities)
+	sellOrders := fuzzing.GetPlacedOrders(types.PositionDirection_SHORT, types.OrderType_LIMIT, keepertest.TestPair, sellPrices, sellQuantities)
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, buyOrders, sellOrders)
+	pair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom, MatchingPolicy: policy, TopOfQueueBonus: bonus}
+	orderBook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
+	var outcome exchange.ExecutionOutcome
+	require.NotPanics(t, func() { outcome = exchange.MatchLimitOrders(ctx, orderBook, nil) })
+
+	// both sides of the book are always settled for the same total quantity
+	longSettled, shortSettled := sdk.ZeroDec(), sdk.ZeroDec()
+	for _, settlement := range outcome.Settlements {
+		require.True(t, settlement.Quantity.IsPositive())
+		if settlement.PositionDirection == types.GetContractPositionDirection(types.PositionDirection_LONG) {
+			longSettled = longSettled.Add(settlement.Quantity)
+		} else {
+			shortSettled = shortSettled.Add(settlement.Quantity)
+		}
+	}
+	require.Equal(t, longSettled, shortSettled)
+}
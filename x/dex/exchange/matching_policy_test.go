@@ -0,0 +1,123 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAllocator(t *testing.T) {
+	bonus := sdk.MustNewDecFromStr("0.5")
+	require.Nil(t, exchange.GetAllocator(types.Pair{}))
+	require.NotNil(t, exchange.GetAllocator(types.Pair{MatchingPolicy: types.MatchingPolicy_PRO_RATA}))
+	require.NotNil(t, exchange.GetAllocator(types.Pair{MatchingPolicy: types.MatchingPolicy_PRO_RATA_WITH_TOP_OF_QUEUE_BONUS, TopOfQueueBonus: &bonus}))
+}
+
+func TestAllocateProRata(t *testing.T) {
+	allocations := []*types.Allocation{
+		{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(10)},
+		{OrderId: 2, Account: "def", Quantity: sdk.NewDec(30)},
+	}
+	require.Equal(t, []types.ToSettle{
+		{OrderID: 1, Account: "abc", Amount: sdk.NewDec(5)},
+		{OrderID: 2, Account: "def", Amount: sdk.NewDec(15)},
+	}, exchange.AllocateProRata(allocations, sdk.NewDec(20), sdk.ZeroDec(), sdk.OneDec()))
+}
+
+func TestAllocateProRataRemainder(t *testing.T) {
+	// shares are rounded down to the lot and the remainder goes to the earliest orders
+	allocations := []*types.Allocation{
+		{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(10)},
+		{OrderId: 2, Account: "def", Quantity: sdk.NewDec(10)},
+		{OrderId: 3, Account: "ghi", Quantity: sdk.NewDec(10)},
+	}
+	require.Equal(t, []types.ToSettle{
+		{OrderID: 1, Account: "abc", Amount: sdk.NewDec(4)},
+		{OrderID: 2, Account: "def", Amount: sdk.NewDec(3)},
+		{OrderID: 3, Account: "ghi", Amount: sdk.NewDec(3)},
+	}, exchange.AllocateProRata(allocations, sdk.NewDec(10), sdk.ZeroDec(), sdk.OneDec()))
+}
+
+func TestAllocateProRataWithTopOfQueueBonus(t *testing.T) {
+	allocations := []*types.Allocation{
+		{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(10)},
+		{OrderId: 2, Account: "def", Quantity: sdk.NewDec(30)},
+	}
+	// 4 as bonus, then 16 split 6:30 into 2 and 13, then 1 of remainder to the first order
+	require.Equal(t, []types.ToSettle{
+		{OrderID: 1, Account: "abc", Amount: sdk.NewDec(7)},
+		{OrderID: 2, Account: "def", Amount: sdk.NewDec(13)},
+	}, exchange.AllocateProRata(allocations, sdk.NewDec(20), sdk.MustNewDecFromStr("0.2"), sdk.OneDec()))
+	// the bonus is capped by the size of the first order
+	require.Equal(t, []types.ToSettle{
+		{OrderID: 1, Account: "abc", Amount: sdk.NewDec(10)},
+		{OrderID: 2, Account: "def", Amount: sdk.NewDec(10)},
+	}, exchange.AllocateProRata(allocations, sdk.NewDec(20), sdk.OneDec(), sdk.OneDec()))
+}
+
+func TestMatchLimitOrdersProRata(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	longOrders := []*types.Order{
+		{
+			Id:                1,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(10),
+			Account:           "abc",
+			PositionDirection: types.PositionDirection_LONG,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+		},
+		{
+			Id:                2,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(30),
+			Account:           "def",
+			PositionDirection: types.PositionDirection_LONG,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+		},
+	}
+	shortOrders := []*types.Order{
+		{
+			Id:                3,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(20),
+			Account:           "ghi",
+			PositionDirection: types.PositionDirection_SHORT,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+		},
+	}
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", MatchingPolicy: types.MatchingPolicy_PRO_RATA})
+	outcome := exchange.MatchLimitOrders(ctx, orderbook, nil)
+	require.Equal(t, sdk.NewDec(40), outcome.TotalQuantity)
+
+	filled := map[uint64]sdk.Dec{1: sdk.ZeroDec(), 2: sdk.ZeroDec(), 3: sdk.ZeroDec()}
+	for _, settlement := range outcome.Settlements {
+		filled[settlement.OrderId] = filled[settlement.OrderId].Add(settlement.Quantity)
+	}
+	require.Equal(t, sdk.NewDec(5), filled[1])
+	require.Equal(t, sdk.NewDec(15), filled[2])
+	require.Equal(t, sdk.NewDec(20), filled[3])
+
+	longBook := dexkeeper.GetAllLongBookForPair(ctx, "test", "USDC", "ATOM")
+	require.Equal(t, 1, len(longBook))
+	require.Equal(t, []*types.Allocation{
+		{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(5)},
+		{OrderId: 2, Account: "def", Quantity: sdk.NewDec(15)},
+	}, longBook[0].GetOrderEntry().Allocations)
+}
@@ -0,0 +1,90 @@
+package exchange
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// PreventSelfTrade applies the self-trade prevention mode of `orderBookEntries` before `takerOrder`,
+// with `remainingQuantity` left, is matched against the order book entry currently being pointed
+// at. It returns the quantity by which the taker order is reduced without trading, and whether the
+// rest of the taker order should be cancelled instead of matched. Fill-or-kill orders can't be
+// partially reduced, so they are cancelled under any mode other than CANCEL_MAKER.
+func PreventSelfTrade(
+	takerOrder *types.Order,
+	remainingQuantity sdk.Dec,
+	orderBookEntries *types.CachedSortedOrderBookEntries,
+	fillOrKill bool,
+) (reduction sdk.Dec, cancelTaker bool) {
+	mode := orderBookEntries.SelfTradePrevention()
+	if mode == types.SelfTradePrevention_NONE {
+		return sdk.ZeroDec(), false
+	}
+	ownQuantity, _ := orderBookEntries.AccountQuantity(takerOrder.Account)
+	if !ownQuantity.IsPositive() {
+		return sdk.ZeroDec(), false
+	}
+	if fillOrKill && mode != types.SelfTradePrevention_CANCEL_MAKER {
+		return sdk.ZeroDec(), true
+	}
+	switch mode {
+	case types.SelfTradePrevention_CANCEL_TAKER:
+		return sdk.ZeroDec(), true
+	case types.SelfTradePrevention_CANCEL_MAKER:
+		orderBookEntries.ReduceAccountQuantity(takerOrder.Account, ownQuantity)
+		return sdk.ZeroDec(), false
+	case types.SelfTradePrevention_CANCEL_BOTH:
+		orderBookEntries.ReduceAccountQuantity(takerOrder.Account, ownQuantity)
+		return sdk.ZeroDec(), true
+	case types.SelfTradePrevention_DECREMENT_AND_CANCEL:
+		reduction = sdk.MinDec(remainingQuantity, ownQuantity)
+		orderBookEntries.ReduceAccountQuantity(takerOrder.Account, reduction)
+		return reduction, false
+	default:
+		return sdk.ZeroDec(), false
+	}
+}
+
+// preventSelfTradeInBook applies the self-trade prevention mode of the order book to the accounts
+// that have orders in both the long and the short order book entries about to be matched against
+// each other. Of an account's orders, the side that holds its latest order is the taker side.
+// Under DECREMENT_AND_CANCEL, the larger side is reduced by the quantity of the smaller side,
+// which is cancelled.
+func preventSelfTradeInBook(orderbook *types.OrderBook, longEntry types.OrderBookEntry) {
+	mode := orderbook.Longs.SelfTradePrevention()
+	if mode == types.SelfTradePrevention_NONE {
+		return
+	}
+	accounts := []string{}
+	seen := map[string]struct{}{}
+	for _, a := range longEntry.GetOrderEntry().Allocations {
+		if _, ok := seen[a.Account]; !ok {
+			seen[a.Account] = struct{}{}
+			accounts = append(accounts, a.Account)
+		}
+	}
+	for _, account := range accounts {
+		longQuantity, latestLongID := orderbook.Longs.AccountQuantity(account)
+		shortQuantity, latestShortID := orderbook.Shorts.AccountQuantity(account)
+		if !longQuantity.IsPositive() || !shortQuantity.IsPositive() {
+			continue
+		}
+		taker, takerQuantity, maker, makerQuantity := orderbook.Longs, longQuantity, orderbook.Shorts, shortQuantity
+		if latestShortID > latestLongID {
+			taker, takerQuantity, maker, makerQuantity = orderbook.Shorts, shortQuantity, orderbook.Longs, longQuantity
+		}
+		switch mode {
+		case types.SelfTradePrevention_CANCEL_TAKER:
+			taker.ReduceAccountQuantity(account, takerQuantity)
+		case types.SelfTradePrevention_CANCEL_MAKER:
+			maker.ReduceAccountQuantity(account, makerQuantity)
+		case types.SelfTradePrevention_CANCEL_BOTH:
+			taker.ReduceAccountQuantity(account, takerQuantity)
+			maker.ReduceAccountQuantity(account, makerQuantity)
+		case types.SelfTradePrevention_DECREMENT_AND_CANCEL:
+			reduction := sdk.MinDec(takerQuantity, makerQuantity)
+			taker.ReduceAccountQuantity(account, reduction)
+			maker.ReduceAccountQuantity(account, reduction)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func settledQuantities(settlements []*types.SettlementEntry) map[uint64]sdk.Dec {
+	res := map[uint64]sdk.Dec{}
+	for _, s := range settlements {
+		if _, ok := res[s.OrderId]; !ok {
+			res[s.OrderId] = sdk.ZeroDec()
+		}
+		res[s.OrderId] = res[s.OrderId].Add(s.Quantity)
+	}
+	return res
+}
+
+func selfTradeCancelledIDs(cancellations []types.SelfTradeCancellation) []uint64 {
+	res := []uint64{}
+	for _, c := range cancellations {
+		if !c.Partial {
+			res = append(res, c.OrderID)
+		}
+	}
+	return res
+}
+
+func selfTradeReductions(cancellations []types.SelfTradeCancellation) map[uint64]sdk.Dec {
+	res := map[uint64]sdk.Dec{}
+	for _, c := range cancellations {
+		if c.Partial {
+			res[c.OrderID] = c.Amount
+		}
+	}
+	return res
+}
+
+// matches a market buy of 8 by "abc" against a short book with 5 of its own ahead of 5 of "def"
+// at 100, and 5 of "ghi" at 101
+func matchMarketOrderWithSelfTradePrevention(t *testing.T, mode types.SelfTradePrevention, orderType types.OrderType) (exchange.ExecutionOutcome, []types.SelfTradeCancellation, []types.OrderBookEntry) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", SelfTradePrevention: mode}
+	for _, entry := range []*types.OrderEntry{
+		{
+			Price:    sdk.NewDec(100),
+			Quantity: sdk.NewDec(10),
+			Allocations: []*types.Allocation{
+				{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(5)},
+				{OrderId: 2, Account: "def", Quantity: sdk.NewDec(5)},
+			},
+		},
+		{
+			Price:       sdk.NewDec(101),
+			Quantity:    sdk.NewDec(5),
+			Allocations: []*types.Allocation{{OrderId: 3, Account: "ghi", Quantity: sdk.NewDec(5)}},
+		},
+	} {
+		entry.PriceDenom, entry.AssetDenom = pair.PriceDenom, pair.AssetDenom
+		dexkeeper.SetShortOrderBookEntry(ctx, "test", &types.ShortBook{Price: entry.Price, Entry: entry})
+	}
+	marketOrder := &types.Order{
+		Id:                10,
+		Account:           "abc",
+		ContractAddr:      "test",
+		Price:             sdk.NewDec(101),
+		Quantity:          sdk.NewDec(8),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         orderType,
+		PositionDirection: types.PositionDirection_LONG,
+	}
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", pair)
+	blockOrders.Add(marketOrder)
+
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+	outcome := exchange.MatchMarketOrders(ctx, []*types.Order{marketOrder}, orderbook.Shorts, types.PositionDirection_LONG, blockOrders, nil)
+	return outcome, orderbook.Shorts.TakeSelfTradeCancellations(), dexkeeper.GetAllShortBookForPair(ctx, "test", pair.PriceDenom, pair.AssetDenom)
+}
+
+func TestMatchMarketOrderWithSelfTradePrevention(t *testing.T) {
+	for _, tc := range []struct {
+		mode              types.SelfTradePrevention
+		expectedSettled   map[uint64]sdk.Dec
+		expectedCancelled []uint64
+		expectedBook      sdk.Dec
+	}{
+		{
+			mode:              types.SelfTradePrevention_NONE,
+			expectedSettled:   map[uint64]sdk.Dec{1: sdk.NewDec(5), 2: sdk.NewDec(3), 10: sdk.NewDec(8)},
+			expectedCancelled: []uint64{},
+			expectedBook:      sdk.NewDec(7),
+		},
+		{
+			// the taker stops at its own order
+			mode:              types.SelfTradePrevention_CANCEL_TAKER,
+			expectedSettled:   map[uint64]sdk.Dec{},
+			expectedCancelled: []uint64{},
+			expectedBook:      sdk.NewDec(15),
+		},
+		{
+			// the taker's own order is cancelled and it keeps matching
+			mode:              types.SelfTradePrevention_CANCEL_MAKER,
+			expectedSettled:   map[uint64]sdk.Dec{2: sdk.NewDec(5), 3: sdk.NewDec(3), 10: sdk.NewDec(8)},
+			expectedCancelled: []uint64{1},
+			expectedBook:      sdk.NewDec(2),
+		},
+		{
+			mode:              types.SelfTradePrevention_CANCEL_BOTH,
+			expectedSettled:   map[uint64]sdk.Dec{},
+			expectedCancelled: []uint64{1},
+			expectedBook:      sdk.NewDec(10),
+		},
+		{
+			// 5 of the taker's 8 are decremented against its own order, which is then cancelled
+			mode:              types.SelfTradePrevention_DECREMENT_AND_CANCEL,
+			expectedSettled:   map[uint64]sdk.Dec{2: sdk.NewDec(3), 10: sdk.NewDec(3)},
+			expectedCancelled: []uint64{1},
+			expectedBook:      sdk.NewDec(7),
+		},
+	} {
+		outcome, cancelled, book := matchMarketOrderWithSelfTradePrevention(t, tc.mode, types.OrderType_MARKET)
+		require.Equal(t, tc.expectedSettled, settledQuantities(outcome.Settlements), tc.mode.String())
+		require.Equal(t, tc.expectedCancelled, selfTradeCancelledIDs(cancelled), tc.mode.String())
+		remaining := sdk.ZeroDec()
+		for _, entry := range book {
+			remaining = remaining.Add(entry.GetOrderEntry().Quantity)
+		}
+		require.Equal(t, tc.expectedBook, remaining, tc.mode.String())
+	}
+}
+
+func TestMatchFOKMarketOrderWithSelfTradePrevention(t *testing.T) {
+	// fill-or-kill orders can't be decremented, so they are killed
+	outcome, cancelled, book := matchMarketOrderWithSelfTradePrevention(t, types.SelfTradePrevention_DECREMENT_AND_CANCEL, types.OrderType_FOKMARKET)
+	require.Empty(t, outcome.Settlements)
+	require.Empty(t, cancelled)
+	require.Equal(t, 2, len(book))
+
+	// but own orders can be cancelled to make room
+	outcome, cancelled, _ = matchMarketOrderWithSelfTradePrevention(t, types.SelfTradePrevention_CANCEL_MAKER, types.OrderType_FOKMARKET)
+	require.Equal(t, sdk.NewDec(8), settledQuantities(outcome.Settlements)[10])
+	require.Equal(t, []uint64{1}, selfTradeCancelledIDs(cancelled))
+}
+
+func TestMatchLimitOrdersWithSelfTradePrevention(t *testing.T) {
+	for _, tc := range []struct {
+		mode                   types.SelfTradePrevention
+		expectedSettled        map[uint64]sdk.Dec
+		expectedLongCancelled  []uint64
+		expectedShortCancelled []uint64
+		expectedShortReduced   map[uint64]sdk.Dec
+	}{
+		{
+			mode:                   types.SelfTradePrevention_NONE,
+			expectedSettled:        map[uint64]sdk.Dec{1: sdk.NewDec(4), 2: sdk.NewDec(6), 3: sdk.NewDec(10)},
+			expectedLongCancelled:  []uint64{},
+			expectedShortCancelled: []uint64{},
+		},
+		{
+			// the short order is the later one of "abc", so it is the taker
+			mode:                   types.SelfTradePrevention_CANCEL_TAKER,
+			expectedSettled:        map[uint64]sdk.Dec{},
+			expectedLongCancelled:  []uint64{},
+			expectedShortCancelled: []uint64{3},
+		},
+		{
+			mode:                   types.SelfTradePrevention_CANCEL_MAKER,
+			expectedSettled:        map[uint64]sdk.Dec{2: sdk.NewDec(6), 3: sdk.NewDec(6)},
+			expectedLongCancelled:  []uint64{1},
+			expectedShortCancelled: []uint64{},
+		},
+		{
+			mode:                   types.SelfTradePrevention_CANCEL_BOTH,
+			expectedSettled:        map[uint64]sdk.Dec{},
+			expectedLongCancelled:  []uint64{1},
+			expectedShortCancelled: []uint64{3},
+		},
+		{
+			// the short order is decremented by 4 and the long order cancelled
+			mode:                   types.SelfTradePrevention_DECREMENT_AND_CANCEL,
+			expectedSettled:        map[uint64]sdk.Dec{2: sdk.NewDec(6), 3: sdk.NewDec(6)},
+			expectedLongCancelled:  []uint64{1},
+			expectedShortCancelled: []uint64{},
+			expectedShortReduced:   map[uint64]sdk.Dec{3: sdk.NewDec(4)},
+		},
+	} {
+		dexkeeper, ctx := keepertest.DexKeeper(t)
+		ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+		pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", SelfTradePrevention: tc.mode}
+		newOrder := func(id uint64, account string, direction types.PositionDirection, quantity int64) *types.Order {
+			return &types.Order{
+				Id:                id,
+				Account:           account,
+				ContractAddr:      "test",
+				Price:             sdk.NewDec(100),
+				Quantity:          sdk.NewDec(quantity),
+				PriceDenom:        pair.PriceDenom,
+				AssetDenom:        pair.AssetDenom,
+				OrderType:         types.OrderType_LIMIT,
+				PositionDirection: direction,
+			}
+		}
+		exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper,
+			[]*types.Order{newOrder(1, "abc", types.PositionDirection_LONG, 4), newOrder(2, "def", types.PositionDirection_LONG, 6)},
+			[]*types.Order{newOrder(3, "abc", types.PositionDirection_SHORT, 10)},
+		)
+		orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+		outcome := exchange.MatchLimitOrders(ctx, orderbook, nil)
+		require.Equal(t, tc.expectedSettled, settledQuantities(outcome.Settlements), tc.mode.String())
+		require.Equal(t, tc.expectedLongCancelled, selfTradeCancelledIDs(orderbook.Longs.TakeSelfTradeCancellations()), tc.mode.String())
+		shortCancellations := orderbook.Shorts.TakeSelfTradeCancellations()
+		require.Equal(t, tc.expectedShortCancelled, selfTradeCancelledIDs(shortCancellations), tc.mode.String())
+		if tc.expectedShortReduced == nil {
+			tc.expectedShortReduced = map[uint64]sdk.Dec{}
+		}
+		require.Equal(t, tc.expectedShortReduced, selfTradeReductions(shortCancellations), tc.mode.String())
+	}
+}
@@ -14,8 +14,10 @@ func Settle(
 	orderbook *types.CachedSortedOrderBookEntries,
 	worstPrice sdk.Dec,
 	makerPrice sdk.Dec,
+	fees *FeeCalculator,
 ) ([]*types.SettlementEntry, []*types.SettlementEntry) {
-	// settlement of one liquidity taker's order is allocated on a FIFO basis
+	// settlement of one liquidity taker's order is allocated on a FIFO basis. The i-th taker
+	// settlement and the i-th maker settlement are the two sides of the same fill
 	takerSettlements := []*types.SettlementEntry{}
 	makerSettlements := []*types.SettlementEntry{}
 	if quantityTaken.IsZero() {
@@ -23,7 +25,8 @@ func Settle(
 	}
 	newToSettle, _ := orderbook.SettleQuantity(ctx, quantityTaken)
 	for _, toSettle := range newToSettle {
-		takerSettlements = append(takerSettlements, types.NewSettlementEntry(
+		notional := toSettle.Amount.Mul(makerPrice)
+		takerSettlement := types.NewSettlementEntry(
 			ctx,
 			takerOrder.Id,
 			takerOrder.Account,
@@ -34,8 +37,10 @@ func Settle(
 			worstPrice,
 			worstPrice,
 			takerOrder.OrderType,
-		))
-		makerSettlements = append(makerSettlements, types.NewSettlementEntry(
+		)
+		takerSettlement.Fee = fees.TakerFee(takerOrder.Account, notional)
+		takerSettlements = append(takerSettlements, takerSettlement)
+		makerSettlement := types.NewSettlementEntry(
 			ctx,
 			toSettle.OrderID,
 			toSettle.Account,
@@ -46,7 +51,9 @@ func Settle(
 			makerPrice,
 			makerPrice,
 			types.OrderType_LIMIT,
-		))
+		)
+		makerSettlement.Fee = fees.MakerFee(toSettle.Account, notional)
+		makerSettlements = append(makerSettlements, makerSettlement)
 	}
 
 	return takerSettlements, makerSettlements
@@ -74,8 +81,11 @@ func SettleFromBook(
 	executedQuantity sdk.Dec,
 	longPrice sdk.Dec,
 	shortPrice sdk.Dec,
+	fees *FeeCalculator,
 ) []*types.SettlementEntry {
-	// settlement from within the order book is also allocated on a FIFO basis
+	// settlement from within the order book is also allocated on a FIFO basis. Of the two orders
+	// in each fill, the one placed earlier is charged the maker fee and the other the taker fee.
+	// Settlements are returned in pairs of a long and a short settlement of the same fill
 	settlements := []*types.SettlementEntry{}
 	if executedQuantity.IsZero() {
 		return settlements
@@ -93,7 +103,7 @@ func SettleFromBook(
 		} else {
 			quantity = shortToSettle.Amount
 		}
-		settlements = append(settlements, types.NewSettlementEntry(
+		longSettlement := types.NewSettlementEntry(
 			ctx,
 			longToSettle.OrderID,
 			longToSettle.Account,
@@ -104,7 +114,8 @@ func SettleFromBook(
 			avgPrice,
 			longPrice,
 			types.OrderType_LIMIT,
-		), types.NewSettlementEntry(
+		)
+		shortSettlement := types.NewSettlementEntry(
 			ctx,
 			shortToSettle.OrderID,
 			shortToSettle.Account,
@@ -115,7 +126,16 @@ func SettleFromBook(
 			avgPrice,
 			shortPrice,
 			types.OrderType_LIMIT,
-		))
+		)
+		notional := quantity.Mul(avgPrice)
+		if longToSettle.OrderID < shortToSettle.OrderID {
+			longSettlement.Fee = fees.MakerFee(longToSettle.Account, notional)
+			shortSettlement.Fee = fees.TakerFee(shortToSettle.Account, notional)
+		} else {
+			longSettlement.Fee = fees.TakerFee(longToSettle.Account, notional)
+			shortSettlement.Fee = fees.MakerFee(shortToSettle.Account, notional)
+		}
+		settlements = append(settlements, longSettlement, shortSettlement)
 		newLongToSettle[longPtr] = types.ToSettle{Account: longToSettle.Account, Amount: longToSettle.Amount.Sub(quantity), OrderID: longToSettle.OrderID}
 		newShortToSettle[shortPtr] = types.ToSettle{Account: shortToSettle.Account, Amount: shortToSettle.Amount.Sub(quantity), OrderID: shortToSettle.OrderID}
 		if newLongToSettle[longPtr].Amount.IsZero() {
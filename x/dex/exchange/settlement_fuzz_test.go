@@ -69,7 +69,7 @@ func fuzzTargetSettle(
 	}
 	for i, entry := range entries {
 		require.NotPanics(t, func() {
-			exchange.Settle(ctx, orders[i], quantity, book, price, entry.GetPrice())
+			exchange.Settle(ctx, orders[i], quantity, book, price, entry.GetPrice(), nil)
 		})
 	}
 }
@@ -112,7 +112,7 @@ func fuzzTargetSettleFromBook(
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom})
 	for i, longEntry := range buyEntries {
 		require.NotPanics(t, func() {
-			exchange.SettleFromBook(ctx, orderbook, quantity, longEntry.GetPrice(), sellEntries[i].GetPrice())
+			exchange.SettleFromBook(ctx, orderbook, quantity, longEntry.GetPrice(), sellEntries[i].GetPrice(), nil)
 		})
 	}
 }
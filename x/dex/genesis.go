@@ -32,14 +32,59 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			k.SetShortBook(ctx, contractState.ContractInfo.ContractAddr, elem)
 		}
 
+		for _, elem := range contractState.TriggeredOrdersList {
+			k.SetTriggeredOrder(ctx, contractState.ContractInfo.ContractAddr, elem)
+		}
+
+		for _, elem := range contractState.ExpiringOrdersList {
+			k.SetExpiringOrder(ctx, contractState.ContractInfo.ContractAddr, elem)
+		}
+
+		for _, elem := range contractState.AccountActiveOrdersList {
+			k.SetAccountActiveOrder(ctx, contractState.ContractInfo.ContractAddr, elem)
+		}
+
+		for _, elem := range contractState.FeeScheduleList {
+			k.SetFeeSchedule(ctx, elem)
+		}
+
+		for _, elem := range contractState.AccountVolumeList {
+			k.SetAccountVolume(ctx, contractState.ContractInfo.ContractAddr, elem)
+		}
+
+		for _, elem := range contractState.CircuitBreakerList {
+			k.SetCircuitBreaker(ctx, elem)
+		}
+
+		for _, elem := range contractState.PairHaltList {
+			k.SetPairHalt(ctx, elem)
+		}
+
 		for _, elem := range contractState.PriceList {
 			for _, priceElem := range elem.Prices {
 				k.SetPriceState(ctx, *priceElem, contractState.ContractInfo.ContractAddr)
 			}
+			for _, volumeElem := range elem.Volumes {
+				k.SetVolumeState(ctx, *volumeElem, contractState.ContractInfo.ContractAddr)
+			}
+			for _, candleElem := range elem.Candles {
+				k.SetCandle(ctx, contractState.ContractInfo.ContractAddr, elem.PricePair, candleElem.Interval, candleElem.Candlestick)
+			}
 		}
 
 		k.SetNextOrderID(ctx, contractState.ContractInfo.ContractAddr, contractState.NextOrderId)
 
+		if contractState.RentLedger != nil {
+			k.SetRentLedger(ctx, *contractState.RentLedger)
+		}
+		if contractState.RentConfig != nil {
+			k.SetRentConfig(ctx, *contractState.RentConfig)
+		}
+
+		for _, elem := range contractState.SuspensionRecordList {
+			k.SetSuspensionRecord(ctx, elem)
+		}
+
 	}
 
 	// this line is used by starport scaffolding # genesis/module/init
@@ -58,22 +103,40 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	for i, contractInfo := range allContractInfo {
 		contractAddr := contractInfo.ContractAddr
 		registeredPairs := k.GetAllRegisteredPairs(ctx, contractAddr)
-		// Save all price info for contract, for all its pairs
+		// Save all price, volume and candle info for contract, for all its pairs
 		contractPrices := []types.ContractPairPrices{}
 		for _, elem := range registeredPairs {
 			pairPrices := k.GetAllPrices(ctx, contractAddr, elem)
+			pairVolumes := k.GetAllVolumes(ctx, contractAddr, elem)
 			contractPrices = append(contractPrices, types.ContractPairPrices{
 				PricePair: elem,
 				Prices:    pairPrices,
+				Volumes:   pairVolumes,
+				Candles:   k.GetAllCandles(ctx, contractAddr, elem),
 			})
 		}
 		contractStates[i] = types.ContractState{
-			ContractInfo:  contractInfo,
-			LongBookList:  k.GetAllLongBook(ctx, contractAddr),
-			ShortBookList: k.GetAllShortBook(ctx, contractAddr),
-			PairList:      registeredPairs,
-			PriceList:     contractPrices,
-			NextOrderId:   k.GetNextOrderID(ctx, contractAddr),
+			ContractInfo:            contractInfo,
+			LongBookList:            k.GetAllLongBook(ctx, contractAddr),
+			ShortBookList:           k.GetAllShortBook(ctx, contractAddr),
+			TriggeredOrdersList:     k.GetAllTriggeredOrders(ctx, contractAddr),
+			PairList:                registeredPairs,
+			PriceList:               contractPrices,
+			NextOrderId:             k.GetNextOrderID(ctx, contractAddr),
+			ExpiringOrdersList:      k.GetAllExpiringOrders(ctx, contractAddr),
+			AccountActiveOrdersList: k.GetAllAccountActiveOrders(ctx, contractAddr),
+			FeeScheduleList:         k.GetAllFeeSchedules(ctx, contractAddr),
+			AccountVolumeList:       k.GetAllAccountVolumes(ctx, contractAddr),
+			CircuitBreakerList:      k.GetAllCircuitBreakers(ctx, contractAddr),
+			PairHaltList:            k.GetAllPairHalts(ctx, contractAddr),
+			SuspensionRecordList:    k.GetAllSuspensionRecords(ctx, contractAddr),
+		}
+		if k.HasRentLedger(ctx, contractAddr) {
+			rentLedger := k.GetRentLedger(ctx, contractAddr)
+			contractStates[i].RentLedger = &rentLedger
+		}
+		if rentConfig, found := k.GetRentConfig(ctx, contractAddr); found {
+			contractStates[i].RentConfig = &rentConfig
 		}
 	}
 	genesis.ContractState = contractStates
@@ -44,6 +44,7 @@ func TestGenesis(t *testing.T) {
 		},
 	}
 
+	candlePrice, candleVolume := sdk.MustNewDecFromStr("101"), sdk.MustNewDecFromStr("3")
 	priceList := []types.ContractPairPrices{
 		{
 			PricePair: pairList[0],
@@ -54,6 +55,28 @@ func TestGenesis(t *testing.T) {
 					Price:                      sdk.MustNewDecFromStr("101"),
 				},
 			},
+			Volumes: []*types.Volume{
+				{
+					SnapshotTimestampInSeconds: 2,
+					Pair:                       &(pairList[0]),
+					Quantity:                   sdk.MustNewDecFromStr("3"),
+					Notional:                   sdk.MustNewDecFromStr("303"),
+				},
+			},
+			Candles: []types.Candle{
+				{
+					Interval: types.CandleInterval_ONE_MINUTE,
+					Candlestick: types.PriceCandlestick{
+						BeginTimestamp: 0,
+						EndTimestamp:   60,
+						Open:           &candlePrice,
+						High:           &candlePrice,
+						Low:            &candlePrice,
+						Close:          &candlePrice,
+						Volume:         &candleVolume,
+					},
+				},
+			},
 		},
 	}
 
@@ -74,6 +97,110 @@ func TestGenesis(t *testing.T) {
 				},
 			},
 		},
+		TriggeredOrdersList: []types.Order{
+			{
+				Id:                1,
+				Account:           keepertest.TestAccount,
+				ContractAddr:      contractInfo.ContractAddr,
+				Price:             sdk.NewDec(2),
+				Quantity:          sdk.NewDec(1),
+				PriceDenom:        "USDC",
+				AssetDenom:        "SEI",
+				OrderType:         types.OrderType_STOPLIMIT,
+				PositionDirection: types.PositionDirection_LONG,
+				Nominal:           sdk.ZeroDec(),
+				TriggerPrice:      sdk.NewDec(2),
+			},
+		},
+		ExpiringOrdersList: []types.ExpiringOrder{
+			{
+				ExpiryHeight: 10,
+				Cancellation: types.Cancellation{
+					Id:                2,
+					Initiator:         types.CancellationInitiator_EXPIRED,
+					Creator:           keepertest.TestAccount,
+					ContractAddr:      contractInfo.ContractAddr,
+					PriceDenom:        "USDC",
+					AssetDenom:        "SEI",
+					PositionDirection: types.PositionDirection_LONG,
+					Price:             sdk.NewDec(1),
+				},
+			},
+			{
+				ExpiryTimestamp: 10000,
+				Cancellation: types.Cancellation{
+					Id:                3,
+					Initiator:         types.CancellationInitiator_EXPIRED,
+					Creator:           keepertest.TestAccount,
+					ContractAddr:      contractInfo.ContractAddr,
+					PriceDenom:        "USDC",
+					AssetDenom:        "SEI",
+					PositionDirection: types.PositionDirection_SHORT,
+					Price:             sdk.NewDec(3),
+				},
+			},
+		},
+		AccountActiveOrdersList: []types.Order{
+			{
+				Id:                1,
+				Account:           keepertest.TestAccount,
+				ContractAddr:      contractInfo.ContractAddr,
+				Price:             sdk.NewDec(1),
+				Quantity:          sdk.NewDec(10),
+				PriceDenom:        "USDC",
+				AssetDenom:        "SEI",
+				OrderType:         types.OrderType_LIMIT,
+				PositionDirection: types.PositionDirection_LONG,
+				Nominal:           sdk.ZeroDec(),
+				TriggerPrice:      sdk.ZeroDec(),
+			},
+		},
+		FeeScheduleList: []types.FeeSchedule{
+			{
+				ContractAddr: contractInfo.ContractAddr,
+				PriceDenom:   "USDC",
+				AssetDenom:   "SEI",
+				MakerFeeRate: sdk.MustNewDecFromStr("0.001"),
+				TakerFeeRate: sdk.MustNewDecFromStr("0.002"),
+				Tiers: []types.FeeTier{
+					{
+						MinVolume:    sdk.NewDec(1000),
+						MakerFeeRate: sdk.ZeroDec(),
+						TakerFeeRate: sdk.MustNewDecFromStr("0.001"),
+					},
+				},
+			},
+		},
+		AccountVolumeList: []types.AccountVolume{
+			{
+				Account:    keepertest.TestAccount,
+				PriceDenom: "USDC",
+				AssetDenom: "SEI",
+				Day:        1,
+				Notional:   sdk.NewDec(100),
+			},
+		},
+		CircuitBreakerList: []types.CircuitBreaker{
+			{
+				ContractAddr:        contractInfo.ContractAddr,
+				PriceDenom:          "USDC",
+				AssetDenom:          "SEI",
+				MaxPriceDeviation:   sdk.MustNewDecFromStr("0.1"),
+				TwapLookbackSeconds: 600,
+				CooldownSeconds:     60,
+			},
+		},
+		PairHaltList: []types.PairHalt{
+			{
+				ContractAddr:         contractInfo.ContractAddr,
+				PriceDenom:           "USDC",
+				AssetDenom:           "SEI",
+				Price:                sdk.NewDec(120),
+				Twap:                 sdk.NewDec(100),
+				HaltedAtTimestamp:    1000,
+				HaltedUntilTimestamp: 1060,
+			},
+		},
 		ContractInfo: contractInfo,
 		PairList:     pairList,
 		PriceList:    priceList,
@@ -95,11 +222,18 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.ContractState[0].LongBookList, got.ContractState[0].LongBookList)
 	require.ElementsMatch(t, genesisState.ContractState[0].ShortBookList, got.ContractState[0].ShortBookList)
+	require.ElementsMatch(t, genesisState.ContractState[0].TriggeredOrdersList, got.ContractState[0].TriggeredOrdersList)
 	require.ElementsMatch(t, genesisState.ContractState[0].PairList, got.ContractState[0].PairList)
 	require.Equal(t, genesisState.ContractState[0].ContractInfo.CodeId, got.ContractState[0].ContractInfo.CodeId)
 	require.Equal(t, genesisState.ContractState[0].ContractInfo.ContractAddr, got.ContractState[0].ContractInfo.ContractAddr)
 	require.ElementsMatch(t, genesisState.ContractState[0].ContractInfo.Dependencies, got.ContractState[0].ContractInfo.Dependencies)
 	require.ElementsMatch(t, genesisState.ContractState[0].PriceList, got.ContractState[0].PriceList)
 	require.Equal(t, genesisState.ContractState[0].NextOrderId, got.ContractState[0].NextOrderId)
+	require.ElementsMatch(t, genesisState.ContractState[0].ExpiringOrdersList, got.ContractState[0].ExpiringOrdersList)
+	require.ElementsMatch(t, genesisState.ContractState[0].AccountActiveOrdersList, got.ContractState[0].AccountActiveOrdersList)
+	require.ElementsMatch(t, genesisState.ContractState[0].FeeScheduleList, got.ContractState[0].FeeScheduleList)
+	require.ElementsMatch(t, genesisState.ContractState[0].AccountVolumeList, got.ContractState[0].AccountVolumeList)
+	require.ElementsMatch(t, genesisState.ContractState[0].CircuitBreakerList, got.ContractState[0].CircuitBreakerList)
+	require.ElementsMatch(t, genesisState.ContractState[0].PairHaltList, got.ContractState[0].PairHaltList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -1,9 +1,13 @@
 package dex
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 )
 
 func HandleAddAssetMetadataProposal(ctx sdk.Context, k *keeper.Keeper, p *types.AddAssetMetadataProposal) error {
@@ -12,3 +16,191 @@ func HandleAddAssetMetadataProposal(ctx sdk.Context, k *keeper.Keeper, p *types.
 	}
 	return nil
 }
+
+func HandleUpdateFeeScheduleProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateFeeScheduleProposal) error {
+	for _, feeSchedule := range p.FeeSchedules {
+		if !k.HasRegisteredPair(ctx, feeSchedule.ContractAddr, feeSchedule.PriceDenom, feeSchedule.AssetDenom) {
+			return types.ErrPairNotRegistered
+		}
+	}
+	for _, feeSchedule := range p.FeeSchedules {
+		k.SetFeeSchedule(ctx, feeSchedule)
+	}
+	return nil
+}
+
+func HandleUpdateCircuitBreakerProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateCircuitBreakerProposal) error {
+	for _, circuitBreaker := range p.CircuitBreakers {
+		if !k.HasRegisteredPair(ctx, circuitBreaker.ContractAddr, circuitBreaker.PriceDenom, circuitBreaker.AssetDenom) {
+			return types.ErrPairNotRegistered
+		}
+	}
+	for _, circuitBreaker := range p.CircuitBreakers {
+		if circuitBreaker.IsRemoval() {
+			k.RemoveCircuitBreaker(ctx, circuitBreaker.ContractAddr, circuitBreaker.PriceDenom, circuitBreaker.AssetDenom)
+			continue
+		}
+		k.SetCircuitBreaker(ctx, circuitBreaker)
+	}
+	return nil
+}
+
+func HandleRegisterPairsProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterPairsProposal) error {
+	for _, batchContractPair := range p.Batchcontractpair {
+		if _, err := k.GetContract(ctx, batchContractPair.ContractAddr); err != nil {
+			return err
+		}
+	}
+	for _, batchContractPair := range p.Batchcontractpair {
+		for _, pair := range batchContractPair.Pairs {
+			if !k.AddRegisteredPair(ctx, batchContractPair.ContractAddr, *pair) {
+				continue
+			}
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeRegisterPair,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, batchContractPair.ContractAddr),
+				sdk.NewAttribute(types.AttributeKeyPriceDenom, pair.PriceDenom),
+				sdk.NewAttribute(types.AttributeKeyAssetDenom, pair.AssetDenom),
+			))
+		}
+	}
+	return nil
+}
+
+func HandleUpdateTickSizeProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateTickSizeProposal) error {
+	for _, tickSize := range p.PriceTickSizes {
+		if err := k.SetPriceTickSizeForPair(ctx, tickSize.ContractAddr, *tickSize.Pair, tickSize.Ticksize); err != nil {
+			return err
+		}
+	}
+	for _, tickSize := range p.QuantityTickSizes {
+		if err := k.SetQuantityTickSizeForPair(ctx, tickSize.ContractAddr, *tickSize.Pair, tickSize.Ticksize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleDelistPairsProposal cancels all resting and stop orders of the delisted pairs in their
+// contracts, which refund the orders, and then removes the pairs. The proposal fails if any
+// contract fails to cancel the orders. Since proposals are executed before the dex EndBlock, orders
+// placed for the delisted pairs in the current block haven't reached the contracts yet, so they are
+// rejected instead, and their funds are credited to their creators in the contracts like those of
+// any other order that doesn't get placed. Cancellations for the delisted pairs in the current
+// block are dropped as all orders of the pairs are cancelled anyway.
+func HandleDelistPairsProposal(ctx sdk.Context, k *keeper.Keeper, p *types.DelistPairsProposal) error {
+	for _, batchContractPair := range p.Batchcontractpair {
+		for _, pair := range batchContractPair.Pairs {
+			if !k.HasRegisteredPair(ctx, batchContractPair.ContractAddr, pair.PriceDenom, pair.AssetDenom) {
+				return types.ErrPairNotRegistered
+			}
+		}
+	}
+	for _, batchContractPair := range p.Batchcontractpair {
+		contractAddr := batchContractPair.ContractAddr
+		orders := []types.Order{}
+		for _, pair := range batchContractPair.Pairs {
+			orders = append(orders, getOrdersOfPair(ctx, k, contractAddr, *pair)...)
+		}
+		if len(orders) > 0 {
+			idsToCancel := make([]uint64, len(orders))
+			for i, order := range orders {
+				idsToCancel[i] = order.Id
+			}
+			msg := types.SudoOrderCancellationMsg{
+				OrderCancellations: types.OrderCancellationMsgDetails{IdsToCancel: idsToCancel},
+			}
+			gasAllowance := k.GetParams(ctx).DefaultGasPerCancel * uint64(len(idsToCancel))
+			if _, err := dexkeeperutils.CallContractSudo(ctx, k, contractAddr, msg, gasAllowance); err != nil {
+				return err
+			}
+		}
+		for _, pair := range batchContractPair.Pairs {
+			rejectBlockOrdersOfPair(ctx, contractAddr, *pair)
+			k.DoDelistPair(ctx, contractAddr, *pair)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeDelistPair,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+				sdk.NewAttribute(types.AttributeKeyPriceDenom, pair.PriceDenom),
+				sdk.NewAttribute(types.AttributeKeyAssetDenom, pair.AssetDenom),
+			))
+		}
+		for _, order := range orders {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeCancelOrder,
+				sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(order.Id)),
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+				sdk.NewAttribute(types.AttributeKeyPriceDenom, order.PriceDenom),
+				sdk.NewAttribute(types.AttributeKeyAssetDenom, order.AssetDenom),
+				sdk.NewAttribute(types.AttributeKeyAccount, order.Account),
+				sdk.NewAttribute(types.AttributeKeyReason, types.DelistedPairReason),
+			))
+		}
+	}
+	return nil
+}
+
+// rejectBlockOrdersOfPair removes the orders and cancellations of a pair that were added in the
+// current block from the dex memory state, and emits rejection events for the orders.
+func rejectBlockOrdersOfPair(ctx sdk.Context, contractAddr string, pair types.Pair) {
+	memState := dexutils.GetMemState(ctx.Context())
+	typedContractAddr := types.ContractAddress(contractAddr)
+	events := []sdk.Event{}
+	for _, order := range memState.GetBlockOrders(ctx, typedContractAddr, pair).Get() {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeRejectOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(order.Id)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+			sdk.NewAttribute(types.AttributeKeyPriceDenom, order.PriceDenom),
+			sdk.NewAttribute(types.AttributeKeyAssetDenom, order.AssetDenom),
+			sdk.NewAttribute(types.AttributeKeyAccount, order.Account),
+			sdk.NewAttribute(types.AttributeKeyReason, types.DelistedPairReason),
+		))
+	}
+	memState.ClearOrdersForPair(ctx, typedContractAddr, pair)
+	memState.ClearCancellationForPair(ctx, typedContractAddr, pair)
+	ctx.EventManager().EmitEvents(events)
+}
+
+// getOrdersOfPair returns the orders resting in the long and short books of a pair, followed by its
+// stop orders that haven't been triggered yet
+func getOrdersOfPair(ctx sdk.Context, k *keeper.Keeper, contractAddr string, pair types.Pair) []types.Order {
+	orders := k.GetAllRestingOrdersForPair(ctx, contractAddr, pair)
+	return append(orders, k.GetAllTriggeredOrdersForPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)...)
+}
+
+func HandleSuspendContractProposal(ctx sdk.Context, k *keeper.Keeper, p *types.SuspendContractProposal) error {
+	if err := k.SuspendContract(ctx, p.ContractAddr, p.Reason); err != nil {
+		return err
+	}
+	// suspension changes will also affect dependency traversal since suspended contracts are skipped
+	dexutils.GetMemState(ctx.Context()).ClearContractToDependencies()
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSuspendContract,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, p.ContractAddr),
+		sdk.NewAttribute(types.AttributeKeyReason, p.Reason),
+	))
+	return nil
+}
+
+func HandleUnsuspendContractProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UnsuspendContractProposal) error {
+	contract, err := k.GetContract(ctx, p.ContractAddr)
+	if err != nil {
+		return err
+	}
+	if !contract.Suspended {
+		return types.ErrContractNotSuspended
+	}
+	contract.Suspended = false
+	contract.SuspensionReason = ""
+	if err := k.SetContract(ctx, &contract); err != nil {
+		return err
+	}
+	// suspension changes will also affect dependency traversal since suspended contracts are skipped
+	dexutils.GetMemState(ctx.Context()).ClearContractToDependencies()
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnsuspendContract,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, p.ContractAddr),
+	))
+	return nil
+}
@@ -0,0 +1,238 @@
+package dex_test
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex"
+	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestHandleRegisterPairsProposal(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexkeeper := testApp.DexKeeper
+	proposal := types.RegisterPairsProposal{
+		Title:       "title",
+		Description: "description",
+		Batchcontractpair: []types.BatchContractPair{{
+			ContractAddr: keepertest.TestContract,
+			Pairs:        []*types.Pair{&keepertest.TestPair},
+		}},
+	}
+	require.Nil(t, proposal.ValidateBasic())
+
+	// contract isn't registered
+	require.NotNil(t, dex.HandleRegisterPairsProposal(ctx, &dexkeeper, &proposal))
+
+	require.Nil(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract, Creator: keepertest.TestAccount}))
+	require.Nil(t, dex.HandleRegisterPairsProposal(ctx, &dexkeeper, &proposal))
+	pair, found := dexkeeper.GetRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.True(t, found)
+	require.Equal(t, keepertest.TestPair, pair)
+
+	// batch auction pairs can't enable self-trade prevention
+	batchAuctionPair := keepertest.TestPair
+	batchAuctionPair.BatchAuction = true
+	batchAuctionPair.SelfTradePrevention = types.SelfTradePrevention_CANCEL_MAKER
+	proposal.Batchcontractpair[0].Pairs = []*types.Pair{&batchAuctionPair}
+	require.NotNil(t, proposal.ValidateBasic())
+	batchAuctionPair.SelfTradePrevention = types.SelfTradePrevention_NONE
+	require.Nil(t, proposal.ValidateBasic())
+}
+
+func TestHandleUpdateTickSizeProposal(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexkeeper := testApp.DexKeeper
+	proposal := types.UpdateTickSizeProposal{
+		Title:       "title",
+		Description: "description",
+		PriceTickSizes: []types.TickSize{{
+			ContractAddr: keepertest.TestContract,
+			Pair:         &keepertest.TestPair,
+			Ticksize:     sdk.MustNewDecFromStr("0.5"),
+		}},
+		QuantityTickSizes: []types.TickSize{{
+			ContractAddr: keepertest.TestContract,
+			Pair:         &keepertest.TestPair,
+			Ticksize:     sdk.MustNewDecFromStr("2"),
+		}},
+	}
+	require.Nil(t, proposal.ValidateBasic())
+
+	// pair isn't registered
+	require.ErrorIs(t, dex.HandleUpdateTickSizeProposal(ctx, &dexkeeper, &proposal), types.ErrPairNotRegistered)
+
+	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.Nil(t, dex.HandleUpdateTickSizeProposal(ctx, &dexkeeper, &proposal))
+	priceTickSize, _ := dexkeeper.GetPriceTickSizeForPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), priceTickSize)
+	quantityTickSize, _ := dexkeeper.GetQuantityTickSizeForPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.Equal(t, sdk.MustNewDecFromStr("2"), quantityTickSize)
+}
+
+func TestHandleSuspendAndUnsuspendContractProposals(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(testApp.GetMemKey(types.MemStoreKey))))
+	dexkeeper := testApp.DexKeeper
+	require.Nil(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract, Creator: keepertest.TestAccount, RentBalance: 1}))
+
+	unsuspendProposal := types.UnsuspendContractProposal{Title: "title", Description: "description", ContractAddr: keepertest.TestContract}
+	require.ErrorIs(t, dex.HandleUnsuspendContractProposal(ctx, &dexkeeper, &unsuspendProposal), types.ErrContractNotSuspended)
+
+	suspendProposal := types.SuspendContractProposal{Title: "title", Description: "description", ContractAddr: keepertest.TestContract, Reason: "exploit"}
+	require.Nil(t, dex.HandleSuspendContractProposal(ctx, &dexkeeper, &suspendProposal))
+	contract, err := dexkeeper.GetContract(ctx, keepertest.TestContract)
+	require.Nil(t, err)
+	require.True(t, contract.Suspended)
+	require.Equal(t, "exploit", contract.SuspensionReason)
+
+	// unsuspending through governance doesn't charge the unsuspend cost
+	require.Nil(t, dex.HandleUnsuspendContractProposal(ctx, &dexkeeper, &unsuspendProposal))
+	contract, err = dexkeeper.GetContract(ctx, keepertest.TestContract)
+	require.Nil(t, err)
+	require.False(t, contract.Suspended)
+	require.Equal(t, "", contract.SuspensionReason)
+	require.Equal(t, uint64(1), contract.RentBalance)
+}
+
+func TestHandleDelistPairsProposal(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(testApp.GetMemKey(types.MemStoreKey))))
+	dexkeeper := testApp.DexKeeper
+	pair := types.Pair{PriceDenom: "SEI", AssetDenom: "ATOM"}
+
+	testAccount, _ := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10000000)), sdk.NewCoin("uusdc", sdk.NewInt(10000000)))
+	bankkeeper := testApp.BankKeeper
+	bankkeeper.MintCoins(ctx, minttypes.ModuleName, amounts)
+	bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, testAccount, amounts)
+	dexAmounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(5000000)), sdk.NewCoin("uusdc", sdk.NewInt(10000000)))
+	bankkeeper.SendCoinsFromAccountToModule(ctx, testAccount, types.ModuleName, dexAmounts)
+	wasm, err := ioutil.ReadFile("./testdata/mars.wasm")
+	if err != nil {
+		panic(err)
+	}
+	wasmKeeper := testApp.WasmKeeper
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&wasmKeeper)
+	var perm *wasmtypes.AccessConfig
+	codeId, err := contractKeeper.Create(ctx, testAccount, wasm, perm)
+	if err != nil {
+		panic(err)
+	}
+	contractAddr, _, err := contractKeeper.Instantiate(ctx, codeId, testAccount, testAccount, []byte(GOOD_CONTRACT_INSTANTIATE), "test",
+		sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(100000))))
+	if err != nil {
+		panic(err)
+	}
+	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
+	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
+		&types.Order{
+			Id:                1,
+			Account:           testAccount.String(),
+			ContractAddr:      contractAddr.String(),
+			Price:             sdk.MustNewDecFromStr("1"),
+			Quantity:          sdk.MustNewDecFromStr("1"),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			OrderType:         types.OrderType_LIMIT,
+			PositionDirection: types.PositionDirection_LONG,
+			Data:              "{\"position_effect\":\"Open\",\"leverage\":\"1\"}",
+		},
+	)
+	dexutils.GetMemState(ctx.Context()).GetDepositInfo(ctx, types.ContractAddress(contractAddr.String())).Add(
+		&types.DepositInfoEntry{
+			Creator: testAccount.String(),
+			Denom:   "uusdc",
+			Amount:  sdk.MustNewDecFromStr("2000000"),
+		},
+	)
+	dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contractAddr.String(), dexkeeper.GetContractWithoutGasCharge)
+	ctx = ctx.WithBlockHeight(1)
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+	_, found := dexkeeper.GetLongBookByPrice(ctx, contractAddr.String(), sdk.MustNewDecFromStr("1"), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	dexutils.GetMemState(ctx.Context()).Clear(ctx)
+	// orders that rested before the account index existed are only found in the book
+	dexkeeper.RemoveAccountActiveOrder(ctx, contractAddr.String(), pair, testAccount.String(), 1)
+	// an order and a cancellation for the pair in the block the proposal is executed in
+	memState := dexutils.GetMemState(ctx.Context())
+	memState.GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
+		&types.Order{
+			Id:                2,
+			Account:           testAccount.String(),
+			ContractAddr:      contractAddr.String(),
+			Price:             sdk.MustNewDecFromStr("1"),
+			Quantity:          sdk.MustNewDecFromStr("1"),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			OrderType:         types.OrderType_LIMIT,
+			PositionDirection: types.PositionDirection_LONG,
+			Data:              "{\"position_effect\":\"Open\",\"leverage\":\"1\"}",
+		},
+	)
+	memState.GetBlockCancels(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
+		&types.Cancellation{
+			Id:           1,
+			Creator:      testAccount.String(),
+			ContractAddr: contractAddr.String(),
+			PriceDenom:   pair.PriceDenom,
+			AssetDenom:   pair.AssetDenom,
+			Price:        sdk.MustNewDecFromStr("1"),
+		},
+	)
+
+	proposal := types.DelistPairsProposal{
+		Title:       "title",
+		Description: "description",
+		Batchcontractpair: []types.BatchContractPair{{
+			ContractAddr: contractAddr.String(),
+			Pairs:        []*types.Pair{&pair},
+		}},
+	}
+	require.Nil(t, proposal.ValidateBasic())
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, dex.HandleDelistPairsProposal(ctx, &dexkeeper, &proposal))
+	require.False(t, dexkeeper.HasRegisteredPair(ctx, contractAddr.String(), pair.PriceDenom, pair.AssetDenom))
+	require.Empty(t, dexkeeper.GetAllLongBookForPair(ctx, contractAddr.String(), pair.PriceDenom, pair.AssetDenom))
+	require.Empty(t, dexkeeper.GetAllAccountActiveOrdersForPair(ctx, contractAddr.String(), pair))
+	require.Empty(t, memState.GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Get())
+	require.Empty(t, memState.GetBlockCancels(ctx, types.ContractAddress(contractAddr.String()), pair).Get())
+	cancelledIDs := []string{}
+	rejectedIDs := []string{}
+	for _, event := range ctx.EventManager().Events() {
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) != types.AttributeKeyOrderID {
+				continue
+			}
+			switch event.Type {
+			case types.EventTypeCancelOrder:
+				cancelledIDs = append(cancelledIDs, string(attribute.Value))
+			case types.EventTypeRejectOrder:
+				rejectedIDs = append(rejectedIDs, string(attribute.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{"1"}, cancelledIDs)
+	// the order placed in the same block never reaches the contract
+	require.Equal(t, []string{"2"}, rejectedIDs)
+
+	// pair is no longer registered
+	require.ErrorIs(t, dex.HandleDelistPairsProposal(ctx, &dexkeeper, &proposal), types.ErrPairNotRegistered)
+}
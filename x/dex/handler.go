@@ -47,6 +47,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgUnsuspendContract:
 			res, err := msgServer.UnsuspendContract(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelAll:
+			res, err := msgServer.CancelAll(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelReplace:
+			res, err := msgServer.CancelReplace(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetRentLowBalanceThresholds:
+			res, err := msgServer.SetRentLowBalanceThresholds(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetRentAutoTopup:
+			res, err := msgServer.SetRentAutoTopup(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -60,6 +72,20 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.AddAssetMetadataProposal:
 			return HandleAddAssetMetadataProposal(ctx, &k, c)
+		case *types.UpdateFeeScheduleProposal:
+			return HandleUpdateFeeScheduleProposal(ctx, &k, c)
+		case *types.UpdateCircuitBreakerProposal:
+			return HandleUpdateCircuitBreakerProposal(ctx, &k, c)
+		case *types.RegisterPairsProposal:
+			return HandleRegisterPairsProposal(ctx, &k, c)
+		case *types.UpdateTickSizeProposal:
+			return HandleUpdateTickSizeProposal(ctx, &k, c)
+		case *types.DelistPairsProposal:
+			return HandleDelistPairsProposal(ctx, &k, c)
+		case *types.SuspendContractProposal:
+			return HandleSuspendContractProposal(ctx, &k, c)
+		case *types.UnsuspendContractProposal:
+			return HandleUnsuspendContractProposal(ctx, &k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized dex proposal content type: %T", c)
 		}
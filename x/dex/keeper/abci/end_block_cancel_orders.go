@@ -18,10 +18,11 @@ func (w KeeperWrapper) HandleEBCancelOrders(ctx context.Context, sdkCtx sdk.Cont
 
 	typedContractAddr := types.ContractAddress(contractAddr)
 	msg := w.getCancelSudoMsg(sdkCtx, typedContractAddr, registeredPairs)
-	if len(msg.OrderCancellations.IdsToCancel) == 0 {
+	cancellationCount := len(msg.OrderCancellations.IdsToCancel) + len(msg.OrderCancellations.Reductions)
+	if cancellationCount == 0 {
 		return nil
 	}
-	userProvidedGas := w.GetParams(sdkCtx).DefaultGasPerCancel * uint64(len(msg.OrderCancellations.IdsToCancel))
+	userProvidedGas := w.GetParams(sdkCtx).DefaultGasPerCancel * uint64(cancellationCount)
 	if _, err := utils.CallContractSudo(sdkCtx, w.Keeper, contractAddr, msg, userProvidedGas); err != nil {
 		sdkCtx.Logger().Error(fmt.Sprintf("Error during cancellation: %s", err.Error()))
 		return err
@@ -32,14 +33,20 @@ func (w KeeperWrapper) HandleEBCancelOrders(ctx context.Context, sdkCtx sdk.Cont
 
 func (w KeeperWrapper) getCancelSudoMsg(sdkCtx sdk.Context, typedContractAddr types.ContractAddress, registeredPairs []types.Pair) types.SudoOrderCancellationMsg {
 	idsToCancel := []uint64{}
+	reductions := []types.OrderReduction{}
 	for _, pair := range registeredPairs {
 		for _, cancel := range dexutils.GetMemState(sdkCtx.Context()).GetBlockCancels(sdkCtx, typedContractAddr, pair).Get() {
+			if cancel.Quantity != nil {
+				reductions = append(reductions, types.OrderReduction{ID: cancel.Id, Quantity: *cancel.Quantity})
+				continue
+			}
 			idsToCancel = append(idsToCancel, cancel.Id)
 		}
 	}
 	return types.SudoOrderCancellationMsg{
 		OrderCancellations: types.OrderCancellationMsgDetails{
 			IdsToCancel: idsToCancel,
+			Reductions:  reductions,
 		},
 	}
 }
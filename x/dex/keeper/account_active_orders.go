@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// SetAccountActiveOrder indexes an order resting in the long/short book under its account
+func (k Keeper) SetAccountActiveOrder(ctx sdk.Context, contractAddr string, order types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountActiveOrdersPrefix(contractAddr, order.PriceDenom, order.AssetDenom, order.Account))
+	b := k.Cdc.MustMarshal(&order)
+	store.Set(GetKeyForOrderID(order.Id), b)
+}
+
+func (k Keeper) GetAccountActiveOrder(ctx sdk.Context, contractAddr string, pair types.Pair, account string, orderID uint64) (val types.Order, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountActiveOrdersPrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, account))
+	b := store.Get(GetKeyForOrderID(orderID))
+	if b == nil {
+		return val, false
+	}
+	k.Cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) RemoveAccountActiveOrder(ctx sdk.Context, contractAddr string, pair types.Pair, account string, orderID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountActiveOrdersPrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, account))
+	store.Delete(GetKeyForOrderID(orderID))
+}
+
+// GetAccountActiveOrdersForPair returns the resting orders of an account in a pair, ordered by order ID
+func (k Keeper) GetAccountActiveOrdersForPair(ctx sdk.Context, contractAddr string, pair types.Pair, account string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountActiveOrdersPrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, account))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetOrdersToCancelAll returns the orders of the sender that a MsgCancelAll cancels: resting orders
+// are found through the account index, and stop orders that have not been triggered yet through the
+// trigger book
+func (k Keeper) GetOrdersToCancelAll(ctx sdk.Context, msg *types.MsgCancelAll) (list []types.Order) {
+	pairs := k.GetAllRegisteredPairs(ctx, msg.ContractAddr)
+	if pair, ok := msg.GetPair(); ok {
+		pairs = []types.Pair{pair}
+	}
+	for _, pair := range pairs {
+		orders := k.GetAccountActiveOrdersForPair(ctx, msg.ContractAddr, pair, msg.Creator)
+		for _, triggeredOrder := range k.GetAllTriggeredOrdersForPair(ctx, msg.ContractAddr, pair.PriceDenom, pair.AssetDenom) {
+			if triggeredOrder.Account == msg.Creator {
+				orders = append(orders, triggeredOrder)
+			}
+		}
+		for _, order := range orders {
+			if msg.MatchesDirection(order.PositionDirection) {
+				list = append(list, order)
+			}
+		}
+	}
+	return
+}
+
+// GetAccountActiveOrders returns the resting orders of an account across all pairs of a contract,
+// ordered by pair and then order ID
+func (k Keeper) GetAccountActiveOrders(ctx sdk.Context, contractAddr string, account string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountActiveOrdersAccountPrefix(contractAddr, account))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAccountActiveOrdersPaginated returns the resting orders of an account across all pairs of a contract,
+// ordered by pair and then order ID
+func (k Keeper) GetAccountActiveOrdersPaginated(ctx sdk.Context, contractAddr string, account string, page *query.PageRequest) (list []types.Order, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountActiveOrdersAccountPrefix(contractAddr, account))
+	pageRes, err = query.Paginate(store, page, func(key []byte, value []byte) error {
+		var order types.Order
+		if err := k.Cdc.Unmarshal(value, &order); err != nil {
+			return err
+		}
+		list = append(list, order)
+		return nil
+	})
+	return
+}
+
+// GetAllAccountActiveOrdersForPair returns the resting orders of all accounts in a pair. The index is
+// keyed by account first, so this goes through the orders of all pairs of the contract.
+func (k Keeper) GetAllAccountActiveOrdersForPair(ctx sdk.Context, contractAddr string, pair types.Pair) (list []types.Order) {
+	for _, order := range k.GetAllAccountActiveOrders(ctx, contractAddr) {
+		if order.PriceDenom == pair.PriceDenom && order.AssetDenom == pair.AssetDenom {
+			list = append(list, order)
+		}
+	}
+	return
+}
+
+// RemoveAllAccountActiveOrdersForPair drops the account index entries of all orders in a pair
+func (k Keeper) RemoveAllAccountActiveOrdersForPair(ctx sdk.Context, contractAddr string, pair types.Pair) {
+	for _, order := range k.GetAllAccountActiveOrdersForPair(ctx, contractAddr, pair) {
+		k.RemoveAccountActiveOrder(ctx, contractAddr, pair, order.Account, order.Id)
+	}
+}
+
+// GetAllAccountActiveOrders returns the resting orders of all accounts of a contract
+func (k Keeper) GetAllAccountActiveOrders(ctx sdk.Context, contractAddr string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountActiveOrdersContractPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllRestingOrdersForPair returns the orders resting in the long and short books of a pair, built
+// from the book allocations rather than the account index, so that orders placed before the index
+// existed are included
+func (k Keeper) GetAllRestingOrdersForPair(ctx sdk.Context, contractAddr string, pair types.Pair) (list []types.Order) {
+	for _, direction := range []types.PositionDirection{types.PositionDirection_LONG, types.PositionDirection_SHORT} {
+		entries := k.GetAllLongBookForPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+		if direction == types.PositionDirection_SHORT {
+			entries = k.GetAllShortBookForPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+		}
+		for _, entry := range entries {
+			for _, allocation := range entry.GetOrderEntry().Allocations {
+				list = append(list, types.Order{
+					Id:                allocation.OrderId,
+					Account:           allocation.Account,
+					ContractAddr:      contractAddr,
+					Price:             entry.GetPrice(),
+					Quantity:          allocation.Quantity,
+					PriceDenom:        pair.PriceDenom,
+					AssetDenom:        pair.AssetDenom,
+					OrderType:         types.OrderType_LIMIT,
+					PositionDirection: direction,
+				})
+			}
+		}
+	}
+	return
+}
+
+func (k Keeper) RemoveAllAccountActiveOrdersForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.AccountActiveOrdersContractPrefix(contractAddr))
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountActiveOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	otherPair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: "sei"}
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, otherPair)
+	for _, order := range []types.Order{
+		{Id: 3, Account: keepertest.TestAccount, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom},
+		{Id: 1, Account: keepertest.TestAccount, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom},
+		{Id: 2, Account: keepertest.TestAccount, PriceDenom: otherPair.PriceDenom, AssetDenom: otherPair.AssetDenom},
+		{Id: 4, Account: keepertest.TestContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom},
+	} {
+		order.Price = sdk.OneDec()
+		order.Quantity = sdk.OneDec()
+		keeper.SetAccountActiveOrder(ctx, keepertest.TestContract, order)
+	}
+
+	orders := keeper.GetAccountActiveOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPair, keepertest.TestAccount)
+	require.Equal(t, 2, len(orders))
+	require.Equal(t, uint64(1), orders[0].Id)
+	require.Equal(t, uint64(3), orders[1].Id)
+	require.Equal(t, 3, len(keeper.GetAccountActiveOrders(ctx, keepertest.TestContract, keepertest.TestAccount)))
+	require.Equal(t, 4, len(keeper.GetAllAccountActiveOrders(ctx, keepertest.TestContract)))
+	require.Equal(t, 3, len(keeper.GetAllAccountActiveOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPair)))
+
+	// pages only go through the orders of the account
+	page, pageRes, err := keeper.GetAccountActiveOrdersPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, &query.PageRequest{Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(page))
+	require.Equal(t, uint64(3), pageRes.Total)
+	page, pageRes, err = keeper.GetAccountActiveOrdersPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, &query.PageRequest{Key: pageRes.NextKey})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(page))
+	require.Nil(t, pageRes.NextKey)
+
+	_, found := keeper.GetAccountActiveOrder(ctx, keepertest.TestContract, otherPair, keepertest.TestAccount, 2)
+	require.True(t, found)
+	keeper.RemoveAccountActiveOrder(ctx, keepertest.TestContract, otherPair, keepertest.TestAccount, 2)
+	_, found = keeper.GetAccountActiveOrder(ctx, keepertest.TestContract, otherPair, keepertest.TestAccount, 2)
+	require.False(t, found)
+	require.Equal(t, 2, len(keeper.GetAccountActiveOrders(ctx, keepertest.TestContract, keepertest.TestAccount)))
+
+	keeper.RemoveAllAccountActiveOrdersForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllAccountActiveOrders(ctx, keepertest.TestContract))
+}
+
+func TestGetAllRestingOrdersForPair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.SetLongOrderBookEntry(ctx, keepertest.TestContract, &types.LongBook{
+		Price: sdk.NewDec(9),
+		Entry: &types.OrderEntry{
+			Price:      sdk.NewDec(9),
+			Quantity:   sdk.NewDec(3),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{OrderId: 1, Account: keepertest.TestAccount, Quantity: sdk.NewDec(1)},
+				{OrderId: 2, Account: "def", Quantity: sdk.NewDec(2)},
+			},
+		},
+	})
+	keeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, &types.ShortBook{
+		Price: sdk.NewDec(11),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(11),
+			Quantity:    sdk.NewDec(4),
+			PriceDenom:  keepertest.TestPriceDenom,
+			AssetDenom:  keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{{OrderId: 3, Account: keepertest.TestAccount, Quantity: sdk.NewDec(4)}},
+		},
+	})
+
+	// none of the orders are in the account index
+	require.Empty(t, keeper.GetAllAccountActiveOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPair))
+	orders := keeper.GetAllRestingOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.Equal(t, 3, len(orders))
+	require.Equal(t, types.Order{
+		Id:                2,
+		Account:           "def",
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(9),
+		Quantity:          sdk.NewDec(2),
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+	}, orders[1])
+	require.Equal(t, uint64(3), orders[2].Id)
+	require.Equal(t, types.PositionDirection_SHORT, orders[2].PositionDirection)
+	require.Equal(t, sdk.NewDec(11), orders[2].Price)
+}
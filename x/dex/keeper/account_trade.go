@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// AddAccountTrades indexes the settlements of a block by account. Trades are keyed by height and
+// by their position among the contract's settlements of the block, so that they are iterated in
+// the order they happened. Trades that are older than the retention period or beyond the most
+// recent MaxAccountTrades of an account are pruned.
+func (k Keeper) AddAccountTrades(ctx sdk.Context, contractAddr string, settlements []*types.SettlementEntry) {
+	accounts := []string{}
+	added := map[string]uint64{}
+	for i, settlement := range settlements {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountTradePrefix(contractAddr, settlement.Account))
+		key := GetKeyForAccountTrade(settlement.Height, uint64(i))
+		if _, ok := added[settlement.Account]; !ok {
+			added[settlement.Account] = 0
+			accounts = append(accounts, settlement.Account)
+		}
+		if !store.Has(key) {
+			added[settlement.Account]++
+		}
+		store.Set(key, k.Cdc.MustMarshal(settlement))
+	}
+	for _, account := range accounts {
+		k.setAccountTradeCount(ctx, contractAddr, account, k.GetAccountTradeCount(ctx, contractAddr, account)+added[account])
+		k.pruneAccountTrades(ctx, contractAddr, account)
+	}
+}
+
+// pruneAccountTrades deletes the oldest trades of an account until the remaining ones are all
+// within the retention period and no more than MaxAccountTrades. Trades are keyed by height, so
+// iteration stops at the first trade that is kept.
+func (k Keeper) pruneAccountTrades(ctx sdk.Context, contractAddr string, account string) {
+	cutoff := uint64(0)
+	if now := uint64(ctx.BlockTime().Unix()); now > types.AccountTradeRetentionInSeconds {
+		cutoff = now - types.AccountTradeRetentionInSeconds
+	}
+	count := k.GetAccountTradeCount(ctx, contractAddr, account)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountTradePrefix(contractAddr, account))
+	iterator := store.Iterator(nil, nil)
+	keysToDelete := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		if count <= types.MaxAccountTrades {
+			var val types.SettlementEntry
+			k.Cdc.MustUnmarshal(iterator.Value(), &val)
+			if val.Timestamp >= cutoff {
+				break
+			}
+		}
+		keysToDelete = append(keysToDelete, iterator.Key())
+		if count > 0 {
+			count--
+		}
+	}
+	iterator.Close()
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+	k.setAccountTradeCount(ctx, contractAddr, account, count)
+}
+
+// GetAccountTradeCount returns the number of trades currently kept for an account
+func (k Keeper) GetAccountTradeCount(ctx sdk.Context, contractAddr string, account string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountTradeCountPrefix(contractAddr))
+	value := store.Get(types.AddressKeyPrefix(account))
+	if value == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(value)
+}
+
+func (k Keeper) setAccountTradeCount(ctx sdk.Context, contractAddr string, account string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountTradeCountPrefix(contractAddr))
+	if count == 0 {
+		store.Delete(types.AddressKeyPrefix(account))
+		return
+	}
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, count)
+	store.Set(types.AddressKeyPrefix(account), value)
+}
+
+// GetAccountTradesPaginated returns the trades of an account whose height and timestamp are within
+// the given inclusive ranges, where a zero bound means unbounded
+func (k Keeper) GetAccountTradesPaginated(
+	ctx sdk.Context,
+	contractAddr string,
+	account string,
+	startHeight uint64,
+	endHeight uint64,
+	startTimestamp uint64,
+	endTimestamp uint64,
+	page *query.PageRequest,
+) (list []types.SettlementEntry, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountTradePrefix(contractAddr, account))
+	pageRes, err = query.FilteredPaginate(store, page, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var trade types.SettlementEntry
+		if err := k.Cdc.Unmarshal(value, &trade); err != nil {
+			return false, err
+		}
+		if trade.Height < startHeight || (endHeight > 0 && trade.Height > endHeight) ||
+			trade.Timestamp < startTimestamp || (endTimestamp > 0 && trade.Timestamp > endTimestamp) {
+			return false, nil
+		}
+		if accumulate {
+			list = append(list, trade)
+		}
+		return true, nil
+	})
+	return
+}
+
+func (k Keeper) GetAllAccountTrades(ctx sdk.Context, contractAddr string, account string) (list []types.SettlementEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountTradePrefix(contractAddr, account))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SettlementEntry
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllAccountTradesForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.AccountTradeContractPrefix(contractAddr))
+	k.removeAllForPrefix(ctx, types.AccountTradeCountPrefix(contractAddr))
+}
+
+// RemoveAllAccountTradesForPair removes the trades of all accounts in a pair. Trades are indexed by
+// account, so all trades of the contract are scanned.
+func (k Keeper) RemoveAllAccountTradesForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountTradeContractPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	keysToDelete := [][]byte{}
+	accounts := []string{}
+	removed := map[string]uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SettlementEntry
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		if val.PriceDenom == priceDenom && val.AssetDenom == assetDenom {
+			keysToDelete = append(keysToDelete, iterator.Key())
+			if _, ok := removed[val.Account]; !ok {
+				accounts = append(accounts, val.Account)
+			}
+			removed[val.Account]++
+		}
+	}
+	iterator.Close()
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+	for _, account := range accounts {
+		count := k.GetAccountTradeCount(ctx, contractAddr, account)
+		if count > removed[account] {
+			count -= removed[account]
+		} else {
+			count = 0
+		}
+		k.setAccountTradeCount(ctx, contractAddr, account, count)
+	}
+}
+
+func GetKeyForAccountTrade(height uint64, index uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key, height)
+	binary.BigEndian.PutUint64(key[8:], index)
+	return key
+}
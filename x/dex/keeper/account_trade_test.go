@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testTrade(account string, orderID uint64, height uint64, timestamp uint64) *types.SettlementEntry {
+	return &types.SettlementEntry{
+		Account:                account,
+		PriceDenom:             keepertest.TestPriceDenom,
+		AssetDenom:             keepertest.TestAssetDenom,
+		Quantity:               sdk.OneDec(),
+		ExecutionCostOrProceed: sdk.NewDec(10),
+		ExpectedCostOrProceed:  sdk.NewDec(10),
+		PositionDirection:      "Long",
+		OrderType:              "Limit",
+		OrderId:                orderID,
+		Height:                 height,
+		Timestamp:              timestamp,
+	}
+}
+
+func TestAddAccountTrades(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(100, 0))
+	keeper.AddAccountTrades(ctx, keepertest.TestContract, []*types.SettlementEntry{
+		testTrade(keepertest.TestAccount, 1, 1, 100),
+		testTrade(keepertest.TestContract, 2, 1, 100),
+		testTrade(keepertest.TestAccount, 3, 1, 100),
+	})
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(time.Unix(200, 0))
+	keeper.AddAccountTrades(ctx, keepertest.TestContract, []*types.SettlementEntry{
+		testTrade(keepertest.TestAccount, 4, 2, 200),
+	})
+
+	trades := keeper.GetAllAccountTrades(ctx, keepertest.TestContract, keepertest.TestAccount)
+	require.Equal(t, 3, len(trades))
+	for i, orderID := range []uint64{1, 3, 4} {
+		require.Equal(t, orderID, trades[i].OrderId)
+	}
+	require.Equal(t, 1, len(keeper.GetAllAccountTrades(ctx, keepertest.TestContract, keepertest.TestContract)))
+	require.Equal(t, uint64(3), keeper.GetAccountTradeCount(ctx, keepertest.TestContract, keepertest.TestAccount))
+	require.Equal(t, uint64(1), keeper.GetAccountTradeCount(ctx, keepertest.TestContract, keepertest.TestContract))
+
+	trades, _, err := keeper.GetAccountTradesPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, 2, 0, 0, 0, nil)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(trades))
+	require.Equal(t, uint64(4), trades[0].OrderId)
+	trades, _, err = keeper.GetAccountTradesPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, 0, 0, 0, 150, nil)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(trades))
+
+	keeper.RemoveAllAccountTradesForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllAccountTrades(ctx, keepertest.TestContract, keepertest.TestAccount))
+	require.Equal(t, uint64(0), keeper.GetAccountTradeCount(ctx, keepertest.TestContract, keepertest.TestAccount))
+}
+
+func TestRemoveAllAccountTradesForPair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(100, 0))
+	otherPairTrade := testTrade(keepertest.TestAccount, 2, 1, 100)
+	otherPairTrade.AssetDenom = "uatom"
+	keeper.AddAccountTrades(ctx, keepertest.TestContract, []*types.SettlementEntry{
+		testTrade(keepertest.TestAccount, 1, 1, 100),
+		otherPairTrade,
+		testTrade(keepertest.TestContract, 3, 1, 100),
+	})
+
+	keeper.RemoveAllAccountTradesForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	trades := keeper.GetAllAccountTrades(ctx, keepertest.TestContract, keepertest.TestAccount)
+	require.Equal(t, 1, len(trades))
+	require.Equal(t, uint64(2), trades[0].OrderId)
+	require.Equal(t, uint64(1), keeper.GetAccountTradeCount(ctx, keepertest.TestContract, keepertest.TestAccount))
+	require.Empty(t, keeper.GetAllAccountTrades(ctx, keepertest.TestContract, keepertest.TestContract))
+	require.Equal(t, uint64(0), keeper.GetAccountTradeCount(ctx, keepertest.TestContract, keepertest.TestContract))
+}
+
+func TestAddAccountTradesPruning(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(100, 0))
+	keeper.AddAccountTrades(ctx, keepertest.TestContract, []*types.SettlementEntry{testTrade(keepertest.TestAccount, 1, 1, 100)})
+
+	// trades that have fallen out of the retention period are pruned
+	timestamp := uint64(100 + types.AccountTradeRetentionInSeconds + 1)
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(time.Unix(int64(timestamp), 0))
+	keeper.AddAccountTrades(ctx, keepertest.TestContract, []*types.SettlementEntry{testTrade(keepertest.TestAccount, 2, 2, timestamp)})
+	trades := keeper.GetAllAccountTrades(ctx, keepertest.TestContract, keepertest.TestAccount)
+	require.Equal(t, 1, len(trades))
+	require.Equal(t, uint64(2), trades[0].OrderId)
+	require.Equal(t, uint64(1), keeper.GetAccountTradeCount(ctx, keepertest.TestContract, keepertest.TestAccount))
+
+	// only the most recent trades are kept
+	ctx = ctx.WithBlockHeight(3)
+	settlements := []*types.SettlementEntry{}
+	for i := 0; i < types.MaxAccountTrades; i++ {
+		settlements = append(settlements, testTrade(keepertest.TestAccount, uint64(i+3), 3, timestamp))
+	}
+	keeper.AddAccountTrades(ctx, keepertest.TestContract, settlements)
+	trades = keeper.GetAllAccountTrades(ctx, keepertest.TestContract, keepertest.TestAccount)
+	require.Equal(t, types.MaxAccountTrades, len(trades))
+	require.Equal(t, uint64(3), trades[0].OrderId)
+	require.Equal(t, uint64(types.MaxAccountTrades), keeper.GetAccountTradeCount(ctx, keepertest.TestContract, keepertest.TestAccount))
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+func (k Keeper) SetCandle(ctx sdk.Context, contractAddr string, pair types.Pair, interval types.CandleInterval, candle types.PriceCandlestick) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, interval))
+	b := k.Cdc.MustMarshal(&candle)
+	store.Set(GetKeyForTs(candle.BeginTimestamp), b)
+}
+
+func (k Keeper) GetCandle(ctx sdk.Context, contractAddr string, pair types.Pair, interval types.CandleInterval, beginTimestamp uint64) (types.PriceCandlestick, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, interval))
+	res := types.PriceCandlestick{}
+	b := store.Get(GetKeyForTs(beginTimestamp))
+	if b == nil {
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res, true
+}
+
+// UpdateCandles folds a trade at the given price and quantity into the candle of every
+// interval that contains the timestamp, creating the candle if it is the first trade
+// of its bucket.
+func (k Keeper) UpdateCandles(ctx sdk.Context, contractAddr string, pair types.Pair, timestamp uint64, price sdk.Dec, quantity sdk.Dec) {
+	for _, interval := range types.CandleIntervals {
+		length, err := types.GetCandleIntervalInSeconds(interval)
+		if err != nil {
+			panic(err)
+		}
+		beginTimestamp := timestamp - timestamp%length
+		candle, found := k.GetCandle(ctx, contractAddr, pair, interval, beginTimestamp)
+		if !found {
+			open := price
+			candle = types.PriceCandlestick{
+				BeginTimestamp: beginTimestamp,
+				EndTimestamp:   beginTimestamp + length,
+				Open:           &open,
+				High:           &price,
+				Low:            &price,
+				Volume:         &quantity,
+			}
+		} else {
+			if price.GT(*candle.High) {
+				candle.High = &price
+			}
+			if price.LT(*candle.Low) {
+				candle.Low = &price
+			}
+			volume := candle.Volume.Add(quantity)
+			candle.Volume = &volume
+		}
+		candle.Close = &price
+		k.SetCandle(ctx, contractAddr, pair, interval, candle)
+	}
+}
+
+// GetLatestCandles returns up to `limit` candles of the given interval, latest first.
+// Buckets without any trade have no candle.
+func (k Keeper) GetLatestCandles(ctx sdk.Context, contractAddr string, pair types.Pair, interval types.CandleInterval, limit uint64) (list []*types.PriceCandlestick) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, interval))
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(list)) < limit; iterator.Next() {
+		var val types.PriceCandlestick
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, &val)
+	}
+
+	return
+}
+
+// GetAllCandles returns the candles of all intervals of a pair, ordered by interval and then by time
+func (k Keeper) GetAllCandles(ctx sdk.Context, contractAddr string, pair types.Pair) (list []types.Candle) {
+	for _, interval := range types.CandleIntervals {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, interval))
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+		for ; iterator.Valid(); iterator.Next() {
+			var val types.PriceCandlestick
+			k.Cdc.MustUnmarshal(iterator.Value(), &val)
+			list = append(list, types.Candle{Interval: interval, Candlestick: val})
+		}
+		iterator.Close()
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllCandlesForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.CandleContractPrefix(contractAddr))
+}
+
+func (k Keeper) RemoveAllCandlesForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) {
+	k.removeAllForPrefix(ctx, append(types.CandleContractPrefix(contractAddr), types.PairPrefix(priceDenom, assetDenom)...))
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateCandles(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, 10, sdk.NewDec(100), sdk.NewDec(1))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, 20, sdk.NewDec(120), sdk.NewDec(2))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, 30, sdk.NewDec(90), sdk.NewDec(3))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, 70, sdk.NewDec(95), sdk.NewDec(4))
+
+	candle, found := keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleInterval_ONE_MINUTE, 0)
+	require.True(t, found)
+	require.Equal(t, uint64(60), candle.EndTimestamp)
+	require.Equal(t, sdk.NewDec(100), *candle.Open)
+	require.Equal(t, sdk.NewDec(120), *candle.High)
+	require.Equal(t, sdk.NewDec(90), *candle.Low)
+	require.Equal(t, sdk.NewDec(90), *candle.Close)
+	require.Equal(t, sdk.NewDec(6), *candle.Volume)
+
+	candle, found = keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleInterval_ONE_MINUTE, 60)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(95), *candle.Open)
+	require.Equal(t, sdk.NewDec(4), *candle.Volume)
+
+	candle, found = keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleInterval_ONE_DAY, 0)
+	require.True(t, found)
+	require.Equal(t, uint64(24*3600), candle.EndTimestamp)
+	require.Equal(t, sdk.NewDec(100), *candle.Open)
+	require.Equal(t, sdk.NewDec(95), *candle.Close)
+	require.Equal(t, sdk.NewDec(10), *candle.Volume)
+}
+
+func TestGetLatestCandles(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, 10, sdk.NewDec(100), sdk.NewDec(1))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, 70, sdk.NewDec(110), sdk.NewDec(1))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, 250, sdk.NewDec(120), sdk.NewDec(1))
+
+	candles := keeper.GetLatestCandles(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleInterval_ONE_MINUTE, 2)
+	require.Equal(t, 2, len(candles))
+	require.Equal(t, uint64(240), candles[0].BeginTimestamp)
+	require.Equal(t, uint64(60), candles[1].BeginTimestamp)
+
+	candles = keeper.GetLatestCandles(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleInterval_FIVE_MINUTES, 10)
+	require.Equal(t, 1, len(candles))
+	require.Equal(t, sdk.NewDec(3), *candles[0].Volume)
+
+	require.Equal(t, 3+1+1+1, len(keeper.GetAllCandles(ctx, keepertest.TestContract, keepertest.TestPair)))
+	keeper.RemoveAllCandlesForContract(ctx, keepertest.TestContract)
+	require.Equal(t, 0, len(keeper.GetAllCandles(ctx, keepertest.TestContract, keepertest.TestPair)))
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, circuitBreaker types.CircuitBreaker) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CircuitBreakerPrefix(circuitBreaker.ContractAddr))
+	b := k.Cdc.MustMarshal(&circuitBreaker)
+	store.Set(types.PairPrefix(circuitBreaker.PriceDenom, circuitBreaker.AssetDenom), b)
+}
+
+func (k Keeper) GetCircuitBreaker(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) (types.CircuitBreaker, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CircuitBreakerPrefix(contractAddr))
+	res := types.CircuitBreaker{}
+	b := store.Get(types.PairPrefix(priceDenom, assetDenom))
+	if b == nil {
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res, true
+}
+
+func (k Keeper) RemoveCircuitBreaker(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CircuitBreakerPrefix(contractAddr))
+	store.Delete(types.PairPrefix(priceDenom, assetDenom))
+}
+
+func (k Keeper) GetAllCircuitBreakers(ctx sdk.Context, contractAddr string) (list []types.CircuitBreaker) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CircuitBreakerPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CircuitBreaker
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllCircuitBreakersForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.CircuitBreakerPrefix(contractAddr))
+}
+
+func (k Keeper) SetPairHalt(ctx sdk.Context, halt types.PairHalt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PairHaltPrefix(halt.ContractAddr))
+	b := k.Cdc.MustMarshal(&halt)
+	store.Set(types.PairPrefix(halt.PriceDenom, halt.AssetDenom), b)
+}
+
+func (k Keeper) GetPairHalt(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) (types.PairHalt, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PairHaltPrefix(contractAddr))
+	res := types.PairHalt{}
+	b := store.Get(types.PairPrefix(priceDenom, assetDenom))
+	if b == nil {
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res, true
+}
+
+func (k Keeper) RemovePairHalt(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PairHaltPrefix(contractAddr))
+	store.Delete(types.PairPrefix(priceDenom, assetDenom))
+}
+
+// IsPairHalted returns whether matching of a pair is halted at the current block time. A halt
+// that has run out is only removed the next time the pair is executed.
+func (k Keeper) IsPairHalted(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) bool {
+	halt, found := k.GetPairHalt(ctx, contractAddr, priceDenom, assetDenom)
+	return found && halt.IsActive(uint64(ctx.BlockTime().Unix()))
+}
+
+func (k Keeper) GetAllPairHalts(ctx sdk.Context, contractAddr string) (list []types.PairHalt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PairHaltPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PairHalt
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllPairHaltsForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.PairHaltPrefix(contractAddr))
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	_, found := keeper.GetCircuitBreaker(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.False(t, found)
+
+	circuitBreaker := types.CircuitBreaker{
+		ContractAddr:        keepertest.TestContract,
+		PriceDenom:          keepertest.TestPriceDenom,
+		AssetDenom:          keepertest.TestAssetDenom,
+		MaxPriceDeviation:   sdk.MustNewDecFromStr("0.1"),
+		TwapLookbackSeconds: 600,
+		CooldownSeconds:     60,
+	}
+	keeper.SetCircuitBreaker(ctx, circuitBreaker)
+	res, found := keeper.GetCircuitBreaker(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.True(t, found)
+	require.Equal(t, circuitBreaker, res)
+	require.Equal(t, []types.CircuitBreaker{circuitBreaker}, keeper.GetAllCircuitBreakers(ctx, keepertest.TestContract))
+
+	keeper.RemoveCircuitBreaker(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.Empty(t, keeper.GetAllCircuitBreakers(ctx, keepertest.TestContract))
+}
+
+func TestPairHalt(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	require.False(t, keeper.IsPairHalted(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+
+	halt := types.PairHalt{
+		ContractAddr:         keepertest.TestContract,
+		PriceDenom:           keepertest.TestPriceDenom,
+		AssetDenom:           keepertest.TestAssetDenom,
+		Price:                sdk.NewDec(120),
+		Twap:                 sdk.NewDec(100),
+		HaltedAtTimestamp:    1000,
+		HaltedUntilTimestamp: 1060,
+	}
+	keeper.SetPairHalt(ctx, halt)
+	require.True(t, keeper.IsPairHalted(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+	require.Equal(t, []types.PairHalt{halt}, keeper.GetAllPairHalts(ctx, keepertest.TestContract))
+
+	// the halt runs out at its end timestamp
+	ctx = ctx.WithBlockTime(time.Unix(1060, 0))
+	require.False(t, keeper.IsPairHalted(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+
+	keeper.RemoveAllPairHaltsForContract(ctx, keepertest.TestContract)
+	_, found := keeper.GetPairHalt(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.False(t, found)
+}
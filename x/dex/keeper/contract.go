@@ -93,12 +93,15 @@ func (k Keeper) GetAllContractInfo(ctx sdk.Context) []types.ContractInfoV2 {
 	return list
 }
 
-// Reduce `RentBalance` of a contract if `userProvidedGas` cannot cover `gasUsed`
-func (k Keeper) ChargeRentForGas(ctx sdk.Context, contractAddr string, gasUsed uint64, gasAllowance uint64) error {
+// Reduce `RentBalance` of a contract if `userProvidedGas` cannot cover `gasUsed`, and record the
+// usage of the sudo call of type `msgType` in the rent ledger of the contract
+func (k Keeper) ChargeRentForGas(ctx sdk.Context, contractAddr string, msgType string, gasUsed uint64, gasAllowance uint64) error {
 	if gasUsed <= gasAllowance {
-		// Allowance can fully cover the consumed gas. Doing nothing
+		// Allowance can fully cover the consumed gas. Only recording the usage
+		k.RecordSudoRentUsage(ctx, contractAddr, msgType, gasUsed, 0)
 		return nil
 	}
+	totalGasUsed := gasUsed
 	gasUsed -= gasAllowance
 	contract, err := k.GetContract(ctx, contractAddr)
 	if err != nil {
@@ -111,12 +114,14 @@ func (k Keeper) ChargeRentForGas(ctx sdk.Context, contractAddr string, gasUsed u
 	}
 	gasFee := gasFeeDec.RoundInt().Uint64()
 	if gasFee > contract.RentBalance {
+		k.RecordSudoRentUsage(ctx, contractAddr, msgType, totalGasUsed, contract.RentBalance)
 		contract.RentBalance = 0
 		if err := k.SetContract(ctx, &contract); err != nil {
 			return err
 		}
 		return types.ErrInsufficientRent
 	}
+	k.RecordSudoRentUsage(ctx, contractAddr, msgType, totalGasUsed, gasFee)
 	contract.RentBalance -= gasFee
 	return k.SetContract(ctx, &contract)
 }
@@ -144,10 +149,23 @@ func (k Keeper) DoUnregisterContract(ctx sdk.Context, contract types.ContractInf
 	k.ClearDependenciesForContract(ctx, contract)
 	k.RemoveAllLongBooksForContract(ctx, contract.ContractAddr)
 	k.RemoveAllShortBooksForContract(ctx, contract.ContractAddr)
+	k.RemoveAllTriggeredOrdersForContract(ctx, contract.ContractAddr)
+	k.RemoveAllExpiringOrdersForContract(ctx, contract.ContractAddr)
+	k.RemoveAllAccountActiveOrdersForContract(ctx, contract.ContractAddr)
 	k.RemoveAllPricesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllVolumesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllCandlesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllFeeSchedulesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllAccountVolumesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllCircuitBreakersForContract(ctx, contract.ContractAddr)
+	k.RemoveAllPairHaltsForContract(ctx, contract.ContractAddr)
+	k.RemoveAllAccountTradesForContract(ctx, contract.ContractAddr)
 	k.DeleteMatchResultState(ctx, contract.ContractAddr)
 	k.DeleteNextOrderID(ctx, contract.ContractAddr)
 	k.DeleteAllRegisteredPairsForContract(ctx, contract.ContractAddr)
+	k.RemoveRentLedger(ctx, contract.ContractAddr)
+	k.RemoveRentConfig(ctx, contract.ContractAddr)
+	k.RemoveAllSuspensionRecordsForContract(ctx, contract.ContractAddr)
 }
 
 func (k Keeper) SuspendContract(ctx sdk.Context, contractAddress string, reason string) error {
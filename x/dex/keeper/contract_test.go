@@ -22,12 +22,12 @@ func TestChargeRentForGas(t *testing.T) {
 		RentBalance:  1000000,
 	})
 	require.Nil(t, err)
-	err = keeper.ChargeRentForGas(ctx, keepertest.TestContract, 5000000, 0)
+	err = keeper.ChargeRentForGas(ctx, keepertest.TestContract, "settlement", 5000000, 0)
 	require.Nil(t, err)
 	contract, err := keeper.GetContract(ctx, keepertest.TestContract)
 	require.Nil(t, err)
 	require.Equal(t, uint64(500000), contract.RentBalance)
-	err = keeper.ChargeRentForGas(ctx, keepertest.TestContract, 6000000, 0)
+	err = keeper.ChargeRentForGas(ctx, keepertest.TestContract, "settlement", 6000000, 0)
 	require.NotNil(t, err)
 	contract, err = keeper.GetContract(ctx, keepertest.TestContract)
 	require.Nil(t, err)
@@ -39,16 +39,19 @@ func TestChargeRentForGas(t *testing.T) {
 		RentBalance:  1000000,
 	})
 	require.Nil(t, err)
-	err = keeper.ChargeRentForGas(ctx, keepertest.TestContract, 5000000, 4000000)
+	err = keeper.ChargeRentForGas(ctx, keepertest.TestContract, "settlement", 5000000, 4000000)
 	require.Nil(t, err)
 	contract, err = keeper.GetContract(ctx, keepertest.TestContract)
 	require.Nil(t, err)
 	require.Equal(t, uint64(900000), contract.RentBalance)
-	err = keeper.ChargeRentForGas(ctx, keepertest.TestContract, 5000000, 6000000)
+	err = keeper.ChargeRentForGas(ctx, keepertest.TestContract, "settlement", 5000000, 6000000)
 	require.Nil(t, err)
 	contract, err = keeper.GetContract(ctx, keepertest.TestContract)
 	require.Nil(t, err)
 	require.Equal(t, uint64(900000), contract.RentBalance)
+	ledger := keeper.GetRentLedger(ctx, keepertest.TestContract)
+	require.Equal(t, uint64(1100000), ledger.TotalCharged)
+	require.Equal(t, []types.SudoRentUsage{{MsgType: "settlement", Calls: 4, GasConsumed: 21000000, RentCharged: 1100000}}, ledger.SudoUsages)
 
 	// delete contract
 	keeper.DeleteContract(ctx, keepertest.TestContract)
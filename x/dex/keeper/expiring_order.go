@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// SetExpiringOrder indexes a resting order by the height or the time after which it expires
+func (k Keeper) SetExpiringOrder(ctx sdk.Context, contractAddr string, expiringOrder types.ExpiringOrder) {
+	store, key := k.getExpiringOrderStoreAndKey(ctx, contractAddr, expiringOrder)
+	b := k.Cdc.MustMarshal(&expiringOrder)
+	store.Set(key, b)
+}
+
+func (k Keeper) RemoveExpiringOrder(ctx sdk.Context, contractAddr string, expiringOrder types.ExpiringOrder) {
+	store, key := k.getExpiringOrderStoreAndKey(ctx, contractAddr, expiringOrder)
+	store.Delete(key)
+}
+
+// GetOrdersExpiredBefore returns all indexed orders of a pair whose expiry height is lower
+// than the given height or whose expiry timestamp is earlier than the given timestamp,
+// ordered by expiry height first and then by expiry timestamp
+func (k Keeper) GetOrdersExpiredBefore(ctx sdk.Context, contractAddr string, pair types.Pair, height int64, timestamp uint64) (list []types.ExpiringOrder) {
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExpiringOrderPrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	list = append(list, k.getExpiringOrdersBefore(heightStore, uint64(height))...)
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExpiringOrderByTimePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	list = append(list, k.getExpiringOrdersBefore(timeStore, timestamp)...)
+	return
+}
+
+// RemoveOrdersExpiredBefore drops all index entries that GetOrdersExpiredBefore would return
+func (k Keeper) RemoveOrdersExpiredBefore(ctx sdk.Context, contractAddr string, pair types.Pair, height int64, timestamp uint64) {
+	for _, expiringOrder := range k.GetOrdersExpiredBefore(ctx, contractAddr, pair, height, timestamp) {
+		k.RemoveExpiringOrder(ctx, contractAddr, expiringOrder)
+	}
+}
+
+func (k Keeper) GetAllExpiringOrders(ctx sdk.Context, contractAddr string) (list []types.ExpiringOrder) {
+	for _, contractPrefix := range [][]byte{
+		types.ExpiringOrderContractPrefix(contractAddr),
+		types.ExpiringOrderByTimeContractPrefix(contractAddr),
+	} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), contractPrefix)
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+		for ; iterator.Valid(); iterator.Next() {
+			var val types.ExpiringOrder
+			k.Cdc.MustUnmarshal(iterator.Value(), &val)
+			list = append(list, val)
+		}
+		iterator.Close()
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllExpiringOrdersForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.ExpiringOrderContractPrefix(contractAddr))
+	k.removeAllForPrefix(ctx, types.ExpiringOrderByTimeContractPrefix(contractAddr))
+}
+
+func (k Keeper) getExpiringOrdersBefore(store prefix.Store, expiry uint64) (list []types.ExpiringOrder) {
+	iterator := store.Iterator(nil, GetKeyForExpiringOrder(expiry, 0))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ExpiringOrder
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) getExpiringOrderStoreAndKey(ctx sdk.Context, contractAddr string, expiringOrder types.ExpiringOrder) (prefix.Store, []byte) {
+	cancellation := expiringOrder.Cancellation
+	if expiringOrder.ExpiryTimestamp > 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExpiringOrderByTimePrefix(contractAddr, cancellation.PriceDenom, cancellation.AssetDenom))
+		return store, GetKeyForExpiringOrder(expiringOrder.ExpiryTimestamp, cancellation.Id)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExpiringOrderPrefix(contractAddr, cancellation.PriceDenom, cancellation.AssetDenom))
+	return store, GetKeyForExpiringOrder(uint64(expiringOrder.ExpiryHeight), cancellation.Id)
+}
+
+// expiry height or timestamp + order ID, both big endian so that entries are ordered by expiry
+func GetKeyForExpiringOrder(expiry uint64, orderID uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key, expiry)
+	binary.BigEndian.PutUint64(key[8:], orderID)
+	return key
+}
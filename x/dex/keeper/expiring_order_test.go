@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrdersExpiredBefore(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	for id, height := range []int64{5, 3, 4, 3} {
+		keeper.SetExpiringOrder(ctx, keepertest.TestContract, types.ExpiringOrder{
+			ExpiryHeight: height,
+			Cancellation: types.Cancellation{
+				Id:                uint64(id),
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+				PositionDirection: types.PositionDirection_LONG,
+				Price:             sdk.OneDec(),
+			},
+		})
+	}
+	for id, timestamp := range []uint64{200, 100} {
+		keeper.SetExpiringOrder(ctx, keepertest.TestContract, types.ExpiringOrder{
+			ExpiryTimestamp: timestamp,
+			Cancellation: types.Cancellation{
+				Id:                uint64(id + 4),
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+				PositionDirection: types.PositionDirection_SHORT,
+				Price:             sdk.OneDec(),
+			},
+		})
+	}
+
+	expired := keeper.GetOrdersExpiredBefore(ctx, keepertest.TestContract, keepertest.TestPair, 5, 150)
+	require.Equal(t, 4, len(expired))
+	require.Equal(t, uint64(1), expired[0].Cancellation.Id)
+	require.Equal(t, uint64(3), expired[1].Cancellation.Id)
+	require.Equal(t, uint64(2), expired[2].Cancellation.Id)
+	require.Equal(t, uint64(5), expired[3].Cancellation.Id)
+
+	keeper.RemoveExpiringOrder(ctx, keepertest.TestContract, expired[0])
+	keeper.RemoveExpiringOrder(ctx, keepertest.TestContract, expired[3])
+	require.Equal(t, 2, len(keeper.GetOrdersExpiredBefore(ctx, keepertest.TestContract, keepertest.TestPair, 5, 150)))
+	require.Equal(t, 4, len(keeper.GetAllExpiringOrders(ctx, keepertest.TestContract)))
+
+	keeper.RemoveOrdersExpiredBefore(ctx, keepertest.TestContract, keepertest.TestPair, 5, 250)
+	remaining := keeper.GetAllExpiringOrders(ctx, keepertest.TestContract)
+	require.Equal(t, 1, len(remaining))
+	require.Equal(t, uint64(0), remaining[0].Cancellation.Id)
+
+	keeper.RemoveAllExpiringOrdersForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllExpiringOrders(ctx, keepertest.TestContract))
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+func (k Keeper) SetFeeSchedule(ctx sdk.Context, feeSchedule types.FeeSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeSchedulePrefix(feeSchedule.ContractAddr))
+	b := k.Cdc.MustMarshal(&feeSchedule)
+	store.Set(types.PairPrefix(feeSchedule.PriceDenom, feeSchedule.AssetDenom), b)
+}
+
+func (k Keeper) GetFeeSchedule(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) (types.FeeSchedule, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeSchedulePrefix(contractAddr))
+	res := types.FeeSchedule{}
+	b := store.Get(types.PairPrefix(priceDenom, assetDenom))
+	if b == nil {
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res, true
+}
+
+func (k Keeper) RemoveFeeSchedule(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeSchedulePrefix(contractAddr))
+	store.Delete(types.PairPrefix(priceDenom, assetDenom))
+}
+
+func (k Keeper) GetAllFeeSchedules(ctx sdk.Context, contractAddr string) (list []types.FeeSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeSchedulePrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeeSchedule
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllFeeSchedulesForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.FeeSchedulePrefix(contractAddr))
+}
+
+func (k Keeper) SetAccountVolume(ctx sdk.Context, contractAddr string, accountVolume types.AccountVolume) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountVolumePrefix(contractAddr, accountVolume.PriceDenom, accountVolume.AssetDenom, accountVolume.Account))
+	b := k.Cdc.MustMarshal(&accountVolume)
+	store.Set(GetKeyForDay(accountVolume.Day), b)
+}
+
+// AddAccountVolume accumulates the notional traded by an account into the bucket of the current
+// day, and drops buckets that have fallen out of the fee volume window
+func (k Keeper) AddAccountVolume(ctx sdk.Context, contractAddr string, pair types.Pair, account string, notional sdk.Dec) {
+	day := types.GetDayForTimestamp(uint64(ctx.BlockTime().Unix()))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountVolumePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, account))
+	if day >= types.FeeVolumeWindowInDays {
+		iterator := store.Iterator(nil, GetKeyForDay(day-types.FeeVolumeWindowInDays+1))
+		keysToDelete := [][]byte{}
+		for ; iterator.Valid(); iterator.Next() {
+			keysToDelete = append(keysToDelete, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keysToDelete {
+			store.Delete(key)
+		}
+	}
+
+	accountVolume := types.AccountVolume{
+		Account:    account,
+		PriceDenom: pair.PriceDenom,
+		AssetDenom: pair.AssetDenom,
+		Day:        day,
+		Notional:   sdk.ZeroDec(),
+	}
+	if b := store.Get(GetKeyForDay(day)); b != nil {
+		k.Cdc.MustUnmarshal(b, &accountVolume)
+	}
+	accountVolume.Notional = accountVolume.Notional.Add(notional)
+	k.SetAccountVolume(ctx, contractAddr, accountVolume)
+}
+
+// GetAccountVolume returns the notional traded by an account on a pair within the fee volume
+// window ending at the current day
+func (k Keeper) GetAccountVolume(ctx sdk.Context, contractAddr string, pair types.Pair, account string) sdk.Dec {
+	day := types.GetDayForTimestamp(uint64(ctx.BlockTime().Unix()))
+	start := uint64(0)
+	if day >= types.FeeVolumeWindowInDays {
+		start = day - types.FeeVolumeWindowInDays + 1
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountVolumePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom, account))
+	iterator := store.Iterator(GetKeyForDay(start), nil)
+
+	defer iterator.Close()
+
+	res := sdk.ZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AccountVolume
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		res = res.Add(val.Notional)
+	}
+	return res
+}
+
+func (k Keeper) GetAllAccountVolumes(ctx sdk.Context, contractAddr string) (list []types.AccountVolume) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountVolumeContractPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AccountVolume
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllAccountVolumesForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.AccountVolumeContractPrefix(contractAddr))
+}
+
+func (k Keeper) RemoveAllAccountVolumesForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) {
+	k.removeAllForPrefix(ctx, append(types.AccountVolumeContractPrefix(contractAddr), types.PairPrefix(priceDenom, assetDenom)...))
+}
+
+func GetKeyForDay(day uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, day)
+	return key
+}
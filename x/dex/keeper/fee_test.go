@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeSchedule(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	_, found := keeper.GetFeeSchedule(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.False(t, found)
+
+	feeSchedule := types.FeeSchedule{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		MakerFeeRate: sdk.MustNewDecFromStr("0.001"),
+		TakerFeeRate: sdk.MustNewDecFromStr("0.002"),
+		Tiers: []types.FeeTier{{
+			MinVolume:    sdk.NewDec(1000),
+			MakerFeeRate: sdk.ZeroDec(),
+			TakerFeeRate: sdk.MustNewDecFromStr("0.001"),
+		}},
+	}
+	keeper.SetFeeSchedule(ctx, feeSchedule)
+	res, found := keeper.GetFeeSchedule(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.True(t, found)
+	require.Equal(t, feeSchedule, res)
+	require.Equal(t, []types.FeeSchedule{feeSchedule}, keeper.GetAllFeeSchedules(ctx, keepertest.TestContract))
+
+	keeper.RemoveAllFeeSchedulesForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllFeeSchedules(ctx, keepertest.TestContract))
+}
+
+func TestAccountVolume(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	day := func(d int64) sdk.Context {
+		return ctx.WithBlockTime(time.Unix(d*types.SecondsPerDay, 0))
+	}
+	keeper.AddAccountVolume(day(1), keepertest.TestContract, keepertest.TestPair, keepertest.TestAccount, sdk.NewDec(10))
+	keeper.AddAccountVolume(day(1), keepertest.TestContract, keepertest.TestPair, keepertest.TestAccount, sdk.NewDec(5))
+	keeper.AddAccountVolume(day(20), keepertest.TestContract, keepertest.TestPair, keepertest.TestAccount, sdk.NewDec(7))
+	require.Equal(t, sdk.NewDec(22), keeper.GetAccountVolume(day(20), keepertest.TestContract, keepertest.TestPair, keepertest.TestAccount))
+	require.Equal(t, 2, len(keeper.GetAllAccountVolumes(ctx, keepertest.TestContract)))
+
+	// day 1 falls out of the window ending at day 30
+	require.Equal(t, sdk.NewDec(7), keeper.GetAccountVolume(day(31), keepertest.TestContract, keepertest.TestPair, keepertest.TestAccount))
+	// and is pruned when volume is next added
+	keeper.AddAccountVolume(day(31), keepertest.TestContract, keepertest.TestPair, keepertest.TestAccount, sdk.NewDec(3))
+	require.Equal(t, 2, len(keeper.GetAllAccountVolumes(ctx, keepertest.TestContract)))
+	require.Equal(t, sdk.NewDec(10), keeper.GetAccountVolume(day(31), keepertest.TestContract, keepertest.TestPair, keepertest.TestAccount))
+	require.Equal(t, sdk.ZeroDec(), keeper.GetAccountVolume(day(31), keepertest.TestContract, keepertest.TestPair, keepertest.TestContract))
+
+	keeper.RemoveAllAccountVolumesForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllAccountVolumes(ctx, keepertest.TestContract))
+}
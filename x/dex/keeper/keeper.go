@@ -27,6 +27,7 @@ type (
 		EpochKeeper   epochkeeper.Keeper
 		BankKeeper    bankkeeper.Keeper
 		WasmKeeper    wasm.Keeper
+		OracleKeeper  types.OracleKeeper
 		MemState      *dexcache.MemState
 	}
 )
@@ -72,6 +73,10 @@ func (k *Keeper) SetWasmKeeper(wasmKeeper *wasm.Keeper) {
 	k.WasmKeeper = *wasmKeeper
 }
 
+func (k *Keeper) SetOracleKeeper(oracleKeeper types.OracleKeeper) {
+	k.OracleKeeper = oracleKeeper
+}
+
 func (k Keeper) CreateModuleAccount(ctx sdk.Context) {
 	moduleAcc := authtypes.NewEmptyModuleAccount(types.ModuleName)
 	k.AccountKeeper.SetModuleAccount(ctx, moduleAcc)
@@ -0,0 +1,38 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+func (k msgServer) CancelAll(goCtx context.Context, msg *types.MsgCancelAll) (*types.MsgCancelAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	cancelledIDs := []uint64{}
+	for _, order := range k.GetOrdersToCancelAll(ctx, msg) {
+		cancelled, err := k.cancelOrder(ctx, msg.Creator, msg.ContractAddr, &types.Cancellation{
+			Id:                order.Id,
+			Price:             order.Price,
+			PriceDenom:        order.PriceDenom,
+			AssetDenom:        order.AssetDenom,
+			PositionDirection: order.PositionDirection,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if cancelled {
+			cancelledIDs = append(cancelledIDs, order.Id)
+		}
+	}
+	utils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, msg.ContractAddr, k.GetContractWithoutGasCharge)
+	return &types.MsgCancelAllResponse{CancelledOrderIds: cancelledIDs}, nil
+}
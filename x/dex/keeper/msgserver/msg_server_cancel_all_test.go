@@ -0,0 +1,99 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// restOrder stores a limit order in the book along with its account index entry
+func restOrder(ctx sdk.Context, k *keeper.Keeper, order types.Order) {
+	entry := &types.OrderEntry{
+		Price:       order.Price,
+		Quantity:    order.Quantity,
+		PriceDenom:  order.PriceDenom,
+		AssetDenom:  order.AssetDenom,
+		Allocations: []*types.Allocation{{Account: order.Account, OrderId: order.Id, Quantity: order.Quantity}},
+	}
+	if order.PositionDirection == types.PositionDirection_LONG {
+		k.SetLongBook(ctx, order.ContractAddr, types.LongBook{Price: order.Price, Entry: entry})
+	} else {
+		k.SetShortBook(ctx, order.ContractAddr, types.ShortBook{Price: order.Price, Entry: entry})
+	}
+	k.SetAccountActiveOrder(ctx, order.ContractAddr, order)
+}
+
+func TestCancelAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	otherPair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: "sei"}
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, otherPair)
+	for _, order := range []types.Order{
+		{Id: 1, Account: keepertest.TestAccount, Price: sdk.NewDec(1), PositionDirection: types.PositionDirection_LONG, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom},
+		{Id: 2, Account: keepertest.TestAccount, Price: sdk.NewDec(3), PositionDirection: types.PositionDirection_SHORT, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom},
+		{Id: 3, Account: TestCreator, Price: sdk.NewDec(2), PositionDirection: types.PositionDirection_LONG, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom},
+		{Id: 4, Account: keepertest.TestAccount, Price: sdk.NewDec(1), PositionDirection: types.PositionDirection_LONG, PriceDenom: otherPair.PriceDenom, AssetDenom: otherPair.AssetDenom},
+	} {
+		order.ContractAddr = keepertest.TestContract
+		order.Quantity = sdk.OneDec()
+		order.OrderType = types.OrderType_LIMIT
+		restOrder(ctx, keeper, order)
+	}
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, types.Order{
+		Id:                5,
+		Account:           keepertest.TestAccount,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(4),
+		Quantity:          sdk.OneDec(),
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_STOPLIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.NewDec(4),
+	})
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+
+	// only long orders of one pair
+	res, err := server.CancelAll(wctx, &types.MsgCancelAll{
+		Creator:            keepertest.TestAccount,
+		ContractAddr:       keepertest.TestContract,
+		PriceDenom:         keepertest.TestPriceDenom,
+		AssetDenom:         keepertest.TestAssetDenom,
+		PositionDirections: []types.PositionDirection{types.PositionDirection_LONG},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []uint64{1, 5}, res.CancelledOrderIds)
+	cancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, keepertest.TestContract, keepertest.TestPair).Get()
+	require.Equal(t, 2, len(cancels))
+	require.Equal(t, types.CancellationInitiator_USER, cancels[0].Initiator)
+	require.Equal(t, keepertest.TestAccount, cancels[0].Creator)
+	require.Equal(t, sdk.NewDec(1), cancels[0].Price)
+
+	// all orders of all pairs
+	res, err = server.CancelAll(wctx, &types.MsgCancelAll{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+	})
+	require.Nil(t, err)
+	require.ElementsMatch(t, []uint64{1, 2, 4, 5}, res.CancelledOrderIds)
+	require.Equal(t, 3, len(dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, keepertest.TestContract, keepertest.TestPair).Get()))
+	require.Equal(t, 1, len(dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, keepertest.TestContract, otherPair).Get()))
+}
+
+func TestCancelAllInvalid(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	_, err := server.CancelAll(sdk.WrapSDKContext(ctx), &types.MsgCancelAll{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+	})
+	require.NotNil(t, err)
+}
@@ -18,43 +18,55 @@ func (k msgServer) CancelOrders(goCtx context.Context, msg *types.MsgCancelOrder
 		return nil, err
 	}
 
-	events := []sdk.Event{}
 	for _, cancellation := range msg.GetCancellations() {
-		var allocation *types.Allocation
-		var found bool
-		if cancellation.PositionDirection == types.PositionDirection_LONG {
-			allocation, found = k.GetLongAllocationForOrderID(ctx, msg.ContractAddr, cancellation.PriceDenom, cancellation.AssetDenom, cancellation.Price, cancellation.Id)
-		} else {
-			allocation, found = k.GetShortAllocationForOrderID(ctx, msg.ContractAddr, cancellation.PriceDenom, cancellation.AssetDenom, cancellation.Price, cancellation.Id)
-		}
-		if !found {
-			continue
-		}
-		if allocation.Account != msg.Creator {
-			return nil, errors.New("cannot cancel orders created by others")
-		}
-		pair := types.Pair{PriceDenom: cancellation.PriceDenom, AssetDenom: cancellation.AssetDenom}
-		pairBlockCancellations := utils.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(msg.GetContractAddr()), pair)
-		if !pairBlockCancellations.Has(cancellation) {
-			// only cancel if it's not cancelled in a previous tx in the same block
-			cancel := types.Cancellation{
-				Id:                cancellation.Id,
-				Initiator:         types.CancellationInitiator_USER,
-				Creator:           msg.Creator,
-				ContractAddr:      msg.ContractAddr,
-				Price:             cancellation.Price,
-				AssetDenom:        cancellation.AssetDenom,
-				PriceDenom:        cancellation.PriceDenom,
-				PositionDirection: cancellation.PositionDirection,
-			}
-			pairBlockCancellations.Add(&cancel)
-			events = append(events, sdk.NewEvent(
-				types.EventTypeCancelOrder,
-				sdk.NewAttribute(types.AttributeKeyCancellationID, fmt.Sprint(cancellation.Id)),
-			))
+		if _, err := k.cancelOrder(ctx, msg.Creator, msg.ContractAddr, cancellation); err != nil {
+			return nil, err
 		}
 	}
-	ctx.EventManager().EmitEvents(events)
 	utils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, msg.ContractAddr, k.GetContractWithoutGasCharge)
 	return &types.MsgCancelOrdersResponse{}, nil
 }
+
+// cancelOrder queues the cancellation of a resting order, or of a stop order that has not been
+// triggered yet, in this block. Returns false if no such order exists.
+func (k msgServer) cancelOrder(ctx sdk.Context, creator string, contractAddr string, cancellation *types.Cancellation) (bool, error) {
+	var allocation *types.Allocation
+	var found bool
+	if cancellation.PositionDirection == types.PositionDirection_LONG {
+		allocation, found = k.GetLongAllocationForOrderID(ctx, contractAddr, cancellation.PriceDenom, cancellation.AssetDenom, cancellation.Price, cancellation.Id)
+	} else {
+		allocation, found = k.GetShortAllocationForOrderID(ctx, contractAddr, cancellation.PriceDenom, cancellation.AssetDenom, cancellation.Price, cancellation.Id)
+	}
+	if !found {
+		// the order may be a stop order that has not been matched yet
+		triggeredOrder, triggeredOrderFound := k.GetTriggeredOrderByID(ctx, contractAddr, cancellation.Id, cancellation.PriceDenom, cancellation.AssetDenom)
+		if !triggeredOrderFound {
+			return false, nil
+		}
+		allocation = &types.Allocation{OrderId: triggeredOrder.Id, Quantity: triggeredOrder.Quantity, Account: triggeredOrder.Account}
+	}
+	if allocation.Account != creator {
+		return false, errors.New("cannot cancel orders created by others")
+	}
+	pair := types.Pair{PriceDenom: cancellation.PriceDenom, AssetDenom: cancellation.AssetDenom}
+	pairBlockCancellations := utils.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(contractAddr), pair)
+	if !pairBlockCancellations.Has(cancellation) {
+		// only cancel if it's not cancelled in a previous tx in the same block
+		cancel := types.Cancellation{
+			Id:                cancellation.Id,
+			Initiator:         types.CancellationInitiator_USER,
+			Creator:           creator,
+			ContractAddr:      contractAddr,
+			Price:             cancellation.Price,
+			AssetDenom:        cancellation.AssetDenom,
+			PriceDenom:        cancellation.PriceDenom,
+			PositionDirection: cancellation.PositionDirection,
+		}
+		pairBlockCancellations.Add(&cancel)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCancelOrder,
+			sdk.NewAttribute(types.AttributeKeyCancellationID, fmt.Sprint(cancellation.Id)),
+		))
+	}
+	return true, nil
+}
@@ -63,6 +63,54 @@ func TestCancelOrder(t *testing.T) {
 	require.Equal(t, keepertest.TestContract, pairBlockCancellations.Get()[0].ContractAddr)
 }
 
+func TestCancelStopOrder(t *testing.T) {
+	// store a stop order to the trigger book
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, types.Order{
+		Id:                1,
+		Account:           keepertest.TestAccount,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.OneDec(),
+		Quantity:          sdk.MustNewDecFromStr("2"),
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_STOPLIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.OneDec(),
+	})
+
+	msg := &types.MsgCancelOrders{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		Cancellations: []*types.Cancellation{
+			{
+				Price:             sdk.OneDec(),
+				PositionDirection: types.PositionDirection_LONG,
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+				Id:                1,
+			},
+		},
+	}
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+
+	// cannot cancel stop orders of others
+	_, err := server.CancelOrders(wctx, &types.MsgCancelOrders{
+		Creator:       keepertest.TestContract,
+		ContractAddr:  keepertest.TestContract,
+		Cancellations: msg.Cancellations,
+	})
+	require.NotNil(t, err)
+
+	_, err = server.CancelOrders(wctx, msg)
+	require.Nil(t, err)
+	pairBlockCancellations := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.Equal(t, 1, len(pairBlockCancellations.Get()))
+	require.Equal(t, uint64(1), pairBlockCancellations.Get()[0].Id)
+}
+
 func TestInvalidCancels(t *testing.T) {
 	// nil cancel price
 	keeper, ctx := keepertest.DexKeeper(t)
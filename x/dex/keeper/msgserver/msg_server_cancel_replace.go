@@ -0,0 +1,34 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// CancelReplace cancels an order and places its replacement. Since both halves only queue
+// changes in the block's memstate, a failure of either reverts the whole message.
+func (k msgServer) CancelReplace(goCtx context.Context, msg *types.MsgCancelReplace) (*types.MsgCancelReplaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	found, err := k.cancelOrder(ctx, msg.Creator, msg.ContractAddr, msg.Cancellation)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidOrderID, "order %d to replace is not found", msg.Cancellation.Id)
+	}
+	res, err := k.PlaceOrders(goCtx, msg.GetPlaceOrders())
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelReplaceResponse{OrderId: res.OrderIds[0]}, nil
+}
@@ -0,0 +1,72 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelReplace(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.QuantityTicksize)
+	restOrder(ctx, keeper, types.Order{
+		Id:                1,
+		Account:           TestCreator,
+		ContractAddr:      TestContract,
+		Price:             sdk.NewDec(10),
+		Quantity:          sdk.NewDec(10),
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+	})
+	keeper.SetNextOrderID(ctx, TestContract, 2)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+
+	msg := &types.MsgCancelReplace{
+		Creator:      TestCreator,
+		ContractAddr: TestContract,
+		Cancellation: &types.Cancellation{
+			Id:                1,
+			Price:             sdk.NewDec(10),
+			PositionDirection: types.PositionDirection_LONG,
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+		},
+		Order: &types.Order{
+			Price:             sdk.NewDec(11),
+			Quantity:          sdk.NewDec(10),
+			PositionDirection: types.PositionDirection_LONG,
+			OrderType:         types.OrderType_LIMIT,
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+		},
+	}
+	res, err := server.CancelReplace(wctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), res.OrderId)
+	require.Equal(t, []uint64{1}, dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, TestContract, keepertest.TestPair).GetIdsToCancel())
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, TestContract, keepertest.TestPair).Get()
+	require.Equal(t, 1, len(orders))
+	require.Equal(t, uint64(2), orders[0].Id)
+	require.Equal(t, sdk.NewDec(11), orders[0].Price)
+
+	// the order to replace must exist
+	msg.Cancellation.Id = 3
+	_, err = server.CancelReplace(wctx, msg)
+	require.NotNil(t, err)
+
+	// and be created by the sender
+	msg.Cancellation.Id = 1
+	msg.Creator = keepertest.TestAccount
+	_, err = server.CancelReplace(wctx, msg)
+	require.NotNil(t, err)
+}
@@ -46,6 +46,7 @@ func (k msgServer) ContractDepositRent(goCtx context.Context, msg *types.MsgCont
 	if err := k.SetContract(ctx, &contract); err != nil {
 		return nil, err
 	}
+	k.RecordRentDeposit(ctx, msg.ContractAddr, msg.Amount)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeDepositRent,
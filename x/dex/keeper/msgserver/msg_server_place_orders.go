@@ -57,10 +57,21 @@ func (k msgServer) PlaceOrders(goCtx context.Context, msg *types.MsgPlaceOrders)
 	nextID := k.GetNextOrderID(ctx, msg.ContractAddr)
 	idsInResp := []uint64{}
 	maxOrderPerPrice := k.GetMaxOrderPerPrice(ctx)
+	oraclePriceBands := k.NewOraclePriceBands(msg.GetContractAddr())
 	for _, order := range msg.GetOrders() {
 		if k.GetOrderCountState(ctx, msg.GetContractAddr(), order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price) >= maxOrderPerPrice {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order book already has more than %d orders for %s-%s-%s %s at %s", maxOrderPerPrice, msg.GetContractAddr(), order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price)
 		}
+		if order.TimeInForce == types.TimeInForce_GOOD_TILL_HEIGHT && order.ExpiryHeight < ctx.BlockHeight() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order expiry height %d is lower than the current height %d", order.ExpiryHeight, ctx.BlockHeight())
+		}
+		if order.TimeInForce == types.TimeInForce_GOOD_TILL_TIME && order.ExpiryTimestamp < uint64(ctx.BlockTime().Unix()) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order expiry timestamp %d is earlier than the current block time %d", order.ExpiryTimestamp, ctx.BlockTime().Unix())
+		}
+		if err := oraclePriceBands.ValidateOrder(ctx, order); err != nil {
+			return nil, err
+		}
+		oraclePriceBands.CapMarketOrderPrice(ctx, order)
 		priceTicksize, found := k.Keeper.GetPriceTickSizeForPair(ctx, msg.GetContractAddr(), types.Pair{PriceDenom: order.PriceDenom, AssetDenom: order.AssetDenom})
 		if !found {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no price ticksize configured", order.PriceDenom, order.AssetDenom)
@@ -3,6 +3,7 @@ package msgserver_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
@@ -291,6 +292,35 @@ func TestPlaceInvalidOrder(t *testing.T) {
 	server = msgserver.NewMsgServerImpl(*keeper)
 	_, err = server.PlaceOrders(wctx, msg)
 	require.NotNil(t, err)
+
+	// Expiry height already passed
+	msg = &types.MsgPlaceOrders{
+		Creator:      TestCreator,
+		ContractAddr: TestContract,
+		Orders: []*types.Order{
+			{
+				Price:             sdk.MustNewDecFromStr("10"),
+				Quantity:          sdk.MustNewDecFromStr("10"),
+				Data:              "",
+				PositionDirection: types.PositionDirection_LONG,
+				OrderType:         types.OrderType_LIMIT,
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+				TimeInForce:       types.TimeInForce_GOOD_TILL_HEIGHT,
+				ExpiryHeight:      9,
+			},
+		},
+	}
+	server = msgserver.NewMsgServerImpl(*keeper)
+	_, err = server.PlaceOrders(sdk.WrapSDKContext(ctx.WithBlockHeight(10)), msg)
+	require.NotNil(t, err)
+
+	// Expiry time already passed
+	msg.Orders[0].TimeInForce = types.TimeInForce_GOOD_TILL_TIME
+	msg.Orders[0].ExpiryHeight = 0
+	msg.Orders[0].ExpiryTimestamp = 9999
+	_, err = server.PlaceOrders(sdk.WrapSDKContext(ctx.WithBlockTime(time.Unix(10000, 0))), msg)
+	require.NotNil(t, err)
 }
 
 func TestPlaceNoOrder(t *testing.T) {
@@ -334,3 +364,56 @@ func TestPlaceOrderExceedingLimit(t *testing.T) {
 	_, err := server.PlaceOrders(wctx, msg)
 	require.NotNil(t, err)
 }
+
+func TestPlaceOrderWithOraclePriceBand(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(testApp.GetMemKey(types.MemStoreKey))))
+	keeper := testApp.DexKeeper
+	band := sdk.MustNewDecFromStr("0.1")
+	pair := keepertest.TestPair
+	pair.OracleDenom = "uatom"
+	pair.OraclePriceBand = &band
+	keeper.AddRegisteredPair(ctx, TestContract, pair)
+	keeper.SetPriceTickSizeForPair(ctx, TestContract, pair, *pair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, TestContract, pair, *pair.QuantityTicksize)
+	testApp.OracleKeeper.SetBaseExchangeRate(ctx, "uatom", sdk.NewDec(10))
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(keeper)
+
+	newOrder := func(price sdk.Dec, direction types.PositionDirection, orderType types.OrderType) *types.Order {
+		return &types.Order{
+			Price:             price,
+			Quantity:          sdk.MustNewDecFromStr("10"),
+			PositionDirection: direction,
+			OrderType:         orderType,
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+		}
+	}
+
+	// limit order outside of the band
+	_, err := server.PlaceOrders(wctx, &types.MsgPlaceOrders{
+		Creator:      TestCreator,
+		ContractAddr: TestContract,
+		Orders:       []*types.Order{newOrder(sdk.NewDec(12), types.PositionDirection_LONG, types.OrderType_LIMIT)},
+	})
+	require.ErrorIs(t, err, types.ErrPriceOutsideOracleBand)
+
+	// limit order within the band and market orders without worst prices
+	msg := &types.MsgPlaceOrders{
+		Creator:      TestCreator,
+		ContractAddr: TestContract,
+		Orders: []*types.Order{
+			newOrder(sdk.NewDec(10), types.PositionDirection_LONG, types.OrderType_LIMIT),
+			newOrder(sdk.ZeroDec(), types.PositionDirection_LONG, types.OrderType_MARKET),
+			newOrder(sdk.ZeroDec(), types.PositionDirection_SHORT, types.OrderType_MARKET),
+		},
+	}
+	res, err := server.PlaceOrders(wctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(res.OrderIds))
+	require.Equal(t, sdk.NewDec(10), msg.Orders[0].Price)
+	require.Equal(t, sdk.NewDec(11), msg.Orders[1].Price)
+	require.Equal(t, sdk.NewDec(9), msg.Orders[2].Price)
+}
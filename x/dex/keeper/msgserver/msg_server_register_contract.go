@@ -203,6 +203,7 @@ func (k msgServer) HandleDepositOrRefund(ctx sdk.Context, msg *types.MsgRegister
 			if err := k.BankKeeper.SendCoins(ctx, creatorAddr, k.AccountKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewIntFromUint64(msg.Contract.RentBalance)))); err != nil {
 				return err
 			}
+			k.RecordRentDeposit(ctx, msg.Contract.ContractAddr, msg.Contract.RentBalance)
 		}
 	} else {
 		if msg.Creator != existingContract.Creator {
@@ -214,12 +215,14 @@ func (k msgServer) HandleDepositOrRefund(ctx sdk.Context, msg *types.MsgRegister
 			if err := k.BankKeeper.SendCoins(ctx, k.AccountKeeper.GetModuleAddress(types.ModuleName), creatorAddr, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewIntFromUint64(refundAmount)))); err != nil {
 				return err
 			}
+			k.RecordRentRefund(ctx, msg.Contract.ContractAddr, refundAmount)
 		} else if msg.Contract.RentBalance > existingContract.RentBalance {
 			// deposit
 			depositAmount := msg.Contract.RentBalance - existingContract.RentBalance
 			if err := k.BankKeeper.SendCoins(ctx, creatorAddr, k.AccountKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewIntFromUint64(depositAmount)))); err != nil {
 				return err
 			}
+			k.RecordRentDeposit(ctx, msg.Contract.ContractAddr, depositAmount)
 		}
 	}
 	return nil
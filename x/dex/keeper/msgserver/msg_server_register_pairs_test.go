@@ -199,6 +199,108 @@ func TestRegisterPairsInvalidMsg(t *testing.T) {
 		Batchcontractpair: batchContractPairs,
 	})
 	require.NotNil(t, err)
+
+	// Test with top of queue bonus missing for the policy that needs it
+	proRataPair := keepertest.TestPair
+	proRataPair.MatchingPolicy = types.MatchingPolicy_PRO_RATA_WITH_TOP_OF_QUEUE_BONUS
+	batchContractPairs = []types.BatchContractPair{}
+	batchContractPairs = append(batchContractPairs, types.BatchContractPair{
+		ContractAddr: contractAddrA.String(),
+		Pairs:        []*types.Pair{&proRataPair},
+	})
+	_, err = server.RegisterPairs(wctx, &types.MsgRegisterPairs{
+		Creator:           keepertest.TestAccount,
+		Batchcontractpair: batchContractPairs,
+	})
+	require.NotNil(t, err)
+
+	// Test with top of queue bonus set for a policy that doesn't use it
+	bonus := sdk.MustNewDecFromStr("0.2")
+	fifoPair := keepertest.TestPair
+	fifoPair.TopOfQueueBonus = &bonus
+	batchContractPairs = []types.BatchContractPair{}
+	batchContractPairs = append(batchContractPairs, types.BatchContractPair{
+		ContractAddr: contractAddrA.String(),
+		Pairs:        []*types.Pair{&fifoPair},
+	})
+	_, err = server.RegisterPairs(wctx, &types.MsgRegisterPairs{
+		Creator:           keepertest.TestAccount,
+		Batchcontractpair: batchContractPairs,
+	})
+	require.NotNil(t, err)
+
+	// Test with an unknown self-trade prevention mode
+	unknownSTPPair := keepertest.TestPair
+	unknownSTPPair.SelfTradePrevention = types.SelfTradePrevention(len(types.SelfTradePrevention_name))
+	batchContractPairs = []types.BatchContractPair{}
+	batchContractPairs = append(batchContractPairs, types.BatchContractPair{
+		ContractAddr: contractAddrA.String(),
+		Pairs:        []*types.Pair{&unknownSTPPair},
+	})
+	_, err = server.RegisterPairs(wctx, &types.MsgRegisterPairs{
+		Creator:           keepertest.TestAccount,
+		Batchcontractpair: batchContractPairs,
+	})
+	require.NotNil(t, err)
+
+	// Test with an oracle denom but no oracle price band
+	noBandPair := keepertest.TestPair
+	noBandPair.OracleDenom = "uatom"
+	batchContractPairs = []types.BatchContractPair{}
+	batchContractPairs = append(batchContractPairs, types.BatchContractPair{
+		ContractAddr: contractAddrA.String(),
+		Pairs:        []*types.Pair{&noBandPair},
+	})
+	_, err = server.RegisterPairs(wctx, &types.MsgRegisterPairs{
+		Creator:           keepertest.TestAccount,
+		Batchcontractpair: batchContractPairs,
+	})
+	require.NotNil(t, err)
+
+	// Test with an oracle price band that isn't below 1
+	band := sdk.OneDec()
+	wideBandPair := keepertest.TestPair
+	wideBandPair.OracleDenom = "uatom"
+	wideBandPair.OraclePriceBand = &band
+	batchContractPairs = []types.BatchContractPair{}
+	batchContractPairs = append(batchContractPairs, types.BatchContractPair{
+		ContractAddr: contractAddrA.String(),
+		Pairs:        []*types.Pair{&wideBandPair},
+	})
+	_, err = server.RegisterPairs(wctx, &types.MsgRegisterPairs{
+		Creator:           keepertest.TestAccount,
+		Batchcontractpair: batchContractPairs,
+	})
+	require.NotNil(t, err)
+
+	// Test with an oracle price band but no oracle denom
+	noDenomPair := keepertest.TestPair
+	noDenomPair.OraclePriceBand = &bonus
+	batchContractPairs = []types.BatchContractPair{}
+	batchContractPairs = append(batchContractPairs, types.BatchContractPair{
+		ContractAddr: contractAddrA.String(),
+		Pairs:        []*types.Pair{&noDenomPair},
+	})
+	_, err = server.RegisterPairs(wctx, &types.MsgRegisterPairs{
+		Creator:           keepertest.TestAccount,
+		Batchcontractpair: batchContractPairs,
+	})
+	require.NotNil(t, err)
+
+	// Test with a batch auction and self-trade prevention
+	batchAuctionPair := keepertest.TestPair
+	batchAuctionPair.BatchAuction = true
+	batchAuctionPair.SelfTradePrevention = types.SelfTradePrevention_CANCEL_TAKER
+	batchContractPairs = []types.BatchContractPair{}
+	batchContractPairs = append(batchContractPairs, types.BatchContractPair{
+		ContractAddr: contractAddrA.String(),
+		Pairs:        []*types.Pair{&batchAuctionPair},
+	})
+	_, err = server.RegisterPairs(wctx, &types.MsgRegisterPairs{
+		Creator:           keepertest.TestAccount,
+		Batchcontractpair: batchContractPairs,
+	})
+	require.NotNil(t, err)
 }
 
 // Test only contract creator can update registered pairs for contract
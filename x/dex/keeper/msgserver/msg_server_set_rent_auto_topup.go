@@ -0,0 +1,40 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+func (k msgServer) SetRentAutoTopup(goCtx context.Context, msg *types.MsgSetRentAutoTopup) (*types.MsgSetRentAutoTopupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	config, _ := k.GetRentConfig(ctx, msg.ContractAddr)
+	if existing := config.AutoTopup; existing != nil && existing.FundingAccount != msg.FundingAccount && contract.Creator != msg.FundingAccount {
+		return nil, types.ErrNotRentFundingAccount
+	}
+	if msg.Amount == 0 {
+		config.AutoTopup = nil
+	} else {
+		config.AutoTopup = &types.RentAutoTopup{
+			FundingAccount: msg.FundingAccount,
+			Threshold:      msg.Threshold,
+			Amount:         msg.Amount,
+		}
+	}
+	k.setOrRemoveRentConfig(ctx, config)
+
+	return &types.MsgSetRentAutoTopupResponse{}, nil
+}
@@ -0,0 +1,76 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	TestRentFunder      = "sei1h9yjz89tl0dl6zu65dpxcqnxfhq60wxx8s5kag"
+	TestOtherRentFunder = "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+)
+
+func TestSetRentLowBalanceThresholds(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	require.Nil(t, keeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		RentBalance:  1000,
+	}))
+
+	_, err := server.SetRentLowBalanceThresholds(wctx, types.NewMsgSetRentLowBalanceThresholds(TestRentFunder, keepertest.TestContract, []uint64{100}))
+	require.NotNil(t, err)
+
+	_, err = server.SetRentLowBalanceThresholds(wctx, types.NewMsgSetRentLowBalanceThresholds(keepertest.TestAccount, keepertest.TestContract, []uint64{100, 50}))
+	require.Nil(t, err)
+	config, found := keeper.GetRentConfig(ctx, keepertest.TestContract)
+	require.True(t, found)
+	require.Equal(t, []uint64{100, 50}, config.LowBalanceThresholds)
+
+	// removing all thresholds removes the config since nothing else is configured
+	_, err = server.SetRentLowBalanceThresholds(wctx, types.NewMsgSetRentLowBalanceThresholds(keepertest.TestAccount, keepertest.TestContract, []uint64{}))
+	require.Nil(t, err)
+	_, found = keeper.GetRentConfig(ctx, keepertest.TestContract)
+	require.False(t, found)
+}
+
+func TestSetRentAutoTopup(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	require.Nil(t, keeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		RentBalance:  1000,
+	}))
+
+	_, err := server.SetRentAutoTopup(wctx, types.NewMsgSetRentAutoTopup(TestRentFunder, keepertest.TestContract, 100, 500))
+	require.Nil(t, err)
+	config, found := keeper.GetRentConfig(ctx, keepertest.TestContract)
+	require.True(t, found)
+	require.Equal(t, &types.RentAutoTopup{FundingAccount: TestRentFunder, Threshold: 100, Amount: 500}, config.AutoTopup)
+
+	// another account can't take over or remove the top-up
+	_, err = server.SetRentAutoTopup(wctx, types.NewMsgSetRentAutoTopup(TestOtherRentFunder, keepertest.TestContract, 100, 500))
+	require.ErrorIs(t, err, types.ErrNotRentFundingAccount)
+	_, err = server.SetRentAutoTopup(wctx, types.NewMsgSetRentAutoTopup(TestOtherRentFunder, keepertest.TestContract, 0, 0))
+	require.ErrorIs(t, err, types.ErrNotRentFundingAccount)
+
+	// but the contract creator can
+	_, err = server.SetRentAutoTopup(wctx, types.NewMsgSetRentAutoTopup(keepertest.TestAccount, keepertest.TestContract, 200, 300))
+	require.Nil(t, err)
+	config, _ = keeper.GetRentConfig(ctx, keepertest.TestContract)
+	require.Equal(t, keepertest.TestAccount, config.AutoTopup.FundingAccount)
+
+	_, err = server.SetRentAutoTopup(wctx, types.NewMsgSetRentAutoTopup(keepertest.TestAccount, keepertest.TestContract, 0, 0))
+	require.Nil(t, err)
+	_, found = keeper.GetRentConfig(ctx, keepertest.TestContract)
+	require.False(t, found)
+}
@@ -0,0 +1,42 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+func (k msgServer) SetRentLowBalanceThresholds(goCtx context.Context, msg *types.MsgSetRentLowBalanceThresholds) (*types.MsgSetRentLowBalanceThresholdsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractAddr)
+	if err != nil {
+		return nil, err
+	}
+	if contract.Creator != msg.Creator {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+
+	config, _ := k.GetRentConfig(ctx, msg.ContractAddr)
+	config.LowBalanceThresholds = msg.Thresholds
+	k.setOrRemoveRentConfig(ctx, config)
+
+	return &types.MsgSetRentLowBalanceThresholdsResponse{}, nil
+}
+
+// setOrRemoveRentConfig removes the rent config of a contract if nothing is configured in it
+func (k msgServer) setOrRemoveRentConfig(ctx sdk.Context, config types.RentConfig) {
+	if len(config.LowBalanceThresholds) == 0 && config.AutoTopup == nil {
+		k.RemoveRentConfig(ctx, config.ContractAddr)
+		return
+	}
+	k.SetRentConfig(ctx, config)
+}
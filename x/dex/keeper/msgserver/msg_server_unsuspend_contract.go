@@ -37,6 +37,7 @@ func (k msgServer) UnsuspendContract(goCtx context.Context, msg *types.MsgUnsusp
 	if err := k.SetContract(ctx, &contract); err != nil {
 		return &types.MsgUnsuspendContractResponse{}, err
 	}
+	k.RecordRentCharge(ctx, msg.ContractAddr, cost)
 
 	// suspension changes will also affect dependency traversal since suspended contracts are skipped
 	dexutils.GetMemState(ctx.Context()).ClearContractToDependencies()
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// GetOracleReferencePrice returns the oracle price that orders of a pair are checked against.
+// Returns false if the pair isn't linked to an oracle denom or the oracle has no fresh price for it, in
+// which case orders of the pair aren't checked.
+func (k Keeper) GetOracleReferencePrice(ctx sdk.Context, pair types.Pair) (sdk.Dec, bool) {
+	if pair.OracleDenom == "" || k.OracleKeeper == nil {
+		return sdk.Dec{}, false
+	}
+	if pair.OracleTwapLookbackSeconds == 0 {
+		rate, _, _, err := k.OracleKeeper.GetBaseExchangeRate(ctx, pair.OracleDenom)
+		if err != nil || !rate.IsPositive() {
+			return sdk.Dec{}, false
+		}
+		return rate, true
+	}
+	twap, err := k.OracleKeeper.CalculateTwap(ctx, pair.OracleDenom, pair.OracleTwapLookbackSeconds)
+	if err != nil || !twap.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	return twap, true
+}
+
+// GetOraclePriceBand returns the lowest and highest prices that orders of a registered pair may
+// have according to its oracle reference price. Returns false if orders of the pair aren't checked.
+func (k Keeper) GetOraclePriceBand(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) (sdk.Dec, sdk.Dec, bool) {
+	pair, found := k.GetRegisteredPair(ctx, contractAddr, priceDenom, assetDenom)
+	if !found || pair.OraclePriceBand == nil {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+	referencePrice, found := k.GetOracleReferencePrice(ctx, pair)
+	if !found {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+	deviation := referencePrice.Mul(*pair.OraclePriceBand)
+	return referencePrice.Sub(deviation), referencePrice.Add(deviation), true
+}
+
+// OraclePriceBands looks up the oracle price band of each pair of a contract at most once, so that
+// the oracle reference price of a pair is computed once for all orders of a message.
+type OraclePriceBands struct {
+	keeper       Keeper
+	contractAddr string
+	bands        map[types.PairString]oraclePriceBand
+}
+
+type oraclePriceBand struct {
+	lower sdk.Dec
+	upper sdk.Dec
+	found bool
+}
+
+func (k Keeper) NewOraclePriceBands(contractAddr string) *OraclePriceBands {
+	return &OraclePriceBands{
+		keeper:       k,
+		contractAddr: contractAddr,
+		bands:        map[types.PairString]oraclePriceBand{},
+	}
+}
+
+func (b *OraclePriceBands) get(ctx sdk.Context, priceDenom string, assetDenom string) (sdk.Dec, sdk.Dec, bool) {
+	pairString := types.GetPairString(&types.Pair{PriceDenom: priceDenom, AssetDenom: assetDenom})
+	band, ok := b.bands[pairString]
+	if !ok {
+		band.lower, band.upper, band.found = b.keeper.GetOraclePriceBand(ctx, b.contractAddr, priceDenom, assetDenom)
+		b.bands[pairString] = band
+	}
+	return band.lower, band.upper, band.found
+}
+
+// ValidateOrder rejects limit orders priced outside of the oracle price band of their pair.
+func (b *OraclePriceBands) ValidateOrder(ctx sdk.Context, order *types.Order) error {
+	if order.OrderType != types.OrderType_LIMIT {
+		return nil
+	}
+	lower, upper, found := b.get(ctx, order.PriceDenom, order.AssetDenom)
+	if !found {
+		return nil
+	}
+	if order.Price.LT(lower) || order.Price.GT(upper) {
+		return sdkerrors.Wrapf(types.ErrPriceOutsideOracleBand, "price %s of %s-%s order is outside of [%s, %s]", order.Price, order.PriceDenom, order.AssetDenom, lower, upper)
+	}
+	return nil
+}
+
+// CapMarketOrderPrice sets the worst price of a market order to the edge of the oracle price band
+// of its pair if the order has no worst price or one outside of the band.
+func (b *OraclePriceBands) CapMarketOrderPrice(ctx sdk.Context, order *types.Order) {
+	if order.OrderType != types.OrderType_MARKET && order.OrderType != types.OrderType_FOKMARKET && order.OrderType != types.OrderType_FOKMARKETBYVALUE {
+		return
+	}
+	lower, upper, found := b.get(ctx, order.PriceDenom, order.AssetDenom)
+	if !found {
+		return
+	}
+	// a zero price means that the order has no worst price
+	if order.PositionDirection == types.PositionDirection_LONG && (order.Price.IsZero() || order.Price.GT(upper)) {
+		order.Price = upper
+	}
+	if order.PositionDirection == types.PositionDirection_SHORT && (order.Price.IsZero() || order.Price.LT(lower)) {
+		order.Price = lower
+	}
+}
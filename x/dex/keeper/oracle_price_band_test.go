@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestGetOraclePriceBand(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(5400, 0)})
+	keeper := testApp.DexKeeper
+
+	// pair isn't registered
+	_, _, found := keeper.GetOraclePriceBand(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.False(t, found)
+
+	// pair isn't linked to an oracle denom
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	_, _, found = keeper.GetOraclePriceBand(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.False(t, found)
+
+	// oracle has no price for the denom
+	band := sdk.MustNewDecFromStr("0.1")
+	spotPair := keepertest.TestPair
+	spotPair.AssetDenom = "ATOM"
+	spotPair.OracleDenom = "uatom"
+	spotPair.OraclePriceBand = &band
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, spotPair)
+	_, _, found = keeper.GetOraclePriceBand(ctx, keepertest.TestContract, spotPair.PriceDenom, spotPair.AssetDenom)
+	require.False(t, found)
+
+	// spot price
+	testApp.OracleKeeper.SetBaseExchangeRate(ctx, "uatom", sdk.NewDec(10))
+	lower, upper, found := keeper.GetOraclePriceBand(ctx, keepertest.TestContract, spotPair.PriceDenom, spotPair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(9), lower)
+	require.Equal(t, sdk.NewDec(11), upper)
+
+	// twap
+	twapPair := spotPair
+	twapPair.AssetDenom = "ATOM2"
+	twapPair.OracleTwapLookbackSeconds = 3600
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, twapPair)
+	_, _, found = keeper.GetOraclePriceBand(ctx, keepertest.TestContract, twapPair.PriceDenom, twapPair.AssetDenom)
+	require.False(t, found)
+	testApp.OracleKeeper.SetVoteTarget(ctx, "uatom")
+	testApp.OracleKeeper.SetPriceSnapshot(ctx, oracletypes.NewPriceSnapshot(oracletypes.PriceSnapshotItems{
+		oracletypes.NewPriceSnapshotItem("uatom", oracletypes.OracleExchangeRate{
+			ExchangeRate: sdk.NewDec(20),
+			LastUpdate:   sdk.NewInt(1800),
+		}),
+	}, 1800))
+	lower, upper, found = keeper.GetOraclePriceBand(ctx, keepertest.TestContract, twapPair.PriceDenom, twapPair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(18), lower)
+	require.Equal(t, sdk.NewDec(22), upper)
+}
+
+func TestValidateOraclePriceBand(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	keeper := testApp.DexKeeper
+	band := sdk.MustNewDecFromStr("0.1")
+	pair := keepertest.TestPair
+	pair.OracleDenom = "uatom"
+	pair.OraclePriceBand = &band
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
+
+	order := types.Order{
+		Price:             sdk.NewDec(12),
+		Quantity:          sdk.NewDec(1),
+		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_LIMIT,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+	}
+	// orders aren't checked without an oracle price
+	require.Nil(t, keeper.NewOraclePriceBands(keepertest.TestContract).ValidateOrder(ctx, &order))
+
+	testApp.OracleKeeper.SetBaseExchangeRate(ctx, "uatom", sdk.NewDec(10))
+	bands := keeper.NewOraclePriceBands(keepertest.TestContract)
+	require.ErrorIs(t, bands.ValidateOrder(ctx, &order), types.ErrPriceOutsideOracleBand)
+	order.Price = sdk.NewDec(8)
+	require.ErrorIs(t, bands.ValidateOrder(ctx, &order), types.ErrPriceOutsideOracleBand)
+	order.Price = sdk.NewDec(11)
+	require.Nil(t, bands.ValidateOrder(ctx, &order))
+
+	// the band of a pair is only looked up once per set of bands
+	testApp.OracleKeeper.SetBaseExchangeRate(ctx, "uatom", sdk.NewDec(20))
+	require.Nil(t, bands.ValidateOrder(ctx, &order))
+	require.ErrorIs(t, keeper.NewOraclePriceBands(keepertest.TestContract).ValidateOrder(ctx, &order), types.ErrPriceOutsideOracleBand)
+
+	// market orders are capped instead of rejected
+	order.OrderType = types.OrderType_MARKET
+	order.Price = sdk.NewDec(12)
+	require.Nil(t, bands.ValidateOrder(ctx, &order))
+	bands.CapMarketOrderPrice(ctx, &order)
+	require.Equal(t, sdk.NewDec(11), order.Price)
+	order.Price = sdk.NewDec(10)
+	bands.CapMarketOrderPrice(ctx, &order)
+	require.Equal(t, sdk.NewDec(10), order.Price)
+	order.PositionDirection = types.PositionDirection_SHORT
+	order.Price = sdk.ZeroDec()
+	bands.CapMarketOrderPrice(ctx, &order)
+	require.Equal(t, sdk.NewDec(9), order.Price)
+}
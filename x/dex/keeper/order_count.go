@@ -54,3 +54,8 @@ func (k Keeper) IncreaseOrderCount(ctx sdk.Context, contractAddr string, priceDe
 	oldCount := k.GetOrderCountState(ctx, contractAddr, priceDenom, assetDenom, direction, price)
 	return k.SetOrderCount(ctx, contractAddr, priceDenom, assetDenom, direction, price, oldCount+count)
 }
+
+func (k Keeper) RemoveAllOrderCountsForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) {
+	k.removeAllForPrefix(ctx, types.OrderCountPrefix(contractAddr, priceDenom, assetDenom, true))
+	k.removeAllForPrefix(ctx, types.OrderCountPrefix(contractAddr, priceDenom, assetDenom, false))
+}
@@ -51,6 +51,34 @@ func (k Keeper) GetAllRegisteredPairs(ctx sdk.Context, contractAddr string) []ty
 	return list
 }
 
+func (k Keeper) RemoveRegisteredPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegisteredPairPrefix(contractAddr))
+	store.Delete(types.PairPrefix(priceDenom, assetDenom))
+}
+
+// DoDelistPair removes the order books, the configuration and the price, volume and trade history
+// of a registered pair. Orders still resting in the books are dropped, so they need to have been
+// cancelled in the contract already.
+func (k Keeper) DoDelistPair(ctx sdk.Context, contractAddr string, pair types.Pair) {
+	k.removeAllForPrefix(ctx, types.OrderBookPrefix(true, contractAddr, pair.PriceDenom, pair.AssetDenom))
+	k.removeAllForPrefix(ctx, types.OrderBookPrefix(false, contractAddr, pair.PriceDenom, pair.AssetDenom))
+	k.removeAllForPrefix(ctx, types.TriggerOrderBookPrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	k.removeAllForPrefix(ctx, types.TriggeredOrderPrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	k.removeAllForPrefix(ctx, types.ExpiringOrderPrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	k.removeAllForPrefix(ctx, types.ExpiringOrderByTimePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	k.RemoveAllAccountActiveOrdersForPair(ctx, contractAddr, pair)
+	k.RemoveAllOrderCountsForPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	k.RemoveAllPricesForPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	k.RemoveAllVolumesForPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	k.RemoveAllCandlesForPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	k.RemoveAllAccountVolumesForPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	k.RemoveAllAccountTradesForPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	k.RemoveFeeSchedule(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	k.RemoveCircuitBreaker(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	k.RemovePairHalt(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	k.RemoveRegisteredPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+}
+
 func (k Keeper) DeleteAllRegisteredPairsForContract(ctx sdk.Context, contractAddr string) {
 	k.removeAllForPrefix(ctx, types.RegisteredPairPrefix(contractAddr))
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/sei-protocol/sei-chain/testutil/nullify"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
@@ -39,3 +40,43 @@ func TestAddGetPair(t *testing.T) {
 	require.True(t, hasPair)
 
 }
+
+func TestDoDelistPair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	otherPair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: "SEI"}
+	for i, pair := range []types.Pair{keepertest.TestPair, otherPair} {
+		pair := pair
+		keeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
+		keeper.SetPriceState(ctx, types.Price{SnapshotTimestampInSeconds: 10, Price: sdk.NewDec(100), Pair: &pair}, keepertest.TestContract)
+		keeper.AddVolume(ctx, keepertest.TestContract, pair, 10, sdk.NewDec(1), sdk.NewDec(100))
+		keeper.UpdateCandles(ctx, keepertest.TestContract, pair, 10, sdk.NewDec(100), sdk.NewDec(1))
+		keeper.AddAccountVolume(ctx, keepertest.TestContract, pair, keepertest.TestAccount, sdk.NewDec(100))
+		keeper.AddAccountTrades(ctx, keepertest.TestContract, []*types.SettlementEntry{{
+			Account:    keepertest.TestAccount,
+			PriceDenom: pair.PriceDenom,
+			AssetDenom: pair.AssetDenom,
+			Quantity:   sdk.NewDec(1),
+			Height:     uint64(i),
+			Timestamp:  uint64(ctx.BlockTime().Unix()),
+		}})
+		require.Nil(t, keeper.IncreaseOrderCount(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, types.PositionDirection_LONG, sdk.NewDec(100), 1))
+	}
+
+	keeper.DoDelistPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.False(t, keeper.HasRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+	require.Empty(t, keeper.GetAllPrices(ctx, keepertest.TestContract, keepertest.TestPair))
+	require.Empty(t, keeper.GetAllVolumes(ctx, keepertest.TestContract, keepertest.TestPair))
+	require.Empty(t, keeper.GetAllCandles(ctx, keepertest.TestContract, keepertest.TestPair))
+	require.True(t, keeper.GetAccountVolume(ctx, keepertest.TestContract, keepertest.TestPair, keepertest.TestAccount).IsZero())
+	require.Zero(t, keeper.GetOrderCountState(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, types.PositionDirection_LONG, sdk.NewDec(100)))
+
+	// the other pair of the contract is untouched
+	require.Equal(t, 1, len(keeper.GetAllPrices(ctx, keepertest.TestContract, otherPair)))
+	require.Equal(t, 1, len(keeper.GetAllVolumes(ctx, keepertest.TestContract, otherPair)))
+	require.NotEmpty(t, keeper.GetAllCandles(ctx, keepertest.TestContract, otherPair))
+	require.Equal(t, sdk.NewDec(100), keeper.GetAccountVolume(ctx, keepertest.TestContract, otherPair, keepertest.TestAccount))
+	require.Equal(t, uint64(1), keeper.GetOrderCountState(ctx, keepertest.TestContract, otherPair.PriceDenom, otherPair.AssetDenom, types.PositionDirection_LONG, sdk.NewDec(100)))
+	trades := keeper.GetAllAccountTrades(ctx, keepertest.TestContract, keepertest.TestAccount)
+	require.Equal(t, 1, len(trades))
+	require.Equal(t, otherPair.AssetDenom, trades[0].AssetDenom)
+}
@@ -87,10 +87,48 @@ func (k Keeper) GetPricesForTwap(ctx sdk.Context, contractAddr string, pair type
 	return
 }
 
+// GetTwap returns the time-weighted average of the price snapshots of a pair over the lookback
+// window ending at the current block time, or zero if the pair has no price snapshot
+func (k Keeper) GetTwap(ctx sdk.Context, contractAddr string, pair types.Pair, lookback uint64) sdk.Dec {
+	return calculateTwap(ctx, k.GetPricesForTwap(ctx, contractAddr, pair, lookback), lookback)
+}
+
+func calculateTwap(ctx sdk.Context, prices []*types.Price, lookback uint64) sdk.Dec {
+	if len(prices) == 0 {
+		return sdk.ZeroDec()
+	}
+	weightedPriceSum := sdk.ZeroDec()
+	lastTimestamp := ctx.BlockTime().Unix()
+	for _, price := range prices {
+		if uint64(ctx.BlockTime().Unix())-price.SnapshotTimestampInSeconds > lookback {
+			weight := lastTimestamp - ctx.BlockTime().Unix() + int64(lookback)
+			weightedPriceSum = weightedPriceSum.Add(price.Price.MulInt64(weight))
+			break
+		}
+		weightedPriceSum = weightedPriceSum.Add(
+			price.Price.MulInt64(lastTimestamp - int64(price.SnapshotTimestampInSeconds)),
+		)
+		lastTimestamp = int64(price.SnapshotTimestampInSeconds)
+	}
+	totalTimeSpan := ctx.BlockTime().Unix() - int64(prices[len(prices)-1].SnapshotTimestampInSeconds)
+	// the only snapshot may have been taken at the current block time
+	if totalTimeSpan <= 0 {
+		return prices[0].Price
+	}
+	if totalTimeSpan > int64(lookback) {
+		totalTimeSpan = int64(lookback)
+	}
+	return weightedPriceSum.QuoInt64(totalTimeSpan)
+}
+
 func (k Keeper) RemoveAllPricesForContract(ctx sdk.Context, contractAddr string) {
 	k.removeAllForPrefix(ctx, types.PriceContractPrefix(contractAddr))
 }
 
+func (k Keeper) RemoveAllPricesForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) {
+	k.removeAllForPrefix(ctx, types.PricePrefix(contractAddr, priceDenom, assetDenom))
+}
+
 func GetKeyForTs(ts uint64) []byte {
 	tsKey := make([]byte, 8)
 	binary.BigEndian.PutUint64(tsKey, ts)
@@ -0,0 +1,29 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetAccountFeeTier(goCtx context.Context, req *types.QueryGetAccountFeeTierRequest) (*types.QueryGetAccountFeeTierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair := types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom}
+	volume := k.GetAccountVolume(ctx, req.ContractAddr, pair, req.Account)
+	feeSchedule, found := k.GetFeeSchedule(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom)
+	if !found {
+		feeSchedule = types.FeeSchedule{MakerFeeRate: sdk.ZeroDec(), TakerFeeRate: sdk.ZeroDec()}
+	}
+	return &types.QueryGetAccountFeeTierResponse{
+		FeeTier:         feeSchedule.GetFeeTier(volume),
+		ThirtyDayVolume: volume,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountFeeTier(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(10*types.SecondsPerDay, 0))
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	req := &types.QueryGetAccountFeeTierRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		Account:      keepertest.TestAccount,
+	}
+
+	// no fee schedule
+	resp, err := wrapper.GetAccountFeeTier(wctx, req)
+	require.Nil(t, err)
+	require.Equal(t, sdk.ZeroDec(), resp.FeeTier.MakerFeeRate)
+	require.Equal(t, sdk.ZeroDec(), resp.FeeTier.TakerFeeRate)
+	require.Equal(t, sdk.ZeroDec(), resp.ThirtyDayVolume)
+
+	tier := types.FeeTier{
+		MinVolume:    sdk.NewDec(100),
+		MakerFeeRate: sdk.ZeroDec(),
+		TakerFeeRate: sdk.MustNewDecFromStr("0.001"),
+	}
+	keeper.SetFeeSchedule(ctx, types.FeeSchedule{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		MakerFeeRate: sdk.MustNewDecFromStr("0.001"),
+		TakerFeeRate: sdk.MustNewDecFromStr("0.002"),
+		Tiers:        []types.FeeTier{tier},
+	})
+	keeper.AddAccountVolume(ctx, keepertest.TestContract, keepertest.TestPair, keepertest.TestAccount, sdk.NewDec(150))
+	resp, err = wrapper.GetAccountFeeTier(wctx, req)
+	require.Nil(t, err)
+	require.Equal(t, tier, resp.FeeTier)
+	require.Equal(t, sdk.NewDec(150), resp.ThirtyDayVolume)
+}
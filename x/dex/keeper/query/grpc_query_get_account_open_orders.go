@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetAccountOpenOrders(c context.Context, req *types.QueryGetAccountOpenOrdersRequest) (*types.QueryGetAccountOpenOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	orders, pageRes, err := k.GetAccountActiveOrdersPaginated(ctx, req.ContractAddr, req.Account, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := []types.AccountOpenOrder{}
+	for _, order := range orders {
+		var allocation *types.Allocation
+		var found bool
+		if order.PositionDirection == types.PositionDirection_LONG {
+			allocation, found = k.GetLongAllocationForOrderID(ctx, req.ContractAddr, order.PriceDenom, order.AssetDenom, order.Price, order.Id)
+		} else {
+			allocation, found = k.GetShortAllocationForOrderID(ctx, req.ContractAddr, order.PriceDenom, order.AssetDenom, order.Price, order.Id)
+		}
+		remainingQuantity := sdk.ZeroDec()
+		if found {
+			remainingQuantity = allocation.Quantity
+		}
+		res = append(res, types.AccountOpenOrder{
+			Order:             order,
+			RemainingQuantity: remainingQuantity,
+			FilledQuantity:    order.Quantity.Sub(remainingQuantity),
+		})
+	}
+	return &types.QueryGetAccountOpenOrdersResponse{Orders: res, Pagination: pageRes}, nil
+}
@@ -0,0 +1,82 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	dexquery "github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountOpenOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	otherPair := types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: "sei"}
+	for _, order := range []types.Order{
+		{Id: 1, Account: keepertest.TestAccount, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom, PositionDirection: types.PositionDirection_LONG},
+		{Id: 2, Account: keepertest.TestAccount, PriceDenom: otherPair.PriceDenom, AssetDenom: otherPair.AssetDenom, PositionDirection: types.PositionDirection_SHORT},
+		{Id: 3, Account: keepertest.TestContract, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom, PositionDirection: types.PositionDirection_LONG},
+	} {
+		order.Price = sdk.NewDec(10)
+		order.Quantity = sdk.NewDec(5)
+		order.PlacementHeight = 7
+		keeper.SetAccountActiveOrder(ctx, keepertest.TestContract, order)
+	}
+	// order 1 is partially filled
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(10),
+		Entry: &types.OrderEntry{
+			Price:      sdk.NewDec(10),
+			Quantity:   sdk.NewDec(7),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{OrderId: 1, Account: keepertest.TestAccount, Quantity: sdk.NewDec(2)},
+				{OrderId: 3, Account: keepertest.TestContract, Quantity: sdk.NewDec(5)},
+			},
+		},
+	})
+	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.NewDec(10),
+		Entry: &types.OrderEntry{
+			Price:      sdk.NewDec(10),
+			Quantity:   sdk.NewDec(5),
+			PriceDenom: otherPair.PriceDenom,
+			AssetDenom: otherPair.AssetDenom,
+			Allocations: []*types.Allocation{
+				{OrderId: 2, Account: keepertest.TestAccount, Quantity: sdk.NewDec(5)},
+			},
+		},
+	})
+	wrapper := dexquery.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+
+	resp, err := wrapper.GetAccountOpenOrders(wctx, &types.QueryGetAccountOpenOrdersRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(resp.Orders))
+	openOrders := map[uint64]types.AccountOpenOrder{}
+	for _, openOrder := range resp.Orders {
+		openOrders[openOrder.Order.Id] = openOrder
+	}
+	require.Equal(t, sdk.NewDec(2), openOrders[1].RemainingQuantity)
+	require.Equal(t, sdk.NewDec(3), openOrders[1].FilledQuantity)
+	require.Equal(t, int64(7), openOrders[1].Order.PlacementHeight)
+	require.Equal(t, sdk.NewDec(5), openOrders[2].RemainingQuantity)
+	require.True(t, openOrders[2].FilledQuantity.IsZero())
+	require.Equal(t, otherPair.AssetDenom, openOrders[2].Order.AssetDenom)
+
+	resp, err = wrapper.GetAccountOpenOrders(wctx, &types.QueryGetAccountOpenOrdersRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+		Pagination:   &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(resp.Orders))
+	require.Equal(t, uint64(2), resp.Pagination.Total)
+	require.NotNil(t, resp.Pagination.NextKey)
+}
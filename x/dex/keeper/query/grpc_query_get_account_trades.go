@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetAccountTrades(c context.Context, req *types.QueryGetAccountTradesRequest) (*types.QueryGetAccountTradesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	trades, pageRes, err := k.GetAccountTradesPaginated(
+		ctx,
+		req.ContractAddr,
+		req.Account,
+		req.StartHeight,
+		req.EndHeight,
+		req.StartTimestamp,
+		req.EndTimestamp,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := []*types.SettlementEntry{}
+	for i := range trades {
+		res = append(res, &trades[i])
+	}
+	return &types.QueryGetAccountTradesResponse{Trades: res, Pagination: pageRes}, nil
+}
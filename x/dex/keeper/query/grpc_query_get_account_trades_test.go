@@ -0,0 +1,64 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	dexquery "github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountTrades(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	for height := uint64(1); height <= 3; height++ {
+		ctx = ctx.WithBlockHeight(int64(height)).WithBlockTime(time.Unix(int64(height*10), 0))
+		keeper.AddAccountTrades(ctx, keepertest.TestContract, []*types.SettlementEntry{
+			{
+				Account:                keepertest.TestAccount,
+				PriceDenom:             keepertest.TestPriceDenom,
+				AssetDenom:             keepertest.TestAssetDenom,
+				Quantity:               sdk.OneDec(),
+				ExecutionCostOrProceed: sdk.NewDec(10),
+				ExpectedCostOrProceed:  sdk.NewDec(10),
+				OrderId:                height,
+				Height:                 height,
+				Timestamp:              height * 10,
+			},
+		})
+	}
+	wrapper := dexquery.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+
+	resp, err := wrapper.GetAccountTrades(wctx, &types.QueryGetAccountTradesRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+		StartHeight:  2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(resp.Trades))
+	require.Equal(t, uint64(2), resp.Trades[0].OrderId)
+	require.Equal(t, uint64(3), resp.Trades[1].OrderId)
+
+	resp, err = wrapper.GetAccountTrades(wctx, &types.QueryGetAccountTradesRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+		Pagination:   &query.PageRequest{Limit: 1, Reverse: true, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(resp.Trades))
+	require.Equal(t, uint64(3), resp.Trades[0].OrderId)
+	require.Equal(t, uint64(3), resp.Pagination.Total)
+
+	resp, err = wrapper.GetAccountTrades(wctx, &types.QueryGetAccountTradesRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+		Pagination:   &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1, Reverse: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(resp.Trades))
+	require.Equal(t, uint64(2), resp.Trades[0].OrderId)
+}
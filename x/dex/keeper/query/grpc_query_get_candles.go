@@ -0,0 +1,26 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetCandles(goCtx context.Context, req *types.QueryGetCandlesRequest) (*types.QueryGetCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := types.GetCandleIntervalInSeconds(req.Interval); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	candles := k.GetLatestCandles(ctx, req.ContractAddr, types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom}, req.Interval, req.NumOfCandles)
+	return &types.QueryGetCandlesResponse{
+		Candles: candles,
+	}, nil
+}
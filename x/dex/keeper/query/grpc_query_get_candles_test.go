@@ -0,0 +1,47 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCandles(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, 3500, sdk.NewDec(100), sdk.NewDec(1))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, 3700, sdk.NewDec(110), sdk.NewDec(2))
+	keeper.UpdateCandles(ctx, keepertest.TestContract, keepertest.TestPair, 3800, sdk.NewDec(90), sdk.NewDec(3))
+
+	wctx := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	resp, err := wrapper.GetCandles(wctx, &types.QueryGetCandlesRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPair.PriceDenom,
+		AssetDenom:   keepertest.TestPair.AssetDenom,
+		Interval:     types.CandleInterval_ONE_HOUR,
+		NumOfCandles: 5,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(resp.Candles))
+	require.Equal(t, uint64(3600), resp.Candles[0].BeginTimestamp)
+	require.Equal(t, uint64(7200), resp.Candles[0].EndTimestamp)
+	require.Equal(t, sdk.NewDec(110), *resp.Candles[0].Open)
+	require.Equal(t, sdk.NewDec(110), *resp.Candles[0].High)
+	require.Equal(t, sdk.NewDec(90), *resp.Candles[0].Low)
+	require.Equal(t, sdk.NewDec(90), *resp.Candles[0].Close)
+	require.Equal(t, sdk.NewDec(5), *resp.Candles[0].Volume)
+	require.Equal(t, uint64(0), resp.Candles[1].BeginTimestamp)
+
+	_, err = wrapper.GetCandles(wctx, &types.QueryGetCandlesRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPair.PriceDenom,
+		AssetDenom:   keepertest.TestPair.AssetDenom,
+		Interval:     types.CandleInterval(100),
+		NumOfCandles: 5,
+	})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetContractRent(goCtx context.Context, req *types.QueryGetContractRentRequest) (*types.QueryGetContractRentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.GetContract(ctx, req.ContractAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	ledger := k.GetRentLedger(ctx, req.ContractAddr)
+	config, _ := k.GetRentConfig(ctx, req.ContractAddr)
+	burnRate := ledger.BurnRatePerBlock(ctx.BlockHeight())
+	res := &types.QueryGetContractRentResponse{
+		RentBalance:      contract.RentBalance,
+		Ledger:           ledger,
+		Config:           config,
+		BurnRatePerBlock: burnRate,
+	}
+	if burnRate.IsPositive() {
+		// the burn rate is at least 1 / RentBurnRateWindow, so this can't overflow for any valid
+		// rent balance
+		res.ProjectedBlocksUntilEmpty = sdk.NewDecFromInt(sdk.NewIntFromUint64(contract.RentBalance)).Quo(burnRate).TruncateInt().Uint64()
+	}
+	return res, nil
+}
@@ -0,0 +1,43 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetContractRent(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	require.Nil(t, keeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		RentBalance:  1000,
+	}))
+	req := &types.QueryGetContractRentRequest{ContractAddr: keepertest.TestContract}
+
+	// nothing has been charged yet
+	resp, err := wrapper.GetContractRent(sdk.WrapSDKContext(ctx), req)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1000), resp.RentBalance)
+	require.True(t, resp.BurnRatePerBlock.IsZero())
+	require.Equal(t, uint64(0), resp.ProjectedBlocksUntilEmpty)
+
+	keeper.RecordSudoRentUsage(ctx.WithBlockHeight(90), keepertest.TestContract, "settlement", 10000, 300)
+	config := types.RentConfig{ContractAddr: keepertest.TestContract, LowBalanceThresholds: []uint64{500}}
+	keeper.SetRentConfig(ctx, config)
+	resp, err = wrapper.GetContractRent(sdk.WrapSDKContext(ctx), req)
+	require.Nil(t, err)
+	require.Equal(t, uint64(300), resp.Ledger.TotalCharged)
+	require.Equal(t, config, resp.Config)
+	require.Equal(t, sdk.NewDec(3), resp.BurnRatePerBlock)
+	require.Equal(t, uint64(333), resp.ProjectedBlocksUntilEmpty)
+
+	_, err = wrapper.GetContractRent(sdk.WrapSDKContext(ctx), &types.QueryGetContractRentRequest{ContractAddr: keepertest.TestAccount})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,24 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetContractSuspensions(goCtx context.Context, req *types.QueryGetContractSuspensionsRequest) (*types.QueryGetContractSuspensionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	records := k.GetAllSuspensionRecords(ctx, req.ContractAddr)
+	if records == nil {
+		records = []types.SuspensionRecord{}
+	}
+	return &types.QueryGetContractSuspensionsResponse{Records: records}, nil
+}
@@ -0,0 +1,36 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetContractSuspensions(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	req := &types.QueryGetContractSuspensionsRequest{ContractAddr: keepertest.TestContract}
+
+	resp, err := wrapper.GetContractSuspensions(sdk.WrapSDKContext(ctx), req)
+	require.Nil(t, err)
+	require.Empty(t, resp.Records)
+
+	record := types.SuspensionRecord{
+		ContractAddr:      keepertest.TestContract,
+		Height:            10,
+		Phase:             types.ContractFailurePhase_PANIC,
+		Error:             "PANIC RECOVERED during order matching: oops",
+		GasUsed:           12345,
+		OrderCount:        3,
+		CancellationCount: 1,
+		DepositCount:      2,
+	}
+	keeper.AddSuspensionRecord(ctx, record)
+	resp, err = wrapper.GetContractSuspensions(sdk.WrapSDKContext(ctx), req)
+	require.Nil(t, err)
+	require.Equal(t, []types.SuspensionRecord{record}, resp.Records)
+}
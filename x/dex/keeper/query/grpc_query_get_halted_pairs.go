@@ -0,0 +1,27 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetHaltedPairs(goCtx context.Context, req *types.QueryGetHaltedPairsRequest) (*types.QueryGetHaltedPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	haltedPairs := []types.PairHalt{}
+	for _, halt := range k.GetAllPairHalts(ctx, req.ContractAddr) {
+		// halts that have run out are only removed once their pair is executed again
+		if halt.IsActive(uint64(ctx.BlockTime().Unix())) {
+			haltedPairs = append(haltedPairs, halt)
+		}
+	}
+	return &types.QueryGetHaltedPairsResponse{HaltedPairs: haltedPairs}, nil
+}
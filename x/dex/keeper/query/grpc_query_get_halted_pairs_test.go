@@ -0,0 +1,39 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHaltedPairs(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	halt := types.PairHalt{
+		ContractAddr:         keepertest.TestContract,
+		PriceDenom:           keepertest.TestPriceDenom,
+		AssetDenom:           keepertest.TestAssetDenom,
+		Price:                sdk.NewDec(120),
+		Twap:                 sdk.NewDec(100),
+		HaltedAtTimestamp:    990,
+		HaltedUntilTimestamp: 1050,
+	}
+	keeper.SetPairHalt(ctx, halt)
+	req := &types.QueryGetHaltedPairsRequest{ContractAddr: keepertest.TestContract}
+
+	resp, err := wrapper.GetHaltedPairs(sdk.WrapSDKContext(ctx), req)
+	require.Nil(t, err)
+	require.Equal(t, []types.PairHalt{halt}, resp.HaltedPairs)
+
+	// halts that have run out are not reported even if they haven't been removed yet
+	ctx = ctx.WithBlockTime(time.Unix(1050, 0))
+	resp, err = wrapper.GetHaltedPairs(sdk.WrapSDKContext(ctx), req)
+	require.Nil(t, err)
+	require.Empty(t, resp.HaltedPairs)
+}
@@ -19,7 +19,8 @@ func (k KeeperWrapper) GetHistoricalPrices(goCtx context.Context, req *types.Que
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	prices := k.GetAllPrices(ctx, req.ContractAddr, types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom})
+	pair := types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom}
+	prices := k.GetAllPrices(ctx, req.ContractAddr, pair)
 	currentTimeStamp := uint64(ctx.BlockTime().Unix())
 	beginTimestamp := currentTimeStamp - req.NumOfPeriods*req.PeriodLengthInSeconds
 	// sort descending
@@ -113,6 +114,21 @@ func (k KeeperWrapper) GetHistoricalPrices(goCtx context.Context, req *types.Que
 		}
 	}
 
+	// set volume
+	volumes := k.GetVolumesSince(ctx, req.ContractAddr, pair, beginTimestamp)
+	volumePtr := 0
+	for i := range candlesticks {
+		volume := sdk.ZeroDec()
+		for volumePtr < len(volumes) && volumes[volumePtr].SnapshotTimestampInSeconds >= candlesticks[i].EndTimestamp {
+			volumePtr++
+		}
+		for volumePtr < len(volumes) && volumes[volumePtr].SnapshotTimestampInSeconds >= candlesticks[i].BeginTimestamp {
+			volume = volume.Add(volumes[volumePtr].Quantity)
+			volumePtr++
+		}
+		candlesticks[i].Volume = &volume
+	}
+
 	return &types.QueryGetHistoricalPricesResponse{
 		Prices: candlesticks,
 	}, nil
@@ -16,6 +16,9 @@ func TestEachPeriodOneDataPoint(t *testing.T) {
 	keepertest.SeedPriceSnapshot(ctx, keeper, "100", 1)
 	keepertest.SeedPriceSnapshot(ctx, keeper, "101", 2)
 	keepertest.SeedPriceSnapshot(ctx, keeper, "99", 3) // should not be included since end is exclusive
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "1", "100", 1)
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "2", "202", 2)
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "3", "297", 3) // should not be included since end is exclusive
 
 	ctx = ctx.WithBlockTime(time.Unix(3, 0))
 	wctx := sdk.WrapSDKContext(ctx)
@@ -35,12 +38,14 @@ func TestEachPeriodOneDataPoint(t *testing.T) {
 	require.Equal(t, sdk.MustNewDecFromStr("101"), *resp.Prices[0].High)
 	require.Equal(t, sdk.MustNewDecFromStr("101"), *resp.Prices[0].Low)
 	require.Equal(t, sdk.MustNewDecFromStr("101"), *resp.Prices[0].Close)
+	require.Equal(t, sdk.MustNewDecFromStr("2"), *resp.Prices[0].Volume)
 	require.Equal(t, uint64(1), resp.Prices[1].BeginTimestamp)
 	require.Equal(t, uint64(2), resp.Prices[1].EndTimestamp)
 	require.Equal(t, sdk.MustNewDecFromStr("100"), *resp.Prices[1].Open)
 	require.Equal(t, sdk.MustNewDecFromStr("100"), *resp.Prices[1].High)
 	require.Equal(t, sdk.MustNewDecFromStr("100"), *resp.Prices[1].Low)
 	require.Equal(t, sdk.MustNewDecFromStr("100"), *resp.Prices[1].Close)
+	require.Equal(t, sdk.MustNewDecFromStr("1"), *resp.Prices[1].Volume)
 }
 
 func TestEachPeriodMultipleDataPoints(t *testing.T) {
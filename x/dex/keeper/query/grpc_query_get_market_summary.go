@@ -38,10 +38,10 @@ func (k KeeperWrapper) GetMarketSummary(goCtx context.Context, req *types.QueryG
 		}
 	}
 
-	zero := sdk.ZeroDec()
+	totalVolume, totalVolumeNotional := k.GetTotalVolumeSince(ctx, req.ContractAddr, types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom}, uint64(cutoff))
 	return &types.QueryGetMarketSummaryResponse{
-		TotalVolume:         &zero, // TODO: replace once we start tracking volume
-		TotalVolumeNotional: &zero, // TODO: replace once we start tracking volume
+		TotalVolume:         &totalVolume,
+		TotalVolumeNotional: &totalVolumeNotional,
 		HighPrice:           &maxPrice,
 		LowPrice:            &minPrice,
 		LastPrice:           &lastPrice,
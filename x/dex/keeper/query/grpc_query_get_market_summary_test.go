@@ -16,6 +16,9 @@ func TestGetMarketSummary(t *testing.T) {
 	keepertest.SeedPriceSnapshot(ctx, keeper, "100", 1)
 	keepertest.SeedPriceSnapshot(ctx, keeper, "101", 2)
 	keepertest.SeedPriceSnapshot(ctx, keeper, "99", 3)
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "1", "100", 1)
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "2", "202", 2)
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "3", "297", 3)
 
 	ctx = ctx.WithBlockTime(time.Unix(4, 0))
 	wctx := sdk.WrapSDKContext(ctx)
@@ -30,4 +33,6 @@ func TestGetMarketSummary(t *testing.T) {
 	require.Equal(t, sdk.MustNewDecFromStr("99"), *resp.LowPrice)
 	require.Equal(t, sdk.MustNewDecFromStr("99"), *resp.LastPrice)
 	require.Equal(t, sdk.MustNewDecFromStr("101"), *resp.HighPrice)
+	require.Equal(t, sdk.MustNewDecFromStr("6"), *resp.TotalVolume)
+	require.Equal(t, sdk.MustNewDecFromStr("599"), *resp.TotalVolumeNotional)
 }
@@ -0,0 +1,111 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const MaxOrderBookDepthLevels = 200
+
+func (k KeeperWrapper) GetOrderBookDepth(goCtx context.Context, req *types.QueryGetOrderBookDepthRequest) (*types.QueryGetOrderBookDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Levels == 0 || req.Levels > MaxOrderBookDepthLevels {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("levels must be between 1 and %d", MaxOrderBookDepthLevels))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bucketSize := sdk.ZeroDec()
+	if req.BucketTicks > 1 {
+		tickSize, found := k.GetPriceTickSizeForPair(ctx, req.ContractAddr, types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom})
+		if !found || !tickSize.IsPositive() {
+			return nil, status.Error(codes.InvalidArgument, "pair does not have a price tick size to bucket by")
+		}
+		bucketSize = tickSize.MulInt64(int64(req.BucketTicks))
+	}
+
+	n := int(req.Levels)
+	bids := aggregateDepth(
+		func(start *sdk.Dec) []types.OrderBookEntry {
+			if start == nil {
+				return k.GetTopNLongBooksForPair(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, n)
+			}
+			return k.GetTopNLongBooksForPairStarting(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, n, *start)
+		},
+		func(price sdk.Dec) sdk.Dec { return price.Quo(bucketSize).TruncateDec().Mul(bucketSize) },
+		bucketSize, n,
+	)
+	asks := aggregateDepth(
+		func(start *sdk.Dec) []types.OrderBookEntry {
+			if start == nil {
+				return k.GetTopNShortBooksForPair(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, n)
+			}
+			return k.GetTopNShortBooksForPairStarting(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, n, *start)
+		},
+		func(price sdk.Dec) sdk.Dec { return price.Quo(bucketSize).Ceil().Mul(bucketSize) },
+		bucketSize, n,
+	)
+
+	res := &types.QueryGetOrderBookDepthResponse{Bids: bids, Asks: asks}
+	if bestBids := k.GetTopNLongBooksForPair(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, 1); len(bestBids) > 0 {
+		bestBid := bestBids[0].GetPrice()
+		res.BestBid = &bestBid
+	}
+	if bestAsks := k.GetTopNShortBooksForPair(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom, 1); len(bestAsks) > 0 {
+		bestAsk := bestAsks[0].GetPrice()
+		res.BestAsk = &bestAsk
+	}
+	if res.BestBid != nil && res.BestAsk != nil {
+		spread := res.BestAsk.Sub(*res.BestBid)
+		res.Spread = &spread
+	}
+	return res, nil
+}
+
+// aggregateDepth loads book entries page by page, from the best price outwards, and sums them up
+// into at most n levels. Entries are bucketed with toBucket if bucketSize is positive.
+func aggregateDepth(
+	loadPage func(startExclusive *sdk.Dec) []types.OrderBookEntry,
+	toBucket func(sdk.Dec) sdk.Dec,
+	bucketSize sdk.Dec,
+	n int,
+) []types.OrderBookDepthLevel {
+	levels := []types.OrderBookDepthLevel{}
+	var start *sdk.Dec
+	for {
+		page := loadPage(start)
+		for _, entry := range page {
+			price := entry.GetPrice()
+			if bucketSize.IsPositive() {
+				price = toBucket(price)
+			}
+			if len(levels) > 0 && levels[len(levels)-1].Price.Equal(price) {
+				last := &levels[len(levels)-1]
+				last.Quantity = last.Quantity.Add(entry.GetOrderEntry().Quantity)
+				last.OrderCount += uint64(len(entry.GetOrderEntry().Allocations))
+				continue
+			}
+			if len(levels) == n {
+				return levels
+			}
+			levels = append(levels, types.OrderBookDepthLevel{
+				Price:      price,
+				Quantity:   entry.GetOrderEntry().Quantity,
+				OrderCount: uint64(len(entry.GetOrderEntry().Allocations)),
+			})
+		}
+		// without bucketing, a full set of levels can't grow any further
+		if len(page) < n || (!bucketSize.IsPositive() && len(levels) == n) {
+			return levels
+		}
+		lastPrice := page[len(page)-1].GetPrice()
+		start = &lastPrice
+	}
+}
@@ -0,0 +1,104 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrderBookDepth(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+
+	newEntry := func(price string, quantity int64, numOrders int) *types.OrderEntry {
+		allocations := []*types.Allocation{}
+		for i := 0; i < numOrders; i++ {
+			allocations = append(allocations, &types.Allocation{
+				OrderId:  uint64(i),
+				Account:  keepertest.TestAccount,
+				Quantity: sdk.NewDec(quantity).QuoInt64(int64(numOrders)),
+			})
+		}
+		return &types.OrderEntry{
+			Price:       sdk.MustNewDecFromStr(price),
+			Quantity:    sdk.NewDec(quantity),
+			PriceDenom:  keepertest.TestPriceDenom,
+			AssetDenom:  keepertest.TestAssetDenom,
+			Allocations: allocations,
+		}
+	}
+	for _, entry := range []*types.OrderEntry{newEntry("10", 2, 2), newEntry("9.5", 3, 1), newEntry("9", 4, 1)} {
+		keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{Price: entry.Price, Entry: entry})
+	}
+	for _, entry := range []*types.OrderEntry{newEntry("11", 1, 1), newEntry("11.5", 5, 1), newEntry("12.5", 6, 3)} {
+		keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{Price: entry.Price, Entry: entry})
+	}
+
+	req := &types.QueryGetOrderBookDepthRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		Levels:       2,
+	}
+	resp, err := wrapper.GetOrderBookDepth(wctx, req)
+	require.Nil(t, err)
+	require.Equal(t, []types.OrderBookDepthLevel{
+		{Price: sdk.NewDec(10), Quantity: sdk.NewDec(2), OrderCount: 2},
+		{Price: sdk.MustNewDecFromStr("9.5"), Quantity: sdk.NewDec(3), OrderCount: 1},
+	}, resp.Bids)
+	require.Equal(t, []types.OrderBookDepthLevel{
+		{Price: sdk.NewDec(11), Quantity: sdk.NewDec(1), OrderCount: 1},
+		{Price: sdk.MustNewDecFromStr("11.5"), Quantity: sdk.NewDec(5), OrderCount: 1},
+	}, resp.Asks)
+	require.Equal(t, sdk.NewDec(10), *resp.BestBid)
+	require.Equal(t, sdk.NewDec(11), *resp.BestAsk)
+	require.Equal(t, sdk.OneDec(), *resp.Spread)
+
+	// bucketing requires a price tick size
+	req.BucketTicks = 2
+	_, err = wrapper.GetOrderBookDepth(wctx, req)
+	require.NotNil(t, err)
+
+	// buckets of 1, with bids rounded down and asks rounded up
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.Nil(t, keeper.SetPriceTickSizeForPair(ctx, keepertest.TestContract, keepertest.TestPair, sdk.MustNewDecFromStr("0.5")))
+	resp, err = wrapper.GetOrderBookDepth(wctx, req)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(resp.Bids))
+	require.Equal(t, sdk.NewDec(10), resp.Bids[0].Price)
+	require.Equal(t, sdk.NewDec(9), resp.Bids[1].Price)
+	require.Equal(t, sdk.NewDec(7), resp.Bids[1].Quantity)
+	require.Equal(t, uint64(2), resp.Bids[1].OrderCount)
+	require.Equal(t, 2, len(resp.Asks))
+	require.Equal(t, sdk.NewDec(11), resp.Asks[0].Price)
+	require.Equal(t, sdk.NewDec(12), resp.Asks[1].Price)
+	require.Equal(t, sdk.NewDec(5), resp.Asks[1].Quantity)
+	// spread is based on the unbucketed book
+	require.Equal(t, sdk.OneDec(), *resp.Spread)
+
+	// empty side
+	resp, err = wrapper.GetOrderBookDepth(wctx, &types.QueryGetOrderBookDepthRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestAssetDenom,
+		AssetDenom:   keepertest.TestPriceDenom,
+		Levels:       2,
+	})
+	require.Nil(t, err)
+	require.Empty(t, resp.Bids)
+	require.Empty(t, resp.Asks)
+	require.Nil(t, resp.BestBid)
+	require.Nil(t, resp.Spread)
+
+	// invalid levels
+	req.Levels = 0
+	_, err = wrapper.GetOrderBookDepth(wctx, req)
+	require.NotNil(t, err)
+	req.Levels = query.MaxOrderBookDepthLevels + 1
+	_, err = wrapper.GetOrderBookDepth(wctx, req)
+	require.NotNil(t, err)
+}
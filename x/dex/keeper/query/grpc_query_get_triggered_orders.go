@@ -0,0 +1,28 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetTriggeredOrders(c context.Context, req *types.QueryGetTriggeredOrdersRequest) (*types.QueryGetTriggeredOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	orders := []*types.Order{}
+	for _, order := range k.GetAllTriggeredOrdersForPair(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom) {
+		order := order
+		if req.Account != "" && order.Account != req.Account {
+			continue
+		}
+		orders = append(orders, &order)
+	}
+
+	return &types.QueryGetTriggeredOrdersResponse{Orders: orders}, nil
+}
@@ -0,0 +1,48 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTriggeredOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	for i, account := range []string{keepertest.TestAccount, "another"} {
+		keeper.SetTriggeredOrder(ctx, keepertest.TestContract, types.Order{
+			Id:                uint64(i),
+			Account:           account,
+			ContractAddr:      keepertest.TestContract,
+			Price:             sdk.ZeroDec(),
+			Quantity:          sdk.NewDec(1),
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+			OrderType:         types.OrderType_STOPLOSS,
+			PositionDirection: types.PositionDirection_SHORT,
+			Nominal:           sdk.ZeroDec(),
+			TriggerPrice:      sdk.NewDec(10),
+		})
+	}
+
+	query := types.QueryGetTriggeredOrdersRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	}
+	resp, err := wrapper.GetTriggeredOrders(wctx, &query)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(resp.Orders))
+
+	query.Account = keepertest.TestAccount
+	resp, err = wrapper.GetTriggeredOrders(wctx, &query)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(resp.Orders))
+	require.Equal(t, uint64(0), resp.Orders[0].Id)
+	require.Equal(t, sdk.NewDec(10), resp.Orders[0].TriggerPrice)
+}
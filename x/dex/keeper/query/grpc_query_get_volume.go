@@ -0,0 +1,29 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetVolume(goCtx context.Context, req *types.QueryGetVolumeRequest) (*types.QueryGetVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// a lookback longer than the chain's age covers all volumes
+	cutoff := uint64(0)
+	if now := uint64(ctx.BlockTime().Unix()); now > req.LookbackInSeconds {
+		cutoff = now - req.LookbackInSeconds
+	}
+	totalVolume, totalVolumeNotional := k.GetTotalVolumeSince(ctx, req.ContractAddr, types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom}, cutoff)
+	return &types.QueryGetVolumeResponse{
+		TotalVolume:         totalVolume,
+		TotalVolumeNotional: totalVolumeNotional,
+	}, nil
+}
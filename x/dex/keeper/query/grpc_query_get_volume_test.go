@@ -0,0 +1,43 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetVolume(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "1", "100", 1)
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "2", "202", 2)
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "3", "297", 3)
+
+	ctx = ctx.WithBlockTime(time.Unix(4, 0))
+	wctx := sdk.WrapSDKContext(ctx)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	resp, err := wrapper.GetVolume(wctx, &types.QueryGetVolumeRequest{
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPair.PriceDenom,
+		AssetDenom:        keepertest.TestPair.AssetDenom,
+		LookbackInSeconds: 2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("5"), resp.TotalVolume)
+	require.Equal(t, sdk.MustNewDecFromStr("499"), resp.TotalVolumeNotional)
+
+	// a lookback longer than the chain's age covers all volumes
+	resp, err = wrapper.GetVolume(wctx, &types.QueryGetVolumeRequest{
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPair.PriceDenom,
+		AssetDenom:        keepertest.TestPair.AssetDenom,
+		LookbackInSeconds: 10,
+	})
+	require.Nil(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("6"), resp.TotalVolume)
+	require.Equal(t, sdk.MustNewDecFromStr("599"), resp.TotalVolumeNotional)
+}
@@ -2,7 +2,10 @@ package query
 
 import (
 	"context"
+	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -12,59 +15,175 @@ import (
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 )
 
+const MaxOrderSimulationsPerRequest = 100
+
 type priceQuantity struct {
 	price    sdk.Dec
 	quantity sdk.Dec
 }
 
+// orderBookSides holds the sides of the order books of a contract loaded while serving a request,
+// keyed by orderBookSideKey, so that each side is only read from the store once.
+type orderBookSides map[string][]types.OrderBookEntry
+
+func orderBookSideKey(priceDenom string, assetDenom string, long bool) string {
+	return strings.Join([]string{priceDenom, assetDenom, strconv.FormatBool(long)}, "|")
+}
+
+func (k KeeperWrapper) getOrderBookSide(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, long bool, books orderBookSides) []types.OrderBookEntry {
+	key := orderBookSideKey(priceDenom, assetDenom, long)
+	if entries, ok := books[key]; ok {
+		return entries
+	}
+	var entries []types.OrderBookEntry
+	if long {
+		entries = k.GetAllLongBookForPair(ctx, contractAddr, priceDenom, assetDenom)
+	} else {
+		entries = k.GetAllShortBookForPair(ctx, contractAddr, priceDenom, assetDenom)
+	}
+	books[key] = entries
+	return entries
+}
+
 // Note that this simulation is only accurate if it's called as part of the main Sei process (e.g. in Begin/EndBlock, transaction handler
 // or contract querier), because it needs to access dex's in-memory state.
 func (k KeeperWrapper) GetOrderSimulation(c context.Context, req *types.QueryOrderSimulationRequest) (*types.QueryOrderSimulationResponse, error) {
+	if req == nil || req.Order == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.simulateOrder(ctx, req.ContractAddr, req.Order, map[string]sdk.Dec{}, orderBookSides{}), nil
+}
+
+// Orders are simulated in the sequence they are specified, with liquidity consumed by an earlier
+// order unavailable to the orders after it. At most MaxOrderSimulationsPerRequest orders can be
+// simulated at once.
+func (k KeeperWrapper) GetOrderSimulations(c context.Context, req *types.QueryOrderSimulationsRequest) (*types.QueryOrderSimulationsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if len(req.Orders) > MaxOrderSimulationsPerRequest {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("at most %d orders can be simulated at once", MaxOrderSimulationsPerRequest))
+	}
 	ctx := sdk.UnwrapSDKContext(c)
-	matchedPriceQuantities := k.getMatchedPriceQuantities(ctx, req)
+	consumed := map[string]sdk.Dec{}
+	books := orderBookSides{}
+	results := []*types.QueryOrderSimulationResponse{}
+	for _, order := range req.Orders {
+		if order == nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid request")
+		}
+		results = append(results, k.simulateOrder(ctx, req.ContractAddr, order, consumed, books))
+	}
+	return &types.QueryOrderSimulationsResponse{Results: results}, nil
+}
+
+// consumed maps price levels (keyed by consumedKey) to quantities already taken by previously
+// simulated orders, and is updated with the quantities taken by this order. books holds the sides
+// of the order books already loaded for the request.
+func (k KeeperWrapper) simulateOrder(ctx sdk.Context, contractAddr string, order *types.Order, consumed map[string]sdk.Dec, books orderBookSides) *types.QueryOrderSimulationResponse {
 	executedQuantity := sdk.ZeroDec()
-	for _, pq := range matchedPriceQuantities {
-		if executedQuantity.Add(pq.quantity).GTE(req.Order.Quantity) {
-			executedQuantity = req.Order.Quantity
+	notional := sdk.ZeroDec()
+	var worstPrice *sdk.Dec
+	for _, pq := range k.getMatchedPriceQuantities(ctx, contractAddr, order, books) {
+		if executedQuantity.GTE(order.Quantity) {
 			break
 		}
-		executedQuantity = executedQuantity.Add(pq.quantity)
+		key := consumedKey(order, pq.price)
+		available := pq.quantity
+		if taken, ok := consumed[key]; ok {
+			available = available.Sub(taken)
+		}
+		if !available.IsPositive() {
+			continue
+		}
+		fillQuantity := sdk.MinDec(available, order.Quantity.Sub(executedQuantity))
+		executedQuantity = executedQuantity.Add(fillQuantity)
+		notional = notional.Add(fillQuantity.Mul(pq.price))
+		price := pq.price
+		worstPrice = &price
+		if taken, ok := consumed[key]; ok {
+			consumed[key] = taken.Add(fillQuantity)
+		} else {
+			consumed[key] = fillQuantity
+		}
+	}
+	remainingQuantity := order.Quantity.Sub(executedQuantity)
+	res := &types.QueryOrderSimulationResponse{
+		ExecutedQuantity:  &executedQuantity,
+		WorstPrice:        worstPrice,
+		Notional:          &notional,
+		RemainingQuantity: &remainingQuantity,
+	}
+	if executedQuantity.IsPositive() {
+		averageFillPrice := notional.Quo(executedQuantity)
+		res.AverageFillPrice = &averageFillPrice
+		if mid, ok := k.getMidPrice(ctx, contractAddr, order.PriceDenom, order.AssetDenom, books); ok {
+			var slippage sdk.Dec
+			if order.PositionDirection == types.PositionDirection_LONG {
+				slippage = averageFillPrice.Sub(mid).Quo(mid)
+			} else {
+				slippage = mid.Sub(averageFillPrice).Quo(mid)
+			}
+			res.Slippage = &slippage
+		}
+	}
+	return res
+}
+
+func consumedKey(order *types.Order, price sdk.Dec) string {
+	return strings.Join([]string{order.PriceDenom, order.AssetDenom, order.PositionDirection.String(), price.String()}, "|")
+}
+
+func (k KeeperWrapper) getMidPrice(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, books orderBookSides) (sdk.Dec, bool) {
+	var bestBid, bestAsk *sdk.Dec
+	for _, lb := range k.getOrderBookSide(ctx, contractAddr, priceDenom, assetDenom, true, books) {
+		if price := lb.GetPrice(); bestBid == nil || price.GT(*bestBid) {
+			bestBid = &price
+		}
+	}
+	for _, sb := range k.getOrderBookSide(ctx, contractAddr, priceDenom, assetDenom, false, books) {
+		if price := sb.GetPrice(); bestAsk == nil || price.LT(*bestAsk) {
+			bestAsk = &price
+		}
+	}
+	if bestBid == nil || bestAsk == nil {
+		return sdk.ZeroDec(), false
+	}
+	mid := bestBid.Add(*bestAsk).Quo(sdk.NewDec(2))
+	if !mid.IsPositive() {
+		return sdk.ZeroDec(), false
 	}
-	return &types.QueryOrderSimulationResponse{
-		ExecutedQuantity: &executedQuantity,
-	}, nil
+	return mid, true
 }
 
-func (k KeeperWrapper) getMatchedPriceQuantities(ctx sdk.Context, req *types.QueryOrderSimulationRequest) []priceQuantity {
-	orderDirection := req.Order.PositionDirection
+func (k KeeperWrapper) getMatchedPriceQuantities(ctx sdk.Context, contractAddr string, simulatedOrder *types.Order, books orderBookSides) []priceQuantity {
+	orderDirection := simulatedOrder.PositionDirection
 	// get existing liquidity
 	eligibleOrderBookPriceToQuantity := map[string]sdk.Dec{}
 	if orderDirection == types.PositionDirection_SHORT {
-		for _, lb := range k.GetAllLongBookForPair(ctx, req.ContractAddr, req.Order.PriceDenom, req.Order.AssetDenom) {
-			if req.Order.Price.IsZero() || req.Order.Price.LTE(lb.GetPrice()) {
+		for _, lb := range k.getOrderBookSide(ctx, contractAddr, simulatedOrder.PriceDenom, simulatedOrder.AssetDenom, true, books) {
+			if simulatedOrder.Price.IsZero() || simulatedOrder.Price.LTE(lb.GetPrice()) {
 				eligibleOrderBookPriceToQuantity[lb.GetPrice().String()] = lb.GetOrderEntry().Quantity
 			}
 		}
 	} else {
-		for _, sb := range k.GetAllShortBookForPair(ctx, req.ContractAddr, req.Order.PriceDenom, req.Order.AssetDenom) {
-			if req.Order.Price.IsZero() || req.Order.Price.GTE(sb.GetPrice()) {
+		for _, sb := range k.getOrderBookSide(ctx, contractAddr, simulatedOrder.PriceDenom, simulatedOrder.AssetDenom, false, books) {
+			if simulatedOrder.Price.IsZero() || simulatedOrder.Price.GTE(sb.GetPrice()) {
 				eligibleOrderBookPriceToQuantity[sb.GetPrice().String()] = sb.GetOrderEntry().Quantity
 			}
 		}
 	}
 
 	// exclude liquidity to be cancelled
-	pair := types.Pair{PriceDenom: req.Order.PriceDenom, AssetDenom: req.Order.AssetDenom}
-	for _, cancel := range dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(req.ContractAddr), pair).Get() {
+	pair := types.Pair{PriceDenom: simulatedOrder.PriceDenom, AssetDenom: simulatedOrder.AssetDenom}
+	for _, cancel := range dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(contractAddr), pair).Get() {
 		var cancelledAllocation *types.Allocation
 		var found bool
 		if cancel.PositionDirection == types.PositionDirection_LONG {
-			cancelledAllocation, found = k.GetLongAllocationForOrderID(ctx, req.ContractAddr, cancel.PriceDenom, cancel.AssetDenom, cancel.Price, cancel.Id)
+			cancelledAllocation, found = k.GetLongAllocationForOrderID(ctx, contractAddr, cancel.PriceDenom, cancel.AssetDenom, cancel.Price, cancel.Id)
 		} else {
-			cancelledAllocation, found = k.GetShortAllocationForOrderID(ctx, req.ContractAddr, cancel.PriceDenom, cancel.AssetDenom, cancel.Price, cancel.Id)
+			cancelledAllocation, found = k.GetShortAllocationForOrderID(ctx, contractAddr, cancel.PriceDenom, cancel.AssetDenom, cancel.Price, cancel.Id)
 		}
 		if !found {
 			continue
@@ -91,18 +210,18 @@ func (k KeeperWrapper) getMatchedPriceQuantities(ctx sdk.Context, req *types.Que
 
 	// exclude liquidity to be taken
 	ptr := 0
-	for _, order := range dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(req.ContractAddr), pair).GetSortedMarketOrders(orderDirection) {
+	for _, order := range dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr), pair).GetSortedMarketOrders(orderDirection) {
 		// If existing market order has price zero, it means it doesn't specify a worst price and will always have precedence over the simulated
 		// order
 		if !order.Price.IsZero() {
 			// If the simulated order doesn't specify a worst price, no existing order with a worst price will take liquidity from it
-			if req.Order.Price.IsZero() {
+			if simulatedOrder.Price.IsZero() {
 				break
 			}
-			if orderDirection == types.PositionDirection_LONG && order.Price.LT(req.Order.Price) {
+			if orderDirection == types.PositionDirection_LONG && order.Price.LT(simulatedOrder.Price) {
 				break
 			}
-			if orderDirection == types.PositionDirection_SHORT && order.Price.GT(req.Order.Price) {
+			if orderDirection == types.PositionDirection_SHORT && order.Price.GT(simulatedOrder.Price) {
 				break
 			}
 		}
@@ -9,6 +9,8 @@ import (
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetOrderSimulation(t *testing.T) {
@@ -58,6 +60,12 @@ func TestGetOrderSimulation(t *testing.T) {
 	res, err = wrapper.GetOrderSimulation(wctx, &types.QueryOrderSimulationRequest{Order: &testOrder, ContractAddr: keepertest.TestContract})
 	require.Nil(t, err)
 	require.Equal(t, sdk.MustNewDecFromStr("3"), *res.ExecutedQuantity)
+	require.Equal(t, sdk.MustNewDecFromStr("26"), *res.Notional)
+	require.Equal(t, sdk.MustNewDecFromStr("26").Quo(sdk.MustNewDecFromStr("3")), *res.AverageFillPrice)
+	require.Equal(t, sdk.MustNewDecFromStr("9"), *res.WorstPrice)
+	require.Equal(t, sdk.MustNewDecFromStr("2"), *res.RemainingQuantity)
+	// no mid price without a long book
+	require.Nil(t, res.Slippage)
 
 	// liquidity taken by cancel
 	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
@@ -99,3 +107,110 @@ func TestGetOrderSimulation(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, sdk.ZeroDec(), *res.ExecutedQuantity)
 }
+
+func TestGetOrderSimulations(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.MustNewDecFromStr("6"),
+		Entry: &types.OrderEntry{
+			Price:      sdk.MustNewDecFromStr("6"),
+			Quantity:   sdk.MustNewDecFromStr("1"),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+		},
+	})
+	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.MustNewDecFromStr("8"),
+		Entry: &types.OrderEntry{
+			Price:      sdk.MustNewDecFromStr("8"),
+			Quantity:   sdk.MustNewDecFromStr("2"),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+		},
+	})
+	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.MustNewDecFromStr("10"),
+		Entry: &types.OrderEntry{
+			Price:      sdk.MustNewDecFromStr("10"),
+			Quantity:   sdk.MustNewDecFromStr("2"),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+		},
+	})
+
+	newLongOrder := func(quantity string) *types.Order {
+		return &types.Order{
+			Account:           keepertest.TestAccount,
+			ContractAddr:      keepertest.TestContract,
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+			Price:             sdk.MustNewDecFromStr("10"),
+			Quantity:          sdk.MustNewDecFromStr(quantity),
+			PositionDirection: types.PositionDirection_LONG,
+		}
+	}
+	res, err := wrapper.GetOrderSimulations(wctx, &types.QueryOrderSimulationsRequest{
+		Orders:       []*types.Order{newLongOrder("3"), newLongOrder("3")},
+		ContractAddr: keepertest.TestContract,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(res.Results))
+
+	// first order takes all liquidity at 8 and part of the liquidity at 10
+	first := res.Results[0]
+	require.Equal(t, sdk.MustNewDecFromStr("3"), *first.ExecutedQuantity)
+	require.Equal(t, sdk.MustNewDecFromStr("26"), *first.Notional)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), *first.WorstPrice)
+	require.True(t, first.RemainingQuantity.IsZero())
+	averageFillPrice := sdk.MustNewDecFromStr("26").Quo(sdk.MustNewDecFromStr("3"))
+	require.Equal(t, averageFillPrice, *first.AverageFillPrice)
+	// mid price is 7
+	require.Equal(t, averageFillPrice.Sub(sdk.NewDec(7)).Quo(sdk.NewDec(7)), *first.Slippage)
+
+	// second order can only take what's left at 10
+	second := res.Results[1]
+	require.Equal(t, sdk.OneDec(), *second.ExecutedQuantity)
+	require.Equal(t, sdk.NewDec(10), *second.Notional)
+	require.Equal(t, sdk.NewDec(10), *second.AverageFillPrice)
+	require.Equal(t, sdk.NewDec(10), *second.WorstPrice)
+	require.Equal(t, sdk.NewDec(2), *second.RemainingQuantity)
+
+	// nothing left for a third order
+	res, err = wrapper.GetOrderSimulations(wctx, &types.QueryOrderSimulationsRequest{
+		Orders:       []*types.Order{newLongOrder("4"), newLongOrder("1")},
+		ContractAddr: keepertest.TestContract,
+	})
+	require.Nil(t, err)
+	require.Equal(t, sdk.ZeroDec(), *res.Results[1].ExecutedQuantity)
+	require.Nil(t, res.Results[1].AverageFillPrice)
+	require.Nil(t, res.Results[1].WorstPrice)
+	require.Nil(t, res.Results[1].Slippage)
+}
+
+func TestGetOrderSimulationsBatchLimit(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+
+	orders := []*types.Order{}
+	for i := 0; i < query.MaxOrderSimulationsPerRequest; i++ {
+		orders = append(orders, &types.Order{
+			Account:           keepertest.TestAccount,
+			ContractAddr:      keepertest.TestContract,
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+			Price:             sdk.MustNewDecFromStr("10"),
+			Quantity:          sdk.MustNewDecFromStr("1"),
+			PositionDirection: types.PositionDirection_LONG,
+		})
+	}
+	res, err := wrapper.GetOrderSimulations(wctx, &types.QueryOrderSimulationsRequest{Orders: orders, ContractAddr: keepertest.TestContract})
+	require.Nil(t, err)
+	require.Equal(t, query.MaxOrderSimulationsPerRequest, len(res.Results))
+
+	_, err = wrapper.GetOrderSimulations(wctx, &types.QueryOrderSimulationsRequest{Orders: append(orders, orders[0]), ContractAddr: keepertest.TestContract})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// MaxAllowedRentBalance is the largest rent balance a contract may have. Since cosmwasm would
+// amplify gas limit by a multiplier for its internal gas metering, we want to make sure the
+// amplified result doesn't exceed uint64 limit.
+func (k Keeper) MaxAllowedRentBalance() uint64 {
+	// TODO: replace with a wasm keeper query once its gas registry is made public
+	return uint64(math.MaxUint64) / wasmkeeper.DefaultGasMultiplier
+}
+
+// GetRentLedger returns the rent ledger of a contract, which is empty if nothing has been recorded
+// for the contract yet
+func (k Keeper) GetRentLedger(ctx sdk.Context, contractAddr string) types.RentLedger {
+	b := ctx.KVStore(k.storeKey).Get(types.RentLedgerKeyForContract(contractAddr))
+	if b == nil {
+		return types.NewRentLedger(contractAddr)
+	}
+	res := types.RentLedger{}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res
+}
+
+func (k Keeper) SetRentLedger(ctx sdk.Context, ledger types.RentLedger) {
+	ctx.KVStore(k.storeKey).Set(types.RentLedgerKeyForContract(ledger.ContractAddr), k.Cdc.MustMarshal(&ledger))
+}
+
+func (k Keeper) HasRentLedger(ctx sdk.Context, contractAddr string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.RentLedgerKeyForContract(contractAddr))
+}
+
+func (k Keeper) RemoveRentLedger(ctx sdk.Context, contractAddr string) {
+	ctx.KVStore(k.storeKey).Delete(types.RentLedgerKeyForContract(contractAddr))
+}
+
+func (k Keeper) GetRentConfig(ctx sdk.Context, contractAddr string) (types.RentConfig, bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.RentConfigKeyForContract(contractAddr))
+	if b == nil {
+		return types.RentConfig{ContractAddr: contractAddr}, false
+	}
+	res := types.RentConfig{}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res, true
+}
+
+func (k Keeper) SetRentConfig(ctx sdk.Context, config types.RentConfig) {
+	ctx.KVStore(k.storeKey).Set(types.RentConfigKeyForContract(config.ContractAddr), k.Cdc.MustMarshal(&config))
+}
+
+func (k Keeper) RemoveRentConfig(ctx sdk.Context, contractAddr string) {
+	ctx.KVStore(k.storeKey).Delete(types.RentConfigKeyForContract(contractAddr))
+}
+
+func (k Keeper) GetAllRentConfigs(ctx sdk.Context) (list []types.RentConfig) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RentConfigKey))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RentConfig
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) RecordRentDeposit(ctx sdk.Context, contractAddr string, amount uint64) {
+	ledger := k.GetRentLedger(ctx, contractAddr)
+	ledger.TotalDeposited += amount
+	k.SetRentLedger(ctx, ledger)
+}
+
+func (k Keeper) RecordRentRefund(ctx sdk.Context, contractAddr string, amount uint64) {
+	ledger := k.GetRentLedger(ctx, contractAddr)
+	ledger.TotalRefunded += amount
+	k.SetRentLedger(ctx, ledger)
+}
+
+// RecordRentCharge records rent charged from a contract for anything other than a sudo call
+func (k Keeper) RecordRentCharge(ctx sdk.Context, contractAddr string, amount uint64) {
+	ledger := k.GetRentLedger(ctx, contractAddr)
+	ledger.AddCharge(ctx.BlockHeight(), amount)
+	k.SetRentLedger(ctx, ledger)
+}
+
+// RecordSudoRentUsage records the gas consumed by a sudo call to a contract and the rent charged
+// for it
+func (k Keeper) RecordSudoRentUsage(ctx sdk.Context, contractAddr string, msgType string, gasConsumed uint64, rentCharged uint64) {
+	ledger := k.GetRentLedger(ctx, contractAddr)
+	ledger.AddSudoUsage(ctx.BlockHeight(), msgType, gasConsumed, rentCharged)
+	k.SetRentLedger(ctx, ledger)
+}
+
+// ProcessRentBalance tops up the rent balance of a contract if it has dropped below its auto
+// top-up threshold, and then emits an event if the rent balance has dropped below a low balance
+// threshold that it wasn't below before.
+func (k Keeper) ProcessRentBalance(ctx sdk.Context, config types.RentConfig) {
+	contract, err := k.GetContract(ctx, config.ContractAddr)
+	if err != nil {
+		return
+	}
+	if config.AutoTopup != nil && contract.RentBalance < config.AutoTopup.Threshold {
+		k.topupRent(ctx, &contract, *config.AutoTopup)
+	}
+	ledger := k.GetRentLedger(ctx, config.ContractAddr)
+	reached := config.LowestThresholdAbove(contract.RentBalance)
+	if reached == ledger.LowBalanceThresholdReached {
+		return
+	}
+	if reached != 0 && (ledger.LowBalanceThresholdReached == 0 || reached < ledger.LowBalanceThresholdReached) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeLowRentBalance,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, config.ContractAddr),
+			sdk.NewAttribute(types.AttributeKeyRentBalance, fmt.Sprint(contract.RentBalance)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprint(reached)),
+		))
+	}
+	ledger.LowBalanceThresholdReached = reached
+	k.SetRentLedger(ctx, ledger)
+}
+
+func (k Keeper) topupRent(ctx sdk.Context, contract *types.ContractInfoV2, topup types.RentAutoTopup) {
+	amount := topup.Amount
+	if maxAmount := k.MaxAllowedRentBalance() - contract.RentBalance; amount > maxAmount {
+		amount = maxAmount
+	}
+	if amount == 0 {
+		return
+	}
+	fundingAccount, err := sdk.AccAddressFromBech32(topup.FundingAccount)
+	if err == nil {
+		err = k.BankKeeper.SendCoins(ctx, fundingAccount, k.AccountKeeper.GetModuleAddress(types.ModuleName), sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewIntFromUint64(amount))))
+	}
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to top up rent of %s from %s: %s", contract.ContractAddr, topup.FundingAccount, err))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTopupRentFailed,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contract.ContractAddr),
+			sdk.NewAttribute(types.AttributeKeyFundingAccount, topup.FundingAccount),
+			sdk.NewAttribute(types.AttributeKeyAmount, fmt.Sprint(amount)),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		))
+		return
+	}
+	contract.RentBalance += amount
+	if err := k.SetContract(ctx, contract); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to set rent balance of %s after topping it up: %s", contract.ContractAddr, err))
+		return
+	}
+	k.RecordRentDeposit(ctx, contract.ContractAddr, amount)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTopupRent,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, contract.ContractAddr),
+		sdk.NewAttribute(types.AttributeKeyFundingAccount, topup.FundingAccount),
+		sdk.NewAttribute(types.AttributeKeyAmount, fmt.Sprint(amount)),
+		sdk.NewAttribute(types.AttributeKeyRentBalance, fmt.Sprint(contract.RentBalance)),
+	))
+}
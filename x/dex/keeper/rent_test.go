@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestRentLedgerBurnRate(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.RecordRentDeposit(ctx.WithBlockHeight(1), keepertest.TestContract, 1000)
+	keeper.RecordRentCharge(ctx.WithBlockHeight(1), keepertest.TestContract, 100)
+	keeper.RecordSudoRentUsage(ctx.WithBlockHeight(50), keepertest.TestContract, "settlement", 2000, 200)
+	keeper.RecordSudoRentUsage(ctx.WithBlockHeight(50), keepertest.TestContract, "settlement", 3000, 300)
+	keeper.RecordRentRefund(ctx.WithBlockHeight(60), keepertest.TestContract, 50)
+
+	ledger := keeper.GetRentLedger(ctx, keepertest.TestContract)
+	require.Equal(t, uint64(1000), ledger.TotalDeposited)
+	require.Equal(t, uint64(600), ledger.TotalCharged)
+	require.Equal(t, uint64(50), ledger.TotalRefunded)
+	require.Equal(t, []types.SudoRentUsage{{MsgType: "settlement", Calls: 2, GasConsumed: 5000, RentCharged: 500}}, ledger.SudoUsages)
+	require.Equal(t, []types.BlockRentCharge{{Height: 1, Amount: 100}, {Height: 50, Amount: 500}}, ledger.RecentCharges)
+	require.Equal(t, sdk.NewDec(6), ledger.BurnRatePerBlock(100))
+	// the charge at height 1 is out of the window
+	require.Equal(t, sdk.NewDec(5), ledger.BurnRatePerBlock(101))
+
+	keeper.RecordRentCharge(ctx.WithBlockHeight(120), keepertest.TestContract, 100)
+	ledger = keeper.GetRentLedger(ctx, keepertest.TestContract)
+	require.Equal(t, []types.BlockRentCharge{{Height: 50, Amount: 500}, {Height: 120, Amount: 100}}, ledger.RecentCharges)
+}
+
+func TestProcessRentBalance(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexkeeper := testApp.DexKeeper
+	funder, _ := sdk.AccAddressFromBech32(keepertest.TestAccount)
+	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(150)))
+	testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amounts)
+	testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, funder, amounts)
+	require.Nil(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		CodeId:       1,
+		RentBalance:  80,
+	}))
+	config := types.RentConfig{
+		ContractAddr:         keepertest.TestContract,
+		LowBalanceThresholds: []uint64{100, 50},
+	}
+	dexkeeper.SetRentConfig(ctx, config)
+
+	// the event is only emitted the first time the balance drops below a threshold
+	countEvents := func(ctx sdk.Context, eventType string) int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				count++
+			}
+		}
+		return count
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	dexkeeper.ProcessRentBalance(ctx, config)
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeLowRentBalance))
+	require.Equal(t, uint64(100), dexkeeper.GetRentLedger(ctx, keepertest.TestContract).LowBalanceThresholdReached)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	dexkeeper.ProcessRentBalance(ctx, config)
+	require.Equal(t, 0, countEvents(ctx, types.EventTypeLowRentBalance))
+
+	// an auto top-up refills the balance before checking the thresholds
+	config.AutoTopup = &types.RentAutoTopup{FundingAccount: keepertest.TestAccount, Threshold: 90, Amount: 100}
+	dexkeeper.SetRentConfig(ctx, config)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	dexkeeper.ProcessRentBalance(ctx, config)
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeTopupRent))
+	require.Equal(t, 0, countEvents(ctx, types.EventTypeLowRentBalance))
+	contract, err := dexkeeper.GetContract(ctx, keepertest.TestContract)
+	require.Nil(t, err)
+	require.Equal(t, uint64(180), contract.RentBalance)
+	ledger := dexkeeper.GetRentLedger(ctx, keepertest.TestContract)
+	require.Equal(t, uint64(100), ledger.TotalDeposited)
+	require.Equal(t, uint64(0), ledger.LowBalanceThresholdReached)
+	require.Equal(t, int64(50), testApp.BankKeeper.GetBalance(ctx, funder, "usei").Amount.Int64())
+
+	// the funding account can't cover another top-up
+	contract.RentBalance = 40
+	require.Nil(t, dexkeeper.SetContract(ctx, &contract))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	dexkeeper.ProcessRentBalance(ctx, config)
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeTopupRentFailed))
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeLowRentBalance))
+	contract, err = dexkeeper.GetContract(ctx, keepertest.TestContract)
+	require.Nil(t, err)
+	require.Equal(t, uint64(40), contract.RentBalance)
+	require.Equal(t, uint64(50), dexkeeper.GetRentLedger(ctx, keepertest.TestContract).LowBalanceThresholdReached)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// MaxSuspensionRecordsPerContract is the number of most recent suspension records kept for each
+// contract
+const MaxSuspensionRecordsPerContract = 20
+
+// AddSuspensionRecord records the suspension of a contract and drops its oldest records beyond
+// `MaxSuspensionRecordsPerContract`
+func (k Keeper) AddSuspensionRecord(ctx sdk.Context, record types.SuspensionRecord) {
+	k.SetSuspensionRecord(ctx, record)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SuspensionRecordPrefix(record.ContractAddr))
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	keysToDelete := [][]byte{}
+	for count := 0; iterator.Valid(); iterator.Next() {
+		count++
+		if count > MaxSuspensionRecordsPerContract {
+			keysToDelete = append(keysToDelete, iterator.Key())
+		}
+	}
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) SetSuspensionRecord(ctx sdk.Context, record types.SuspensionRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SuspensionRecordPrefix(record.ContractAddr))
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(record.Height))
+	store.Set(heightBytes, k.Cdc.MustMarshal(&record))
+}
+
+// GetAllSuspensionRecords returns the suspension records of a contract, oldest first
+func (k Keeper) GetAllSuspensionRecords(ctx sdk.Context, contractAddr string) (list []types.SuspensionRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SuspensionRecordPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SuspensionRecord
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllSuspensionRecordsForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.SuspensionRecordPrefix(contractAddr))
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuspensionRecords(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	for height := int64(1); height <= keeper.MaxSuspensionRecordsPerContract+5; height++ {
+		dexkeeper.AddSuspensionRecord(ctx, types.SuspensionRecord{
+			ContractAddr: keepertest.TestContract,
+			Height:       height,
+			Phase:        types.ContractFailurePhase_SETTLEMENT,
+			Error:        "error",
+		})
+	}
+	dexkeeper.AddSuspensionRecord(ctx, types.SuspensionRecord{ContractAddr: keepertest.TestAccount, Height: 1})
+
+	// only the most recent records are kept, oldest first
+	records := dexkeeper.GetAllSuspensionRecords(ctx, keepertest.TestContract)
+	require.Equal(t, keeper.MaxSuspensionRecordsPerContract, len(records))
+	require.Equal(t, int64(6), records[0].Height)
+	require.Equal(t, int64(keeper.MaxSuspensionRecordsPerContract+5), records[len(records)-1].Height)
+
+	dexkeeper.RemoveAllSuspensionRecordsForContract(ctx, keepertest.TestContract)
+	require.Empty(t, dexkeeper.GetAllSuspensionRecords(ctx, keepertest.TestContract))
+	require.Equal(t, 1, len(dexkeeper.GetAllSuspensionRecords(ctx, keepertest.TestAccount)))
+}
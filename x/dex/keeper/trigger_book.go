@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// SetTriggeredOrder sets a stop order in the trigger book of its pair, and indexes it if it has
+// been triggered
+func (k Keeper) SetTriggeredOrder(ctx sdk.Context, contractAddr string, order types.Order) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.TriggerOrderBookPrefix(contractAddr, order.PriceDenom, order.AssetDenom),
+	)
+	b := k.Cdc.MustMarshal(&order)
+	store.Set(GetKeyForOrderID(order.Id), b)
+
+	indexStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.TriggeredOrderPrefix(contractAddr, order.PriceDenom, order.AssetDenom),
+	)
+	if order.TriggerStatus {
+		// the index only needs its keys
+		indexStore.Set(GetKeyForOrderID(order.Id), []byte{})
+	} else {
+		indexStore.Delete(GetKeyForOrderID(order.Id))
+	}
+}
+
+func (k Keeper) GetTriggeredOrderByID(ctx sdk.Context, contractAddr string, orderID uint64, priceDenom string, assetDenom string) (val types.Order, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom),
+	)
+	b := store.Get(GetKeyForOrderID(orderID))
+	if b == nil {
+		return val, false
+	}
+	k.Cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) RemoveTriggeredOrder(ctx sdk.Context, contractAddr string, orderID uint64, priceDenom string, assetDenom string) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom),
+	)
+	store.Delete(GetKeyForOrderID(orderID))
+
+	indexStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.TriggeredOrderPrefix(contractAddr, priceDenom, assetDenom),
+	)
+	indexStore.Delete(GetKeyForOrderID(orderID))
+}
+
+// GetAllTriggeredOrdersForPair returns all stop orders of a pair, ordered by order ID
+func (k Keeper) GetAllTriggeredOrdersForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllTriggeredOrders returns all stop orders of a contract across its pairs
+func (k Keeper) GetAllTriggeredOrders(ctx sdk.Context, contractAddr string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerOrderBookContractPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// HasTriggeredOrders returns whether any stop order of the contract has been triggered and is
+// waiting to be matched, without reading the trigger books
+func (k Keeper) HasTriggeredOrders(ctx sdk.Context, contractAddr string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggeredOrderContractPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+func (k Keeper) RemoveAllTriggeredOrdersForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.TriggerOrderBookContractPrefix(contractAddr))
+	k.removeAllForPrefix(ctx, types.TriggeredOrderContractPrefix(contractAddr))
+}
+
+func GetKeyForOrderID(orderID uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, orderID)
+	return key
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNTriggeredOrders(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Order {
+	items := make([]types.Order, n)
+	for i := range items {
+		items[i] = types.Order{
+			Id:                uint64(i),
+			Account:           keepertest.TestAccount,
+			ContractAddr:      keepertest.TestContract,
+			Price:             sdk.NewDec(int64(i)),
+			Quantity:          sdk.NewDec(1),
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+			OrderType:         types.OrderType_STOPLIMIT,
+			PositionDirection: types.PositionDirection_LONG,
+			Nominal:           sdk.ZeroDec(),
+			TriggerPrice:      sdk.NewDec(int64(i)),
+		}
+		keeper.SetTriggeredOrder(ctx, keepertest.TestContract, items[i])
+	}
+	return items
+}
+
+func TestTriggeredOrderGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNTriggeredOrders(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, item.Id, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+		require.True(t, found)
+		require.Equal(t, item, got)
+	}
+	_, found := keeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 10, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.False(t, found)
+}
+
+func TestTriggeredOrderRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNTriggeredOrders(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveTriggeredOrder(ctx, keepertest.TestContract, item.Id, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+		_, found := keeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, item.Id, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+		require.False(t, found)
+	}
+}
+
+func TestTriggeredOrderGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNTriggeredOrders(keeper, ctx, 10)
+	require.Equal(t, items, keeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+	require.Equal(t, items, keeper.GetAllTriggeredOrders(ctx, keepertest.TestContract))
+	require.Empty(t, keeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, keepertest.TestAssetDenom, keepertest.TestPriceDenom))
+
+	keeper.RemoveAllTriggeredOrdersForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllTriggeredOrders(ctx, keepertest.TestContract))
+}
+
+func TestHasTriggeredOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNTriggeredOrders(keeper, ctx, 2)
+	require.False(t, keeper.HasTriggeredOrders(ctx, keepertest.TestContract))
+
+	items[1].TriggerStatus = true
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, items[1])
+	require.True(t, keeper.HasTriggeredOrders(ctx, keepertest.TestContract))
+	require.False(t, keeper.HasTriggeredOrders(ctx, keepertest.TestAccount))
+
+	keeper.RemoveTriggeredOrder(ctx, keepertest.TestContract, items[1].Id, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.False(t, keeper.HasTriggeredOrders(ctx, keepertest.TestContract))
+
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, items[1])
+	keeper.RemoveAllTriggeredOrdersForContract(ctx, keepertest.TestContract)
+	require.False(t, keeper.HasTriggeredOrders(ctx, keepertest.TestContract))
+}
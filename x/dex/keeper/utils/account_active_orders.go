@@ -0,0 +1,31 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// RemoveFilledAccountActiveOrders drops the account index entries of orders that have been
+// completely filled by the given settlements and are therefore no longer in the book.
+func RemoveFilledAccountActiveOrders(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	settlements []*types.SettlementEntry,
+) {
+	for _, settlement := range settlements {
+		order, found := keeper.GetAccountActiveOrder(ctx, string(contractAddr), pair, settlement.Account, settlement.OrderId)
+		if !found {
+			continue
+		}
+		getter := keeper.GetLongAllocationForOrderID
+		if order.PositionDirection == types.PositionDirection_SHORT {
+			getter = keeper.GetShortAllocationForOrderID
+		}
+		if _, stillResting := getter(ctx, string(contractAddr), pair.PriceDenom, pair.AssetDenom, order.Price, order.Id); !stillResting {
+			keeper.RemoveAccountActiveOrder(ctx, string(contractAddr), pair, order.Account, order.Id)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// TripCircuitBreakerFromExecutionOutcome halts matching of a pair for the cooldown of its circuit
+// breaker if the average clearing price of this block deviates from the pair's TWAP by more than
+// the circuit breaker allows. It needs to be called before the price state of this block is set so
+// that the TWAP only reflects previous blocks.
+func TripCircuitBreakerFromExecutionOutcome(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	outcome exchange.ExecutionOutcome,
+) {
+	if outcome.TotalQuantity.IsZero() {
+		return
+	}
+	circuitBreaker, found := keeper.GetCircuitBreaker(ctx, string(contractAddr), pair.PriceDenom, pair.AssetDenom)
+	if !found {
+		return
+	}
+	avgPrice := outcome.TotalNotional.Quo(outcome.TotalQuantity)
+	twap := keeper.GetTwap(ctx, string(contractAddr), pair, circuitBreaker.TwapLookbackSeconds)
+	if !circuitBreaker.IsTripped(avgPrice, twap) {
+		return
+	}
+	now := uint64(ctx.BlockTime().Unix())
+	halt := types.PairHalt{
+		ContractAddr:         string(contractAddr),
+		PriceDenom:           pair.PriceDenom,
+		AssetDenom:           pair.AssetDenom,
+		Price:                avgPrice,
+		Twap:                 twap,
+		HaltedAtTimestamp:    now,
+		HaltedUntilTimestamp: now + circuitBreaker.CooldownSeconds,
+	}
+	keeper.SetPairHalt(ctx, halt)
+	ctx.EventManager().EmitEvent(types.NewHaltPairEvent(halt))
+}
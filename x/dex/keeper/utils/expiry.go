@@ -0,0 +1,52 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// CancelExpiredOrders adds cancellations for resting orders of a pair whose expiry height or
+// expiry time has passed, so that they are removed from the book and cancelled in the contract
+// in this block's EndBlock, which also emits their `expire_order` events. Index entries are left
+// in place and are only pruned once the pair is executed, so that expired orders of a contract
+// that fails in this block are retried in the next one. Returns whether the pair has any expired
+// index entry.
+func CancelExpiredOrders(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+) bool {
+	expiredOrders := keeper.GetOrdersExpiredBefore(ctx, string(contractAddr), pair, ctx.BlockHeight(), uint64(ctx.BlockTime().Unix()))
+	if len(expiredOrders) == 0 {
+		return false
+	}
+	blockCancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, contractAddr, pair)
+	for _, expiringOrder := range expiredOrders {
+		cancellation := expiringOrder.Cancellation
+		getter := keeper.GetLongAllocationForOrderID
+		if cancellation.PositionDirection == types.PositionDirection_SHORT {
+			getter = keeper.GetShortAllocationForOrderID
+		}
+		// orders that are no longer in the book (i.e. filled or cancelled) only need their
+		// index entry pruned
+		if _, found := getter(ctx, string(contractAddr), pair.PriceDenom, pair.AssetDenom, cancellation.Price, cancellation.Id); !found {
+			continue
+		}
+		blockCancels.Add(&cancellation)
+	}
+	return true
+}
+
+// PruneExpiredOrders drops the index entries of all orders of a pair that have expired as of
+// the current block. It must be called after the cancellations of the block have been applied.
+func PruneExpiredOrders(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+) {
+	keeper.RemoveOrdersExpiredBefore(ctx, string(contractAddr), pair, ctx.BlockHeight(), uint64(ctx.BlockTime().Unix()))
+}
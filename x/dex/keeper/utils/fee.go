@@ -0,0 +1,74 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// GetFeeCalculator returns the fee calculator for a pair, or nil if the pair has no fee schedule
+func GetFeeCalculator(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+) *exchange.FeeCalculator {
+	feeSchedule, found := keeper.GetFeeSchedule(ctx, string(contractAddr), pair.PriceDenom, pair.AssetDenom)
+	if !found {
+		return nil
+	}
+	return exchange.NewFeeCalculator(feeSchedule, func(account string) sdk.Dec {
+		return keeper.GetAccountVolume(ctx, string(contractAddr), pair, account)
+	})
+}
+
+// UpdateAccountVolumesFromSettlements adds the notional of each settlement to the volume of its
+// account, which fee tiers are based on
+func UpdateAccountVolumesFromSettlements(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	settlements []*types.SettlementEntry,
+) {
+	notionals := map[string]sdk.Dec{}
+	accounts := []string{}
+	for _, settlement := range settlements {
+		notional := settlement.Quantity.Mul(settlement.ExecutionCostOrProceed)
+		if existing, ok := notionals[settlement.Account]; ok {
+			notionals[settlement.Account] = existing.Add(notional)
+			continue
+		}
+		notionals[settlement.Account] = notional
+		accounts = append(accounts, settlement.Account)
+	}
+	for _, account := range accounts {
+		keeper.AddAccountVolume(ctx, string(contractAddr), pair, account, notionals[account])
+	}
+}
+
+// GetFeesToCollect sums up the fees of settlements by price denom, truncated to whole coins
+func GetFeesToCollect(settlements []*types.SettlementEntry) (sdk.Coins, error) {
+	totals := map[string]sdk.Dec{}
+	for _, settlement := range settlements {
+		if settlement.Fee == nil || !settlement.Fee.IsPositive() {
+			continue
+		}
+		if total, ok := totals[settlement.PriceDenom]; ok {
+			totals[settlement.PriceDenom] = total.Add(*settlement.Fee)
+		} else {
+			totals[settlement.PriceDenom] = *settlement.Fee
+		}
+	}
+	coins := []sdk.Coin{}
+	for denom, total := range totals {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, err
+		}
+		if amount := total.TruncateInt(); amount.IsPositive() {
+			coins = append(coins, sdk.NewCoin(denom, amount))
+		}
+	}
+	return sdk.NewCoins(coins...), nil
+}
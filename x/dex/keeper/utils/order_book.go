@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/utils/datastructures"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
@@ -58,11 +59,16 @@ func PopulateOrderbook(
 			ctx.Logger().Error(fmt.Sprintf("error setting order count: %s", err))
 		}
 	}
+	allocator := exchange.GetAllocator(pair)
 	return &types.OrderBook{
 		Contract: contractAddr,
 		Pair:     pair,
-		Longs:    types.NewCachedSortedOrderBookEntries(longLoader, longSetter, longDeleter),
-		Shorts:   types.NewCachedSortedOrderBookEntries(shortLoader, shortSetter, shortDeleter),
+		Longs: types.NewCachedSortedOrderBookEntries(longLoader, longSetter, longDeleter).
+			WithAllocator(allocator).
+			WithSelfTradePrevention(pair.SelfTradePrevention),
+		Shorts: types.NewCachedSortedOrderBookEntries(shortLoader, shortSetter, shortDeleter).
+			WithAllocator(allocator).
+			WithSelfTradePrevention(pair.SelfTradePrevention),
 	}
 }
 
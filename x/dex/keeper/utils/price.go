@@ -7,6 +7,8 @@ import (
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
 
+// SetPriceStateFromExecutionOutcome records the average execution price of this block and
+// folds it into the candles of the pair.
 func SetPriceStateFromExecutionOutcome(
 	ctx sdk.Context,
 	keeper *keeper.Keeper,
@@ -25,4 +27,36 @@ func SetPriceStateFromExecutionOutcome(
 		SnapshotTimestampInSeconds: uint64(ctx.BlockTime().Unix()),
 	}
 	keeper.SetPriceState(ctx, priceState, string(contractAddr))
+	quantity, _ := getTradedVolume(outcome)
+	keeper.UpdateCandles(ctx, string(contractAddr), pair, priceState.SnapshotTimestampInSeconds, avgPrice, quantity)
+}
+
+// SetVolumeStateFromExecutionOutcome adds the volume traded in this block to the volume bucket of
+// the block time. Volume is derived from the long side of settlements since `TotalQuantity` of an
+// outcome counts limit order matches on both sides.
+func SetVolumeStateFromExecutionOutcome(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	outcome exchange.ExecutionOutcome,
+) {
+	quantity, notional := getTradedVolume(outcome)
+	if quantity.IsZero() {
+		return
+	}
+	keeper.AddVolume(ctx, string(contractAddr), pair, uint64(ctx.BlockTime().Unix()), quantity, notional)
+}
+
+func getTradedVolume(outcome exchange.ExecutionOutcome) (sdk.Dec, sdk.Dec) {
+	quantity, notional := sdk.ZeroDec(), sdk.ZeroDec()
+	longDirection := types.GetContractPositionDirection(types.PositionDirection_LONG)
+	for _, settlement := range outcome.Settlements {
+		if settlement.PositionDirection != longDirection {
+			continue
+		}
+		quantity = quantity.Add(settlement.Quantity)
+		notional = notional.Add(settlement.Quantity.Mul(settlement.ExecutionCostOrProceed))
+	}
+	return quantity, notional
 }
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+func IsStopOrder(order *types.Order) bool {
+	return order.OrderType == types.OrderType_STOPLOSS || order.OrderType == types.OrderType_STOPLIMIT
+}
+
+// A long stop order triggers once the price rises to its trigger price, and a short
+// stop order triggers once the price falls to its trigger price.
+func IsTriggerPriceCrossed(order types.Order, price sdk.Dec) bool {
+	if order.PositionDirection == types.PositionDirection_LONG {
+		return price.GTE(order.TriggerPrice)
+	}
+	return price.LTE(order.TriggerPrice)
+}
+
+// UpdateTriggerBookFromExecutionOutcome removes triggered orders that have been matched
+// in this block from the trigger book, arms stop orders placed in this block, and then
+// marks armed orders whose trigger price is crossed by the post-match price as triggered.
+// Triggered orders will be converted and matched in the next block.
+func UpdateTriggerBookFromExecutionOutcome(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	blockOrders []*types.Order,
+	outcome exchange.ExecutionOutcome,
+) {
+	for _, order := range blockOrders {
+		if order.TriggerStatus {
+			keeper.RemoveTriggeredOrder(ctx, string(contractAddr), order.Id, pair.PriceDenom, pair.AssetDenom)
+			continue
+		}
+		if IsStopOrder(order) && order.Status != types.OrderStatus_FAILED_TO_PLACE {
+			keeper.SetTriggeredOrder(ctx, string(contractAddr), *order)
+		}
+	}
+
+	if outcome.TotalQuantity.IsZero() {
+		return
+	}
+	postMatchPrice := outcome.TotalNotional.Quo(outcome.TotalQuantity)
+	for _, order := range keeper.GetAllTriggeredOrdersForPair(ctx, string(contractAddr), pair.PriceDenom, pair.AssetDenom) {
+		if order.TriggerStatus || !IsTriggerPriceCrossed(order, postMatchPrice) {
+			continue
+		}
+		order.TriggerStatus = true
+		keeper.SetTriggeredOrder(ctx, string(contractAddr), order)
+	}
+}
+
+// MoveTriggeredOrdersToBlockOrders adds all triggered orders of a pair to the block's
+// orders, as market orders for STOPLOSS and limit orders for STOPLIMIT, unless they
+// have been cancelled in this block.
+func MoveTriggeredOrdersToBlockOrders(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+) {
+	memState := dexutils.GetMemState(ctx.Context())
+	blockOrders := memState.GetBlockOrders(ctx, contractAddr, pair)
+	blockCancels := memState.GetBlockCancels(ctx, contractAddr, pair)
+	for _, order := range keeper.GetAllTriggeredOrdersForPair(ctx, string(contractAddr), pair.PriceDenom, pair.AssetDenom) {
+		if !order.TriggerStatus || blockCancels.Has(&types.Cancellation{Id: order.Id}) {
+			continue
+		}
+		order := order
+		if order.OrderType == types.OrderType_STOPLOSS {
+			order.OrderType = types.OrderType_MARKET
+		} else {
+			order.OrderType = types.OrderType_LIMIT
+		}
+		order.Status = types.OrderStatus_PLACED
+		blockOrders.Add(&order)
+	}
+}
@@ -102,7 +102,7 @@ func CallContractSudo(sdkCtx sdk.Context, k *keeper.Keeper, contractAddr string,
 	}
 	msgType := getMsgType(msg)
 	data, gasUsed, suderr := sudo(sdkCtx, k, contractAddress, wasmMsg, msgType)
-	if err := k.ChargeRentForGas(sdkCtx, contractAddr, gasUsed, gasAllowance); err != nil {
+	if err := k.ChargeRentForGas(sdkCtx, contractAddr, msgType, gasUsed, gasAllowance); err != nil {
 		metrics.IncrementSudoFailCount(msgType)
 		sdkCtx.Logger().Error(err.Error())
 		return []byte{}, err
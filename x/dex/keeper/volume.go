@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+func (k Keeper) SetVolumeState(ctx sdk.Context, volume types.Volume, contractAddr string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VolumePrefix(contractAddr, volume.Pair.PriceDenom, volume.Pair.AssetDenom))
+	b := k.Cdc.MustMarshal(&volume)
+	store.Set(GetKeyForTs(volume.SnapshotTimestampInSeconds), b)
+}
+
+// AddVolume accumulates traded quantity and notional into the volume bucket of the given timestamp
+func (k Keeper) AddVolume(ctx sdk.Context, contractAddr string, pair types.Pair, timestamp uint64, quantity sdk.Dec, notional sdk.Dec) {
+	volume, found := k.GetVolumeState(ctx, contractAddr, timestamp, pair)
+	if !found {
+		volume = types.Volume{
+			SnapshotTimestampInSeconds: timestamp,
+			Quantity:                   sdk.ZeroDec(),
+			Notional:                   sdk.ZeroDec(),
+			Pair:                       &pair,
+		}
+	}
+	volume.Quantity = volume.Quantity.Add(quantity)
+	volume.Notional = volume.Notional.Add(notional)
+	k.SetVolumeState(ctx, volume, contractAddr)
+}
+
+func (k Keeper) GetVolumeState(ctx sdk.Context, contractAddr string, timestamp uint64, pair types.Pair) (types.Volume, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VolumePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	res := types.Volume{}
+	b := store.Get(GetKeyForTs(timestamp))
+	if b == nil {
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res, true
+}
+
+func (k Keeper) GetAllVolumes(ctx sdk.Context, contractAddr string, pair types.Pair) (list []*types.Volume) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VolumePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Volume
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, &val)
+	}
+
+	return
+}
+
+// GetVolumesSince returns all volume buckets no older than the cutoff timestamp, latest first
+func (k Keeper) GetVolumesSince(ctx sdk.Context, contractAddr string, pair types.Pair, cutoff uint64) (list []*types.Volume) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VolumePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Volume
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		if val.SnapshotTimestampInSeconds < cutoff {
+			break
+		}
+		list = append(list, &val)
+	}
+
+	return
+}
+
+// GetTotalVolumeSince returns the total quantity and notional traded since the cutoff timestamp
+func (k Keeper) GetTotalVolumeSince(ctx sdk.Context, contractAddr string, pair types.Pair, cutoff uint64) (sdk.Dec, sdk.Dec) {
+	quantity, notional := sdk.ZeroDec(), sdk.ZeroDec()
+	for _, volume := range k.GetVolumesSince(ctx, contractAddr, pair, cutoff) {
+		quantity = quantity.Add(volume.Quantity)
+		notional = notional.Add(volume.Notional)
+	}
+	return quantity, notional
+}
+
+func (k Keeper) RemoveAllVolumesForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.VolumeContractPrefix(contractAddr))
+}
+
+func (k Keeper) RemoveAllVolumesForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) {
+	k.removeAllForPrefix(ctx, types.VolumePrefix(contractAddr, priceDenom, assetDenom))
+}
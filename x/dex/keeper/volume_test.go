@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddVolume(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.AddVolume(ctx, keepertest.TestContract, keepertest.TestPair, 1, sdk.NewDec(2), sdk.NewDec(20))
+	keeper.AddVolume(ctx, keepertest.TestContract, keepertest.TestPair, 1, sdk.NewDec(1), sdk.NewDec(11))
+	keeper.AddVolume(ctx, keepertest.TestContract, keepertest.TestPair, 2, sdk.NewDec(5), sdk.NewDec(60))
+
+	volume, found := keeper.GetVolumeState(ctx, keepertest.TestContract, 1, keepertest.TestPair)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), volume.Quantity)
+	require.Equal(t, sdk.NewDec(31), volume.Notional)
+	_, found = keeper.GetVolumeState(ctx, keepertest.TestContract, 3, keepertest.TestPair)
+	require.False(t, found)
+	require.Equal(t, 2, len(keeper.GetAllVolumes(ctx, keepertest.TestContract, keepertest.TestPair)))
+}
+
+func TestGetVolumesSince(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "1", "10", 1)
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "2", "22", 2)
+	keepertest.SeedVolumeSnapshot(ctx, keeper, "3", "27", 3)
+
+	volumes := keeper.GetVolumesSince(ctx, keepertest.TestContract, keepertest.TestPair, 2)
+	require.Equal(t, 2, len(volumes))
+	require.Equal(t, uint64(3), volumes[0].SnapshotTimestampInSeconds)
+	require.Equal(t, uint64(2), volumes[1].SnapshotTimestampInSeconds)
+
+	quantity, notional := keeper.GetTotalVolumeSince(ctx, keepertest.TestContract, keepertest.TestPair, 2)
+	require.Equal(t, sdk.NewDec(5), quantity)
+	require.Equal(t, sdk.NewDec(49), notional)
+
+	keeper.RemoveAllVolumesForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllVolumes(ctx, keepertest.TestContract, keepertest.TestPair))
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// RebuildAccountActiveOrders rebuilds the account active orders index of every contract from the
+// allocations resting in its long and short books, so that orders placed before the index existed
+// can be found by account. Orders that are already indexed keep their indexed details, and index
+// entries of orders no longer in the books are dropped.
+func RebuildAccountActiveOrders(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	for _, c := range dexkeeper.GetAllContractInfo(ctx) {
+		indexed := map[uint64]types.Order{}
+		for _, order := range dexkeeper.GetAllAccountActiveOrders(ctx, c.ContractAddr) {
+			indexed[order.Id] = order
+		}
+		dexkeeper.RemoveAllAccountActiveOrdersForContract(ctx, c.ContractAddr)
+		for _, pair := range dexkeeper.GetAllRegisteredPairs(ctx, c.ContractAddr) {
+			for _, order := range dexkeeper.GetAllRestingOrdersForPair(ctx, c.ContractAddr, pair) {
+				if existing, ok := indexed[order.Id]; ok {
+					order = existing
+				}
+				dexkeeper.SetAccountActiveOrder(ctx, c.ContractAddr, order)
+			}
+		}
+	}
+	return nil
+}
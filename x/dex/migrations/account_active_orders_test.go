@@ -0,0 +1,79 @@
+package migrations_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebuildAccountActiveOrders(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	require.NoError(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract}))
+	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	dexkeeper.SetLongOrderBookEntry(ctx, keepertest.TestContract, &types.LongBook{
+		Price: sdk.NewDec(9),
+		Entry: &types.OrderEntry{
+			Price:      sdk.NewDec(9),
+			Quantity:   sdk.NewDec(3),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{OrderId: 1, Account: keepertest.TestAccount, Quantity: sdk.NewDec(1)},
+				{OrderId: 2, Account: "def", Quantity: sdk.NewDec(2)},
+			},
+		},
+	})
+	dexkeeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, &types.ShortBook{
+		Price: sdk.NewDec(11),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(11),
+			Quantity:    sdk.NewDec(4),
+			PriceDenom:  keepertest.TestPriceDenom,
+			AssetDenom:  keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{{OrderId: 3, Account: keepertest.TestAccount, Quantity: sdk.NewDec(4)}},
+		},
+	})
+	// order 2 is already indexed, and order 4 is indexed but no longer in the book
+	dexkeeper.SetAccountActiveOrder(ctx, keepertest.TestContract, types.Order{
+		Id:                2,
+		Account:           "def",
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(9),
+		Quantity:          sdk.NewDec(5),
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		PlacementHeight:   7,
+	})
+	dexkeeper.SetAccountActiveOrder(ctx, keepertest.TestContract, types.Order{
+		Id:         4,
+		Account:    keepertest.TestAccount,
+		Price:      sdk.NewDec(10),
+		Quantity:   sdk.NewDec(1),
+		PriceDenom: keepertest.TestPriceDenom,
+		AssetDenom: keepertest.TestAssetDenom,
+	})
+
+	require.NoError(t, migrations.RebuildAccountActiveOrders(ctx, *dexkeeper))
+
+	orders := dexkeeper.GetAccountActiveOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPair, keepertest.TestAccount)
+	require.Equal(t, 2, len(orders))
+	require.Equal(t, uint64(1), orders[0].Id)
+	require.Equal(t, keepertest.TestContract, orders[0].ContractAddr)
+	require.Equal(t, types.PositionDirection_LONG, orders[0].PositionDirection)
+	require.Equal(t, sdk.NewDec(9), orders[0].Price)
+	require.Equal(t, sdk.NewDec(1), orders[0].Quantity)
+	require.Equal(t, uint64(3), orders[1].Id)
+	require.Equal(t, types.PositionDirection_SHORT, orders[1].PositionDirection)
+	require.Equal(t, sdk.NewDec(11), orders[1].Price)
+	existing, found := dexkeeper.GetAccountActiveOrder(ctx, keepertest.TestContract, keepertest.TestPair, "def", 2)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(5), existing.Quantity)
+	require.Equal(t, int64(7), existing.PlacementHeight)
+	require.Equal(t, 3, len(dexkeeper.GetAllAccountActiveOrders(ctx, keepertest.TestContract)))
+}
@@ -30,6 +30,7 @@ import (
 	dexkeeperabci "github.com/sei-protocol/sei-chain/x/dex/keeper/abci"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
 	dexkeeperquery "github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
 	"github.com/sei-protocol/sei-chain/x/dex/migrations"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
@@ -209,6 +210,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	_ = cfg.RegisterMigration(types.ModuleName, 15, func(ctx sdk.Context) error {
 		return migrations.V15ToV16(ctx, am.keeper)
 	})
+	_ = cfg.RegisterMigration(types.ModuleName, 16, func(ctx sdk.Context) error {
+		return migrations.RebuildAccountActiveOrders(ctx, am.keeper)
+	})
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -233,7 +237,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 16 }
+func (AppModule) ConsensusVersion() uint64 { return 17 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
@@ -280,6 +284,29 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	}
 	// only write if all contracts have been processed
 	cachedStore.Write()
+
+	// Sweep resting orders that have expired by height or time into this block's cancellations.
+	// Contracts with triggered or expired orders need to be processed even if they receive no
+	// new order in this block
+	for _, contract := range allContracts {
+		hasExpiredOrders := am.cancelExpiredOrders(ctx, contract)
+		if am.keeper.HasTriggeredOrders(ctx, contract.ContractAddr) || hasExpiredOrders {
+			dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contract.ContractAddr, am.keeper.GetContractWithoutGasCharge)
+		}
+	}
+}
+
+func (am AppModule) cancelExpiredOrders(ctx sdk.Context, contract types.ContractInfoV2) bool {
+	if !contract.NeedOrderMatching {
+		return false
+	}
+	hasExpiredOrders := false
+	for _, pair := range am.keeper.GetAllRegisteredPairs(ctx, contract.ContractAddr) {
+		if dexkeeperutils.CancelExpiredOrders(ctx, &am.keeper, types.ContractAddress(contract.ContractAddr), pair) {
+			hasExpiredOrders = true
+		}
+	}
+	return hasExpiredOrders
 }
 
 func (am AppModule) getPriceToDelete(
@@ -296,6 +323,21 @@ func (am AppModule) getPriceToDelete(
 				Store:     store,
 				PriceKeys: keysToDelete,
 			})
+			// volume buckets are keyed the same way as prices and share the same retention
+			volumeStore := prefix.NewStore(ctx.KVStore(am.keeper.GetStoreKey()), types.VolumePrefix(contract.ContractAddr, pair.PriceDenom, pair.AssetDenom))
+			result = append(result, &types.PriceStore{
+				Store:     volumeStore,
+				PriceKeys: am.keeper.GetPriceKeysToDelete(volumeStore, timestamp),
+			})
+			// candles are keyed by the begin timestamp of their bucket, so a candle is pruned
+			// once its bucket starts before the cutoff
+			for _, interval := range types.CandleIntervals {
+				candleStore := prefix.NewStore(ctx.KVStore(am.keeper.GetStoreKey()), types.CandlePrefix(contract.ContractAddr, pair.PriceDenom, pair.AssetDenom, interval))
+				result = append(result, &types.PriceStore{
+					Store:     candleStore,
+					PriceKeys: am.keeper.GetPriceKeysToDelete(candleStore, timestamp),
+				})
+			}
 		}
 	}
 	return result
@@ -352,5 +394,10 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) (ret []abc
 	}
 	telemetry.MeasureSince(endBlockerStartTime, am.Name(), "total_end_blocker_atomic")
 
+	// top up rent balances and warn about low rent balances after this block's rent has been charged
+	for _, rentConfig := range am.keeper.GetAllRentConfigs(ctx) {
+		am.keeper.ProcessRentBalance(ctx, rentConfig)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
@@ -323,6 +323,8 @@ func TestEndBlockLimitOrder(t *testing.T) {
 	matchResults, _ := dexkeeper.GetMatchResultState(ctx, contractAddr.String())
 	require.Equal(t, 2, len(matchResults.Orders))
 	require.Equal(t, 4, len(matchResults.Settlements))
+	// settlements are indexed as trades of the account
+	require.Equal(t, 4, len(dexkeeper.GetAllAccountTrades(ctx, contractAddr.String(), testAccount.String())))
 
 	dexutils.GetMemState(ctx.Context()).Clear(ctx)
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
@@ -354,6 +356,7 @@ func TestEndBlockLimitOrder(t *testing.T) {
 	matchResults, _ = dexkeeper.GetMatchResultState(ctx, contractAddr.String())
 	require.Equal(t, 1, len(matchResults.Orders))
 	require.Equal(t, 2, len(matchResults.Settlements))
+	require.Equal(t, 6, len(dexkeeper.GetAllAccountTrades(ctx, contractAddr.String(), testAccount.String())))
 }
 
 func TestEndBlockRollback(t *testing.T) {
@@ -389,6 +392,51 @@ func TestEndBlockRollback(t *testing.T) {
 	contract, err := dexkeeper.GetContract(ctx, keepertest.TestContract)
 	require.Nil(t, err)
 	require.True(t, contract.Suspended)
+	// and the suspension should be recorded
+	records := dexkeeper.GetAllSuspensionRecords(ctx, keepertest.TestContract)
+	require.Equal(t, 1, len(records))
+	require.Equal(t, int64(1), records[0].Height)
+	require.Equal(t, types.ContractFailurePhase_ORDER_PLACEMENT, records[0].Phase)
+	require.Equal(t, contract.SuspensionReason, records[0].Error)
+	require.Equal(t, uint64(1), records[0].OrderCount)
+	require.Equal(t, uint64(0), records[0].DepositCount)
+}
+
+func TestEndBlockRollbackOnCancellation(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(testApp.GetMemKey(types.MemStoreKey))))
+	dexkeeper := testApp.DexKeeper
+	pair := TEST_PAIR()
+	// register contract and pair
+	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: keepertest.TestContract, NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
+	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
+	// cancel an order of a nonexistent contract, so that the cancellation sudo call fails
+	dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(keepertest.TestContract), pair).Add(
+		&types.Cancellation{
+			Id:                1,
+			Initiator:         types.CancellationInitiator_USER,
+			Creator:           keepertest.TestAccount,
+			ContractAddr:      keepertest.TestContract,
+			Price:             sdk.MustNewDecFromStr("1"),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			PositionDirection: types.PositionDirection_LONG,
+		},
+	)
+	dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, keepertest.TestContract, dexkeeper.GetContractWithoutGasCharge)
+	ctx = ctx.WithBlockHeight(1)
+	testApp.EndBlocker(ctx, abci.RequestEndBlock{})
+	contract, err := dexkeeper.GetContract(ctx, keepertest.TestContract)
+	require.Nil(t, err)
+	require.True(t, contract.Suspended)
+	// the suspension is recorded as a failure of the cancellation sudo call
+	records := dexkeeper.GetAllSuspensionRecords(ctx, keepertest.TestContract)
+	require.Equal(t, 1, len(records))
+	require.Equal(t, types.ContractFailurePhase_CANCELLATION, records[0].Phase)
+	require.Equal(t, contract.SuspensionReason, records[0].Error)
+	require.Equal(t, uint64(1), records[0].CancellationCount)
+	require.Equal(t, uint64(0), records[0].OrderCount)
 }
 
 func TestEndBlockPartialRollback(t *testing.T) {
@@ -513,6 +561,36 @@ func TestBeginBlock(t *testing.T) {
 
 	// right now just make sure it doesn't crash since it doesn't register any state to be checked against
 	testApp.BeginBlocker(ctx, abci.RequestBeginBlock{})
+
+	// resting orders whose expiry time has passed are queued for cancellation
+	pair := types.Pair{PriceDenom: "SEI", AssetDenom: "ATOM"}
+	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
+	dexkeeper.SetLongBook(ctx, contractAddr.String(), types.LongBook{
+		Price: sdk.OneDec(),
+		Entry: &types.OrderEntry{
+			Price:       sdk.OneDec(),
+			Quantity:    sdk.OneDec(),
+			Allocations: []*types.Allocation{{OrderId: 1, Account: testAccount.String(), Quantity: sdk.OneDec()}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+	dexkeeper.SetExpiringOrder(ctx, contractAddr.String(), types.ExpiringOrder{
+		ExpiryTimestamp: uint64(ctx.BlockTime().Unix()) - 1,
+		Cancellation: types.Cancellation{
+			Id:                1,
+			Initiator:         types.CancellationInitiator_EXPIRED,
+			Creator:           testAccount.String(),
+			ContractAddr:      contractAddr.String(),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			PositionDirection: types.PositionDirection_LONG,
+			Price:             sdk.OneDec(),
+		},
+	})
+	testApp.BeginBlocker(ctx, abci.RequestBeginBlock{})
+	require.Equal(t, []uint64{1}, dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(contractAddr.String()), pair).GetIdsToCancel())
+	require.True(t, dexutils.GetMemState(ctx.Context()).GetContractToProcess().Contains(contractAddr.String()))
 }
 
 // Note that once the bug that causes EndBlock to panic is fixed, this test will need to be
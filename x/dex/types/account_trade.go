@@ -0,0 +1,7 @@
+package types
+
+// MaxAccountTrades is the number of most recent trades kept per account on a contract
+const MaxAccountTrades = 1000
+
+// AccountTradeRetentionInSeconds is how long the trades of an account are kept for
+const AccountTradeRetentionInSeconds = 30 * SecondsPerDay
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HaltedPairReason is the reason reported for orders that are cancelled because they can't wait
+// for matching of their pair to resume
+const HaltedPairReason = "pair is halted"
+
+func (c CircuitBreaker) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.ContractAddr); err != nil {
+		return fmt.Errorf("invalid contract address %s: %w", c.ContractAddr, err)
+	}
+	if c.PriceDenom == "" || c.AssetDenom == "" {
+		return errors.New("price and asset denoms of a circuit breaker must be set")
+	}
+	if c.MaxPriceDeviation.IsNil() || c.MaxPriceDeviation.IsNegative() {
+		return errors.New("max price deviation of a circuit breaker must not be negative")
+	}
+	if c.IsRemoval() {
+		return nil
+	}
+	if c.TwapLookbackSeconds == 0 {
+		return errors.New("twap lookback of a circuit breaker must be positive")
+	}
+	if c.CooldownSeconds == 0 {
+		return errors.New("cooldown of a circuit breaker must be positive")
+	}
+	return nil
+}
+
+// IsRemoval returns whether setting the circuit breaker removes the circuit breaker of its pair
+func (c CircuitBreaker) IsRemoval() bool {
+	return c.MaxPriceDeviation.IsZero()
+}
+
+// IsTripped returns whether `price` deviates from `twap` by more than the circuit breaker allows
+func (c CircuitBreaker) IsTripped(price sdk.Dec, twap sdk.Dec) bool {
+	if !twap.IsPositive() {
+		return false
+	}
+	return price.Sub(twap).Abs().Quo(twap).GT(c.MaxPriceDeviation)
+}
+
+// IsActive returns whether the pair is still halted at `timestamp`
+func (h PairHalt) IsActive(timestamp uint64) bool {
+	return timestamp < h.HaltedUntilTimestamp
+}
@@ -0,0 +1,998 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/circuit_breaker.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Halts matching of a pair for cooldownSeconds once the average clearing price of a block deviates
+// from the pair's TWAP over twapLookbackSeconds by more than maxPriceDeviation, expressed as a
+// fraction of the TWAP
+type CircuitBreaker struct {
+	ContractAddr        string                                 `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_addr"`
+	PriceDenom          string                                 `protobuf:"bytes,2,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom          string                                 `protobuf:"bytes,3,opt,name=assetDenom,proto3" json:"asset_denom"`
+	MaxPriceDeviation   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation"`
+	TwapLookbackSeconds uint64                                 `protobuf:"varint,5,opt,name=twapLookbackSeconds,proto3" json:"twap_lookback_seconds"`
+	CooldownSeconds     uint64                                 `protobuf:"varint,6,opt,name=cooldownSeconds,proto3" json:"cooldown_seconds"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_459ae5de7f394a04, []int{0}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetTwapLookbackSeconds() uint64 {
+	if m != nil {
+		return m.TwapLookbackSeconds
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetCooldownSeconds() uint64 {
+	if m != nil {
+		return m.CooldownSeconds
+	}
+	return 0
+}
+
+// A pair whose matching is halted by its circuit breaker
+type PairHalt struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_addr"`
+	PriceDenom   string `protobuf:"bytes,2,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom   string `protobuf:"bytes,3,opt,name=assetDenom,proto3" json:"asset_denom"`
+	// the average clearing price that tripped the circuit breaker
+	Price             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Twap              github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
+	HaltedAtTimestamp uint64                                 `protobuf:"varint,6,opt,name=haltedAtTimestamp,proto3" json:"halted_at_timestamp"`
+	// matching resumes in the first block at or after this timestamp
+	HaltedUntilTimestamp uint64 `protobuf:"varint,7,opt,name=haltedUntilTimestamp,proto3" json:"halted_until_timestamp"`
+}
+
+func (m *PairHalt) Reset()         { *m = PairHalt{} }
+func (m *PairHalt) String() string { return proto.CompactTextString(m) }
+func (*PairHalt) ProtoMessage()    {}
+func (*PairHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_459ae5de7f394a04, []int{1}
+}
+func (m *PairHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairHalt.Merge(m, src)
+}
+func (m *PairHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairHalt proto.InternalMessageInfo
+
+func (m *PairHalt) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *PairHalt) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *PairHalt) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *PairHalt) GetHaltedAtTimestamp() uint64 {
+	if m != nil {
+		return m.HaltedAtTimestamp
+	}
+	return 0
+}
+
+func (m *PairHalt) GetHaltedUntilTimestamp() uint64 {
+	if m != nil {
+		return m.HaltedUntilTimestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CircuitBreaker)(nil), "seiprotocol.seichain.dex.CircuitBreaker")
+	proto.RegisterType((*PairHalt)(nil), "seiprotocol.seichain.dex.PairHalt")
+}
+
+func init() { proto.RegisterFile("dex/circuit_breaker.proto", fileDescriptor_459ae5de7f394a04) }
+
+var fileDescriptor_459ae5de7f394a04 = []byte{
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0xda, 0x0d, 0x66, 0xfe, 0x8c, 0x66, 0x05, 0xb2, 0x1d, 0x92, 0x69, 0x07, 0xb4,
+	0x4b, 0x93, 0x03, 0x02, 0x6e, 0x48, 0x0b, 0x9b, 0x40, 0xfc, 0xd3, 0x14, 0xe0, 0xc2, 0x25, 0x72,
+	0x6d, 0xab, 0xb5, 0x9a, 0xe4, 0x8d, 0x6c, 0x97, 0x85, 0x6f, 0xc1, 0xf7, 0xe0, 0x8b, 0xec, 0xb8,
+	0x0b, 0x12, 0xe2, 0x10, 0xa1, 0xf6, 0x96, 0x4f, 0x81, 0x62, 0x27, 0xb4, 0xb0, 0x5d, 0x7a, 0xe3,
+	0x12, 0x3b, 0xef, 0xf3, 0x3c, 0x3f, 0xc5, 0x7e, 0x63, 0xa3, 0x5d, 0xca, 0x8a, 0x80, 0x70, 0x41,
+	0x66, 0x5c, 0xc5, 0x23, 0xc1, 0xf0, 0x94, 0x09, 0x3f, 0x17, 0xa0, 0xc0, 0x76, 0x24, 0xe3, 0x7a,
+	0x46, 0x20, 0xf1, 0x25, 0xe3, 0x64, 0x82, 0x79, 0xe6, 0x53, 0x56, 0xec, 0x0d, 0xc6, 0x30, 0x06,
+	0x2d, 0x05, 0xf5, 0xcc, 0xf8, 0x0f, 0xbe, 0x75, 0xd1, 0x9d, 0xe7, 0x86, 0x14, 0x1a, 0x90, 0xfd,
+	0x18, 0xdd, 0x22, 0x90, 0x29, 0x81, 0x89, 0x3a, 0xa2, 0x54, 0x38, 0xd6, 0xbe, 0x75, 0xb8, 0x15,
+	0xf6, 0xab, 0xd2, 0xbb, 0xdd, 0xd6, 0x63, 0x4c, 0xa9, 0x88, 0xfe, 0xb2, 0xd9, 0x01, 0x42, 0xb9,
+	0xe0, 0x84, 0x1d, 0xb3, 0x0c, 0x52, 0xe7, 0x9a, 0x0e, 0x6d, 0x57, 0xa5, 0x77, 0x53, 0x57, 0x63,
+	0x5a, 0x97, 0xa3, 0x15, 0x4b, 0x1d, 0xc0, 0x52, 0x32, 0x65, 0x02, 0xdd, 0x65, 0x40, 0x57, 0xdb,
+	0xc0, 0xd2, 0x62, 0x4b, 0xd4, 0x4f, 0x71, 0x71, 0x6a, 0x08, 0x9f, 0x39, 0x56, 0x1c, 0x32, 0xa7,
+	0xa7, 0x73, 0x27, 0xe7, 0xa5, 0xd7, 0xf9, 0x59, 0x7a, 0x0f, 0xc7, 0x5c, 0x4d, 0x66, 0x23, 0x9f,
+	0x40, 0x1a, 0x10, 0x90, 0x29, 0xc8, 0x66, 0x18, 0x4a, 0x3a, 0x0d, 0xd4, 0x97, 0x9c, 0x49, 0xff,
+	0x98, 0x91, 0xaa, 0xf4, 0x76, 0x52, 0x5c, 0xc4, 0xed, 0xa7, 0x35, 0xb0, 0xe8, 0x32, 0xdf, 0x7e,
+	0x8d, 0x76, 0xd4, 0x19, 0xce, 0xdf, 0x00, 0x4c, 0x47, 0x98, 0x4c, 0xdf, 0x33, 0x02, 0x19, 0x95,
+	0xce, 0xc6, 0xbe, 0x75, 0xd8, 0x0b, 0x77, 0xab, 0xd2, 0xbb, 0x57, 0xcb, 0x71, 0xd2, 0xe8, 0xb1,
+	0x34, 0x86, 0xe8, 0xaa, 0x94, 0xfd, 0x0c, 0x6d, 0x13, 0x80, 0x84, 0xc2, 0x59, 0xd6, 0x82, 0x36,
+	0x35, 0x68, 0x50, 0x95, 0xde, 0xdd, 0x56, 0xfa, 0xc3, 0xf8, 0xd7, 0x7c, 0xf0, 0xbd, 0x8b, 0x6e,
+	0x9c, 0x62, 0x2e, 0x5e, 0xe2, 0x44, 0xfd, 0xbf, 0x7d, 0x7a, 0x8b, 0x36, 0x74, 0xbc, 0xe9, 0xcd,
+	0xd3, 0xb5, 0x7b, 0x63, 0xe2, 0x91, 0x19, 0xec, 0x57, 0xa8, 0x57, 0xef, 0xa5, 0xde, 0xf2, 0xad,
+	0xf0, 0xc9, 0xda, 0x34, 0x9d, 0x8e, 0xf4, 0xd3, 0x3e, 0x41, 0xfd, 0x09, 0x4e, 0x14, 0xa3, 0x47,
+	0xea, 0x03, 0x4f, 0x99, 0x54, 0x38, 0xcd, 0x9b, 0x16, 0x3c, 0xa8, 0x7f, 0x0a, 0x23, 0xc6, 0x58,
+	0xc5, 0xaa, 0x95, 0xa3, 0xcb, 0x09, 0xfb, 0x1d, 0x1a, 0x98, 0xe2, 0xc7, 0x4c, 0xf1, 0x64, 0x49,
+	0xba, 0xae, 0x49, 0x7b, 0x55, 0xe9, 0xdd, 0x6f, 0x48, 0xb3, 0xda, 0xb0, 0x02, 0xbb, 0x32, 0x17,
+	0xbe, 0x38, 0x9f, 0xbb, 0xd6, 0xc5, 0xdc, 0xb5, 0x7e, 0xcd, 0x5d, 0xeb, 0xeb, 0xc2, 0xed, 0x5c,
+	0x2c, 0xdc, 0xce, 0x8f, 0x85, 0xdb, 0xf9, 0x34, 0x5c, 0x59, 0xa6, 0x64, 0x7c, 0xd8, 0x9e, 0x6d,
+	0xfd, 0xa2, 0x0f, 0x77, 0x50, 0x04, 0xf5, 0x75, 0xa0, 0x57, 0x3c, 0xda, 0xd4, 0xfa, 0xa3, 0xdf,
+	0x03, 0x00, 0x14, 0x6e, 0x64, 0xd7, 0x22, 0x04, 0x00, 0x00,
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CooldownSeconds != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.CooldownSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TwapLookbackSeconds != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.TwapLookbackSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaltedUntilTimestamp != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.HaltedUntilTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HaltedAtTimestamp != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.HaltedAtTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitBreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitBreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	if m.TwapLookbackSeconds != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.TwapLookbackSeconds))
+	}
+	if m.CooldownSeconds != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.CooldownSeconds))
+	}
+	return n
+}
+
+func (m *PairHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	l = m.Twap.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	if m.HaltedAtTimestamp != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.HaltedAtTimestamp))
+	}
+	if m.HaltedUntilTimestamp != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.HaltedUntilTimestamp))
+	}
+	return n
+}
+
+func sovCircuitBreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitBreaker(x uint64) (n int) {
+	return sovCircuitBreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackSeconds", wireType)
+			}
+			m.TwapLookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapLookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownSeconds", wireType)
+			}
+			m.CooldownSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CooldownSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairHalt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairHalt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedAtTimestamp", wireType)
+			}
+			m.HaltedAtTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltedAtTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedUntilTimestamp", wireType)
+			}
+			m.HaltedUntilTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltedUntilTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitBreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitBreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitBreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitBreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitBreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitBreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitBreaker = fmt.Errorf("proto: unexpected end of group")
+)
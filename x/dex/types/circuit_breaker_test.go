@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testCircuitBreaker() types.CircuitBreaker {
+	return types.CircuitBreaker{
+		ContractAddr:        keepertest.TestContract,
+		PriceDenom:          keepertest.TestPriceDenom,
+		AssetDenom:          keepertest.TestAssetDenom,
+		MaxPriceDeviation:   sdk.MustNewDecFromStr("0.1"),
+		TwapLookbackSeconds: 600,
+		CooldownSeconds:     60,
+	}
+}
+
+func TestCircuitBreakerValidate(t *testing.T) {
+	require.Nil(t, testCircuitBreaker().Validate())
+
+	circuitBreaker := testCircuitBreaker()
+	circuitBreaker.ContractAddr = "invalid"
+	require.NotNil(t, circuitBreaker.Validate())
+
+	circuitBreaker = testCircuitBreaker()
+	circuitBreaker.MaxPriceDeviation = sdk.NewDec(-1)
+	require.NotNil(t, circuitBreaker.Validate())
+
+	circuitBreaker = testCircuitBreaker()
+	circuitBreaker.CooldownSeconds = 0
+	require.NotNil(t, circuitBreaker.Validate())
+
+	circuitBreaker = testCircuitBreaker()
+	circuitBreaker.TwapLookbackSeconds = 0
+	require.NotNil(t, circuitBreaker.Validate())
+
+	// removals don't need a lookback or cooldown
+	circuitBreaker = types.CircuitBreaker{
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		MaxPriceDeviation: sdk.ZeroDec(),
+	}
+	require.True(t, circuitBreaker.IsRemoval())
+	require.Nil(t, circuitBreaker.Validate())
+}
+
+func TestCircuitBreakerIsTripped(t *testing.T) {
+	circuitBreaker := testCircuitBreaker()
+	require.False(t, circuitBreaker.IsTripped(sdk.NewDec(110), sdk.NewDec(100)))
+	require.True(t, circuitBreaker.IsTripped(sdk.NewDec(111), sdk.NewDec(100)))
+	require.False(t, circuitBreaker.IsTripped(sdk.NewDec(90), sdk.NewDec(100)))
+	require.True(t, circuitBreaker.IsTripped(sdk.NewDec(89), sdk.NewDec(100)))
+	// there is nothing to compare against without a TWAP
+	require.False(t, circuitBreaker.IsTripped(sdk.NewDec(1000), sdk.ZeroDec()))
+}
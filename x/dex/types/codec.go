@@ -17,9 +17,20 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdatePriceTickSize{}, "dex/MsgUpdatePriceTickSize", nil)
 	cdc.RegisterConcrete(&MsgUpdateQuantityTickSize{}, "dex/MsgUpdateQuantityTickSize", nil)
 	cdc.RegisterConcrete(&AddAssetMetadataProposal{}, "dex/AddAssetMetadataProposal", nil)
+	cdc.RegisterConcrete(&UpdateFeeScheduleProposal{}, "dex/UpdateFeeScheduleProposal", nil)
+	cdc.RegisterConcrete(&UpdateCircuitBreakerProposal{}, "dex/UpdateCircuitBreakerProposal", nil)
+	cdc.RegisterConcrete(&RegisterPairsProposal{}, "dex/RegisterPairsProposal", nil)
+	cdc.RegisterConcrete(&UpdateTickSizeProposal{}, "dex/UpdateTickSizeProposal", nil)
+	cdc.RegisterConcrete(&DelistPairsProposal{}, "dex/DelistPairsProposal", nil)
+	cdc.RegisterConcrete(&SuspendContractProposal{}, "dex/SuspendContractProposal", nil)
+	cdc.RegisterConcrete(&UnsuspendContractProposal{}, "dex/UnsuspendContractProposal", nil)
 	cdc.RegisterConcrete(&MsgUnregisterContract{}, "dex/MsgUnregisterContract", nil)
 	cdc.RegisterConcrete(&MsgContractDepositRent{}, "dex/MsgContractDepositRent", nil)
 	cdc.RegisterConcrete(&MsgUnsuspendContract{}, "dex/MsgUnsuspendContract", nil)
+	cdc.RegisterConcrete(&MsgCancelAll{}, "dex/MsgCancelAll", nil)
+	cdc.RegisterConcrete(&MsgCancelReplace{}, "dex/MsgCancelReplace", nil)
+	cdc.RegisterConcrete(&MsgSetRentLowBalanceThresholds{}, "dex/MsgSetRentLowBalanceThresholds", nil)
+	cdc.RegisterConcrete(&MsgSetRentAutoTopup{}, "dex/MsgSetRentAutoTopup", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -45,6 +56,27 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&AddAssetMetadataProposal{},
 	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdateFeeScheduleProposal{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdateCircuitBreakerProposal{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&RegisterPairsProposal{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdateTickSizeProposal{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&DelistPairsProposal{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&SuspendContractProposal{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UnsuspendContractProposal{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnregisterContract{},
 	)
@@ -54,6 +86,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnsuspendContract{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelAll{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelReplace{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetRentLowBalanceThresholds{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetRentAutoTopup{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -20,6 +20,35 @@ func GetOrderTypeFromStr(str string) (OrderType, error) {
 	return OrderType(val), err
 }
 
+// CandleIntervals lists all intervals for which candles are maintained
+var CandleIntervals = []CandleInterval{
+	CandleInterval_ONE_MINUTE,
+	CandleInterval_FIVE_MINUTES,
+	CandleInterval_ONE_HOUR,
+	CandleInterval_ONE_DAY,
+}
+
+func GetCandleIntervalFromStr(str string) (CandleInterval, error) {
+	val, err := getEnumFromStr(str, CandleInterval_value)
+	return CandleInterval(val), err
+}
+
+// GetCandleIntervalInSeconds returns the length of a candle bucket of the given interval
+func GetCandleIntervalInSeconds(interval CandleInterval) (uint64, error) {
+	switch interval {
+	case CandleInterval_ONE_MINUTE:
+		return 60, nil
+	case CandleInterval_FIVE_MINUTES:
+		return 5 * 60, nil
+	case CandleInterval_ONE_HOUR:
+		return 3600, nil
+	case CandleInterval_ONE_DAY:
+		return 24 * 3600, nil
+	default:
+		return 0, fmt.Errorf("unknown candle interval: %d", interval)
+	}
+}
+
 func getEnumFromStr(str string, enumMap map[string]int32) (int32, error) {
 	upperStr := strings.ToUpper(str)
 	if val, ok := enumMap[upperStr]; ok {
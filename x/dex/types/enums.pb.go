@@ -172,18 +172,24 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 type CancellationInitiator int32
 
 const (
-	CancellationInitiator_USER       CancellationInitiator = 0
-	CancellationInitiator_LIQUIDATED CancellationInitiator = 1
+	CancellationInitiator_USER                  CancellationInitiator = 0
+	CancellationInitiator_LIQUIDATED            CancellationInitiator = 1
+	CancellationInitiator_EXPIRED               CancellationInitiator = 2
+	CancellationInitiator_SELF_TRADE_PREVENTION CancellationInitiator = 3
 )
 
 var CancellationInitiator_name = map[int32]string{
 	0: "USER",
 	1: "LIQUIDATED",
+	2: "EXPIRED",
+	3: "SELF_TRADE_PREVENTION",
 }
 
 var CancellationInitiator_value = map[string]int32{
-	"USER":       0,
-	"LIQUIDATED": 1,
+	"USER":                  0,
+	"LIQUIDATED":            1,
+	"EXPIRED":               2,
+	"SELF_TRADE_PREVENTION": 3,
 }
 
 func (x CancellationInitiator) String() string {
@@ -194,6 +200,172 @@ func (CancellationInitiator) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b8c5bb23c6eb0b88, []int{5}
 }
 
+type TimeInForce int32
+
+const (
+	TimeInForce_GOOD_TILL_CANCEL    TimeInForce = 0
+	TimeInForce_IMMEDIATE_OR_CANCEL TimeInForce = 1
+	TimeInForce_GOOD_TILL_HEIGHT    TimeInForce = 2
+	TimeInForce_GOOD_TILL_TIME      TimeInForce = 3
+)
+
+var TimeInForce_name = map[int32]string{
+	0: "GOOD_TILL_CANCEL",
+	1: "IMMEDIATE_OR_CANCEL",
+	2: "GOOD_TILL_HEIGHT",
+	3: "GOOD_TILL_TIME",
+}
+
+var TimeInForce_value = map[string]int32{
+	"GOOD_TILL_CANCEL":    0,
+	"IMMEDIATE_OR_CANCEL": 1,
+	"GOOD_TILL_HEIGHT":    2,
+	"GOOD_TILL_TIME":      3,
+}
+
+func (x TimeInForce) String() string {
+	return proto.EnumName(TimeInForce_name, int32(x))
+}
+
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{6}
+}
+
+type CandleInterval int32
+
+const (
+	CandleInterval_ONE_MINUTE   CandleInterval = 0
+	CandleInterval_FIVE_MINUTES CandleInterval = 1
+	CandleInterval_ONE_HOUR     CandleInterval = 2
+	CandleInterval_ONE_DAY      CandleInterval = 3
+)
+
+var CandleInterval_name = map[int32]string{
+	0: "ONE_MINUTE",
+	1: "FIVE_MINUTES",
+	2: "ONE_HOUR",
+	3: "ONE_DAY",
+}
+
+var CandleInterval_value = map[string]int32{
+	"ONE_MINUTE":   0,
+	"FIVE_MINUTES": 1,
+	"ONE_HOUR":     2,
+	"ONE_DAY":      3,
+}
+
+func (x CandleInterval) String() string {
+	return proto.EnumName(CandleInterval_name, int32(x))
+}
+
+func (CandleInterval) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{7}
+}
+
+type MatchingPolicy int32
+
+const (
+	MatchingPolicy_FIFO                             MatchingPolicy = 0
+	MatchingPolicy_PRO_RATA                         MatchingPolicy = 1
+	MatchingPolicy_PRO_RATA_WITH_TOP_OF_QUEUE_BONUS MatchingPolicy = 2
+)
+
+var MatchingPolicy_name = map[int32]string{
+	0: "FIFO",
+	1: "PRO_RATA",
+	2: "PRO_RATA_WITH_TOP_OF_QUEUE_BONUS",
+}
+
+var MatchingPolicy_value = map[string]int32{
+	"FIFO":                             0,
+	"PRO_RATA":                         1,
+	"PRO_RATA_WITH_TOP_OF_QUEUE_BONUS": 2,
+}
+
+func (x MatchingPolicy) String() string {
+	return proto.EnumName(MatchingPolicy_name, int32(x))
+}
+
+func (MatchingPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{8}
+}
+
+// what happens when an order would match against an order of the same account
+type SelfTradePrevention int32
+
+const (
+	SelfTradePrevention_NONE                 SelfTradePrevention = 0
+	SelfTradePrevention_CANCEL_TAKER         SelfTradePrevention = 1
+	SelfTradePrevention_CANCEL_MAKER         SelfTradePrevention = 2
+	SelfTradePrevention_CANCEL_BOTH          SelfTradePrevention = 3
+	SelfTradePrevention_DECREMENT_AND_CANCEL SelfTradePrevention = 4
+)
+
+var SelfTradePrevention_name = map[int32]string{
+	0: "NONE",
+	1: "CANCEL_TAKER",
+	2: "CANCEL_MAKER",
+	3: "CANCEL_BOTH",
+	4: "DECREMENT_AND_CANCEL",
+}
+
+var SelfTradePrevention_value = map[string]int32{
+	"NONE":                 0,
+	"CANCEL_TAKER":         1,
+	"CANCEL_MAKER":         2,
+	"CANCEL_BOTH":          3,
+	"DECREMENT_AND_CANCEL": 4,
+}
+
+func (x SelfTradePrevention) String() string {
+	return proto.EnumName(SelfTradePrevention_name, int32(x))
+}
+
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{9}
+}
+
+// the phase of the dex EndBlock in which a contract failed
+type ContractFailurePhase int32
+
+const (
+	ContractFailurePhase_DEPOSIT                   ContractFailurePhase = 0
+	ContractFailurePhase_ORDER_PLACEMENT           ContractFailurePhase = 1
+	ContractFailurePhase_SETTLEMENT                ContractFailurePhase = 2
+	ContractFailurePhase_MARKET_ORDER_CANCELLATION ContractFailurePhase = 3
+	ContractFailurePhase_PANIC                     ContractFailurePhase = 4
+	ContractFailurePhase_INTERNAL                  ContractFailurePhase = 5
+	ContractFailurePhase_CANCELLATION              ContractFailurePhase = 6
+)
+
+var ContractFailurePhase_name = map[int32]string{
+	0: "DEPOSIT",
+	1: "ORDER_PLACEMENT",
+	2: "SETTLEMENT",
+	3: "MARKET_ORDER_CANCELLATION",
+	4: "PANIC",
+	5: "INTERNAL",
+	6: "CANCELLATION",
+}
+
+var ContractFailurePhase_value = map[string]int32{
+	"DEPOSIT":                   0,
+	"ORDER_PLACEMENT":           1,
+	"SETTLEMENT":                2,
+	"MARKET_ORDER_CANCELLATION": 3,
+	"PANIC":                     4,
+	"INTERNAL":                  5,
+	"CANCELLATION":              6,
+}
+
+func (x ContractFailurePhase) String() string {
+	return proto.EnumName(ContractFailurePhase_name, int32(x))
+}
+
+func (ContractFailurePhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{10}
+}
+
 func init() {
 	proto.RegisterEnum("seiprotocol.seichain.dex.PositionDirection", PositionDirection_name, PositionDirection_value)
 	proto.RegisterEnum("seiprotocol.seichain.dex.PositionEffect", PositionEffect_name, PositionEffect_value)
@@ -201,35 +373,61 @@ func init() {
 	proto.RegisterEnum("seiprotocol.seichain.dex.Unit", Unit_name, Unit_value)
 	proto.RegisterEnum("seiprotocol.seichain.dex.OrderStatus", OrderStatus_name, OrderStatus_value)
 	proto.RegisterEnum("seiprotocol.seichain.dex.CancellationInitiator", CancellationInitiator_name, CancellationInitiator_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.CandleInterval", CandleInterval_name, CandleInterval_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.MatchingPolicy", MatchingPolicy_name, MatchingPolicy_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.SelfTradePrevention", SelfTradePrevention_name, SelfTradePrevention_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.ContractFailurePhase", ContractFailurePhase_name, ContractFailurePhase_value)
 }
 
 func init() { proto.RegisterFile("dex/enums.proto", fileDescriptor_b8c5bb23c6eb0b88) }
 
 var fileDescriptor_b8c5bb23c6eb0b88 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xdf, 0x6e, 0xd3, 0x30,
-	0x18, 0xc5, 0xe3, 0xb5, 0xab, 0xd6, 0x0f, 0xd8, 0x8c, 0x01, 0x89, 0xab, 0xdc, 0x21, 0xa1, 0x48,
-	0x6b, 0x84, 0xe0, 0x05, 0xbc, 0xc4, 0x1d, 0xd6, 0xdc, 0x38, 0xe4, 0x0f, 0x12, 0xdc, 0x4c, 0x59,
-	0xe2, 0x31, 0x4b, 0x5d, 0x52, 0x25, 0xae, 0xd4, 0xbd, 0x05, 0x8f, 0xc5, 0xe5, 0x2e, 0xb9, 0x44,
-	0xed, 0x8b, 0x20, 0x3b, 0x8c, 0xbb, 0x73, 0x3e, 0x9f, 0xcf, 0xfe, 0xc9, 0x07, 0xce, 0x1a, 0xb5,
-	0x0b, 0x55, 0xbb, 0xbd, 0x1f, 0x16, 0x9b, 0xbe, 0x33, 0x1d, 0x79, 0x3b, 0x28, 0xed, 0x54, 0xdd,
-	0xad, 0x17, 0x83, 0xd2, 0xf5, 0x5d, 0xa5, 0xdb, 0x45, 0xa3, 0x76, 0xc1, 0x7b, 0x78, 0x99, 0x76,
-	0x83, 0x36, 0xba, 0x6b, 0x63, 0xdd, 0xab, 0xda, 0x0a, 0x72, 0x02, 0x53, 0x21, 0x93, 0x4b, 0xec,
-	0x91, 0x39, 0x1c, 0xe7, 0x9f, 0x65, 0x56, 0x60, 0x14, 0xbc, 0x83, 0xd3, 0xa7, 0x24, 0xbb, 0xbd,
-	0x55, 0xb5, 0xb1, 0x31, 0x99, 0xb2, 0x64, 0x8c, 0x45, 0x42, 0xe6, 0x0c, 0xa3, 0xa0, 0x81, 0xb9,
-	0xec, 0x1b, 0xd5, 0x17, 0x0f, 0x1b, 0x65, 0xe7, 0x82, 0xaf, 0x78, 0x81, 0x3d, 0x02, 0x30, 0x5b,
-	0xd1, 0xec, 0x8a, 0x15, 0x18, 0x91, 0x17, 0x30, 0x5f, 0xca, 0xab, 0x7f, 0x76, 0x42, 0x5e, 0x03,
-	0xfe, 0x6f, 0x2f, 0xbe, 0x7d, 0xa5, 0xa2, 0x64, 0x78, 0x4a, 0x9e, 0xc3, 0x49, 0x5e, 0xc8, 0x54,
-	0xc8, 0x3c, 0xc7, 0xc7, 0x76, 0xc5, 0x39, 0x77, 0xdb, 0x2c, 0xf8, 0x04, 0xd3, 0xb2, 0xd5, 0x66,
-	0x0c, 0xd1, 0x24, 0xa6, 0x59, 0x3c, 0x62, 0xac, 0xb8, 0x10, 0x1c, 0xa3, 0x51, 0x46, 0x99, 0xc4,
-	0x47, 0x16, 0x33, 0xa1, 0x89, 0xc4, 0x93, 0x40, 0xc0, 0x33, 0xc7, 0x96, 0x9b, 0xca, 0x6c, 0x07,
-	0x8b, 0x94, 0x0a, 0x1a, 0x31, 0xbb, 0xfa, 0x0a, 0xce, 0x96, 0x94, 0x0b, 0x16, 0x5f, 0x17, 0xf2,
-	0xda, 0x4d, 0x47, 0xce, 0x88, 0x26, 0x11, 0x13, 0x82, 0xc5, 0xf8, 0xc8, 0x61, 0x97, 0x62, 0xc9,
-	0x9d, 0x9d, 0x04, 0x1f, 0xe0, 0x4d, 0x54, 0xb5, 0xb5, 0x5a, 0xaf, 0x2b, 0xfb, 0x29, 0xbc, 0xd5,
-	0x46, 0x57, 0xa6, 0xeb, 0xed, 0x83, 0x65, 0xce, 0x32, 0xec, 0x91, 0x53, 0x00, 0xc1, 0xbf, 0x94,
-	0x3c, 0xa6, 0x05, 0x8b, 0x31, 0xba, 0xb8, 0xfc, 0xb5, 0xf7, 0xd1, 0xe3, 0xde, 0x47, 0x7f, 0xf6,
-	0x3e, 0xfa, 0x79, 0xf0, 0xbd, 0xc7, 0x83, 0xef, 0xfd, 0x3e, 0xf8, 0xde, 0xf7, 0xf3, 0x1f, 0xda,
-	0xdc, 0x6d, 0x6f, 0x16, 0x75, 0x77, 0x1f, 0x0e, 0x4a, 0x9f, 0x3f, 0xb5, 0xe5, 0x8c, 0xab, 0x2b,
-	0xdc, 0x85, 0xb6, 0x56, 0xf3, 0xb0, 0x51, 0xc3, 0xcd, 0xcc, 0x9d, 0x7f, 0xfc, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x40, 0x7d, 0x48, 0x4c, 0xea, 0x01, 0x00, 0x00,
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x54, 0xc1, 0x6e, 0xdc, 0x36,
+	0x14, 0x94, 0x76, 0x6d, 0x37, 0xa6, 0xd3, 0x35, 0x4b, 0x3b, 0x68, 0x72, 0xe8, 0xa2, 0x87, 0x16,
+	0x28, 0x04, 0xc4, 0x3e, 0xb4, 0x3f, 0x40, 0x4b, 0x4f, 0x5e, 0xc2, 0x14, 0xa9, 0x50, 0x94, 0x5b,
+	0xf7, 0x42, 0x28, 0x5a, 0x3a, 0x16, 0x20, 0x4b, 0x86, 0x24, 0x07, 0xf6, 0x5f, 0x14, 0xfd, 0xaa,
+	0x1e, 0x73, 0xec, 0xb1, 0xb0, 0x7f, 0xa4, 0xa0, 0xb4, 0xdb, 0x34, 0x37, 0xce, 0x70, 0xc8, 0x9d,
+	0x37, 0x3b, 0x14, 0x3a, 0x5c, 0xdb, 0x87, 0x53, 0xdb, 0xdc, 0xdf, 0xf6, 0x27, 0x77, 0x5d, 0x3b,
+	0xb4, 0xe4, 0x75, 0x6f, 0xab, 0x71, 0x55, 0xb6, 0xf5, 0x49, 0x6f, 0xab, 0xf2, 0xa6, 0xa8, 0x9a,
+	0x93, 0xb5, 0x7d, 0x08, 0x7e, 0x42, 0xdf, 0xa4, 0x6d, 0x5f, 0x0d, 0x55, 0xdb, 0x44, 0x55, 0x67,
+	0x4b, 0xb7, 0x20, 0x2f, 0xd0, 0x0e, 0x97, 0xe2, 0x1c, 0x7b, 0x64, 0x1f, 0xed, 0x66, 0x2b, 0xa9,
+	0x34, 0xf6, 0x83, 0x1f, 0xd1, 0x62, 0xab, 0x84, 0xeb, 0x6b, 0x5b, 0x0e, 0x4e, 0x26, 0x53, 0x10,
+	0x93, 0x2c, 0xe4, 0x32, 0x03, 0xec, 0x07, 0x6b, 0xb4, 0x2f, 0xbb, 0xb5, 0xed, 0xf4, 0xe3, 0x9d,
+	0x75, 0x3c, 0x67, 0x09, 0xd3, 0xd8, 0x23, 0x08, 0xed, 0x25, 0x54, 0x5d, 0x80, 0xc6, 0x3e, 0xf9,
+	0x1a, 0xed, 0xc7, 0xf2, 0x62, 0x03, 0xe7, 0xe4, 0x18, 0xe1, 0xff, 0xe0, 0xd9, 0xd5, 0x25, 0xe5,
+	0x39, 0xe0, 0x1d, 0xf2, 0x12, 0xbd, 0xc8, 0xb4, 0x4c, 0xb9, 0xcc, 0x32, 0xbc, 0xeb, 0x8e, 0x8c,
+	0x68, 0xbc, 0x6d, 0x2f, 0xf8, 0x05, 0xed, 0xe4, 0x4d, 0x35, 0x4c, 0x22, 0x2a, 0x22, 0xaa, 0xa2,
+	0xc9, 0x46, 0xc2, 0x38, 0x67, 0xd8, 0x9f, 0x96, 0xa1, 0x92, 0x78, 0xe6, 0x6c, 0x0a, 0x2a, 0x24,
+	0x9e, 0x07, 0x1c, 0x1d, 0x8c, 0xde, 0xb2, 0xa1, 0x18, 0xee, 0x7b, 0x67, 0x29, 0xe5, 0x34, 0x04,
+	0x77, 0xf4, 0x08, 0x1d, 0xc6, 0x94, 0x71, 0x88, 0x8c, 0x96, 0x66, 0x64, 0x27, 0x9f, 0x21, 0x15,
+	0x21, 0x70, 0x0e, 0x11, 0x9e, 0x8d, 0xb6, 0x73, 0x1e, 0xb3, 0x11, 0xce, 0x83, 0x2b, 0xf4, 0x2a,
+	0x2c, 0x9a, 0xd2, 0xd6, 0x75, 0xe1, 0x42, 0x61, 0x4d, 0x35, 0x54, 0xc5, 0xd0, 0x76, 0xee, 0x07,
+	0xf3, 0x0c, 0x14, 0xf6, 0xc8, 0x02, 0x21, 0xce, 0xde, 0xe5, 0x2c, 0xa2, 0x1a, 0x22, 0xec, 0x93,
+	0x03, 0xf4, 0x15, 0xfc, 0x96, 0x32, 0x35, 0x5e, 0xf7, 0x06, 0xbd, 0xca, 0x80, 0xc7, 0x46, 0x2b,
+	0x1a, 0x81, 0x49, 0x15, 0x5c, 0x82, 0xd0, 0x4c, 0x0a, 0x3c, 0x0f, 0xae, 0xd1, 0x81, 0xae, 0x6e,
+	0x2d, 0x6b, 0xe2, 0xb6, 0x2b, 0xad, 0x0b, 0xe8, 0x5c, 0xca, 0xc8, 0x68, 0xc6, 0xb9, 0x99, 0x1c,
+	0x61, 0x8f, 0x7c, 0x8b, 0x8e, 0x58, 0x92, 0x40, 0xc4, 0xa8, 0x06, 0x23, 0xd5, 0x76, 0xc3, 0xff,
+	0x52, 0xbe, 0x02, 0x76, 0xbe, 0xd2, 0x78, 0x46, 0x08, 0x5a, 0x7c, 0x66, 0x35, 0x4b, 0x00, 0xcf,
+	0x83, 0x04, 0x2d, 0xc2, 0xa2, 0x59, 0xd7, 0x96, 0x35, 0x83, 0xed, 0x3e, 0x16, 0xb5, 0x73, 0x2c,
+	0x05, 0x98, 0x84, 0x89, 0x5c, 0x03, 0xf6, 0x08, 0x46, 0x2f, 0x63, 0x76, 0xb9, 0x25, 0x32, 0xec,
+	0xbb, 0xc8, 0x9d, 0x62, 0x25, 0x73, 0x85, 0x67, 0x6e, 0x22, 0x87, 0x22, 0x7a, 0x85, 0xe7, 0x81,
+	0x40, 0x8b, 0xa4, 0x18, 0xca, 0x9b, 0xaa, 0xf9, 0x90, 0xb6, 0x75, 0x55, 0x3e, 0xba, 0x28, 0x62,
+	0x16, 0x4b, 0xec, 0xb9, 0x63, 0xa9, 0x92, 0x46, 0x51, 0x4d, 0xb1, 0x4f, 0x7e, 0x40, 0xdf, 0x6f,
+	0x91, 0xf9, 0x95, 0xe9, 0x95, 0xd1, 0x32, 0x35, 0x32, 0x36, 0xef, 0x72, 0xc8, 0xc1, 0x9c, 0x49,
+	0x91, 0x67, 0x78, 0x16, 0x34, 0xe8, 0x28, 0xb3, 0xf5, 0xb5, 0xee, 0x8a, 0xb5, 0x4d, 0x3b, 0xfb,
+	0xd1, 0x36, 0xdb, 0x7a, 0x0a, 0x29, 0x36, 0xee, 0xa6, 0xa9, 0x8d, 0xa6, 0x17, 0xa0, 0xb0, 0xff,
+	0x3f, 0x26, 0x19, 0x99, 0x19, 0x39, 0x44, 0x07, 0x1b, 0xe6, 0x4c, 0xea, 0x15, 0x9e, 0x93, 0xd7,
+	0xe8, 0x38, 0x82, 0x50, 0x41, 0x02, 0x42, 0x1b, 0x2a, 0xa2, 0x6d, 0x70, 0x3b, 0xc1, 0x9f, 0x3e,
+	0x3a, 0x0e, 0xdb, 0x66, 0xe8, 0x8a, 0x72, 0x88, 0x8b, 0xaa, 0xbe, 0xef, 0x6c, 0x7a, 0x53, 0xf4,
+	0xd6, 0x4d, 0x19, 0x41, 0x2a, 0xb3, 0xb1, 0xc9, 0x47, 0xe8, 0x50, 0xaa, 0x08, 0xd4, 0x54, 0x13,
+	0x77, 0x0b, 0xf6, 0x5d, 0x6e, 0x19, 0x68, 0xcd, 0x27, 0x3c, 0x23, 0xdf, 0xa1, 0x37, 0x53, 0xa1,
+	0xcd, 0xa4, 0xdd, 0xf4, 0x88, 0x4e, 0x7f, 0xb0, 0xab, 0x67, 0x4a, 0x05, 0x0b, 0xa7, 0x9e, 0x33,
+	0xa1, 0x41, 0x09, 0xca, 0xf1, 0xee, 0x67, 0xff, 0x1b, 0xe9, 0xde, 0xd9, 0xf9, 0x5f, 0x4f, 0x4b,
+	0xff, 0xd3, 0xd3, 0xd2, 0xff, 0xe7, 0x69, 0xe9, 0xff, 0xf1, 0xbc, 0xf4, 0x3e, 0x3d, 0x2f, 0xbd,
+	0xbf, 0x9f, 0x97, 0xde, 0xef, 0x6f, 0x3f, 0x54, 0xc3, 0xcd, 0xfd, 0xfb, 0x93, 0xb2, 0xbd, 0x3d,
+	0xed, 0x6d, 0xf5, 0x76, 0xfb, 0xc2, 0x47, 0x30, 0x3e, 0xf1, 0xd3, 0x87, 0x53, 0xf7, 0x29, 0x18,
+	0x1e, 0xef, 0x6c, 0xff, 0x7e, 0x6f, 0xdc, 0xff, 0xf9, 0xdf, 0x01, 0x00, 0xb0, 0xf1, 0x5f, 0x4c,
+	0x1e, 0x04, 0x00, 0x00,
 }
@@ -51,3 +51,16 @@ func TestGetOrderTypeFromStr(t *testing.T) {
 
 	require.NotNil(t, err)
 }
+
+func TestGetCandleIntervalInSeconds(t *testing.T) {
+	interval, err := types.GetCandleIntervalFromStr("five_minutes")
+	require.Nil(t, err)
+	require.Equal(t, types.CandleInterval_FIVE_MINUTES, interval)
+
+	seconds, err := types.GetCandleIntervalInSeconds(interval)
+	require.Nil(t, err)
+	require.Equal(t, uint64(300), seconds)
+
+	_, err = types.GetCandleIntervalInSeconds(types.CandleInterval(100))
+	require.NotNil(t, err)
+}
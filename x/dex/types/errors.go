@@ -22,6 +22,12 @@ var (
 	ErrContractNotExists          = sdkerrors.Register(ModuleName, 17, "Error finding contract info")
 	ErrParsingContractInfo        = sdkerrors.Register(ModuleName, 18, "Error parsing contract info")
 	ErrInsufficientRent           = sdkerrors.Register(ModuleName, 19, "Error contract does not have sufficient fee")
+	ErrEncodingCandles            = sdkerrors.Register(ModuleName, 20, "Error encoding candles as JSON")
+	ErrEncodingAccountTrades      = sdkerrors.Register(ModuleName, 21, "Error encoding account trades as JSON")
+	ErrEncodingOrderSimulations   = sdkerrors.Register(ModuleName, 22, "Error encoding order simulations as JSON")
+	ErrEncodingOrderBookDepth     = sdkerrors.Register(ModuleName, 23, "Error encoding order book depth as JSON")
+	ErrPriceOutsideOracleBand     = sdkerrors.Register(ModuleName, 24, "order price is outside of the oracle price band")
+	ErrNotRentFundingAccount      = sdkerrors.Register(ModuleName, 25, "the rent of the contract is topped up by another funding account")
 	ErrCircularContractDependency = sdkerrors.Register(ModuleName, 1103, "circular contract dependency detected")
 	ErrContractSuspended          = sdkerrors.Register(ModuleName, 1104, "contract suspended")
 	ErrContractNotSuspended       = sdkerrors.Register(ModuleName, 1105, "contract not suspended")
@@ -1,14 +1,31 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	EventTypePlaceOrder          = "place_order"
 	EventTypeCancelOrder         = "cancel_order"
+	EventTypeRejectOrder         = "reject_order"
+	EventTypeExpireOrder         = "expire_order"
+	EventTypeFillOrder           = "fill_order"
 	EventTypeDepositRent         = "deposit_rent"
 	EventTypeRegisterContract    = "register_contract"
 	EventTypeUnregisterContract  = "unregister_contract"
 	EventTypeRegisterPair        = "register_pair"
 	EventTypeSetQuantityTickSize = "set_quantity_tick_size"
 	EventTypeSetPriceTickSize    = "set_price_tick_size"
+	EventTypeHaltPair            = "halt_pair"
+	EventTypeResumePair          = "resume_pair"
+	EventTypeDelistPair          = "delist_pair"
+	EventTypeSuspendContract     = "suspend_contract"
+	EventTypeUnsuspendContract   = "unsuspend_contract"
+	EventTypeLowRentBalance      = "low_rent_balance"
+	EventTypeTopupRent           = "topup_rent"
+	EventTypeTopupRentFailed     = "topup_rent_failed"
 
 	AttributeKeyOrderID         = "order_id"
 	AttributeKeyCancellationID  = "cancellation_id"
@@ -16,6 +33,110 @@ const (
 	AttributeKeyRentBalance     = "rent_balance"
 	AttributeKeyPriceDenom      = "price_denom"
 	AttributeKeyAssetDenom      = "asset_denom"
+	AttributeKeyReason          = "reason"
+
+	// attributes of the events emitted when a pair is executed in EndBlock
+	AttributeKeyCounterpartyOrderID = "counterparty_order_id"
+	AttributeKeyAccount             = "account"
+	AttributeKeyPositionDirection   = "position_direction"
+	AttributeKeyPrice               = "price"
+	AttributeKeyQuantity            = "quantity"
+	AttributeKeyLiquidity           = "liquidity"
+	AttributeKeyFillType            = "fill_type"
+	AttributeKeyFee                 = "fee"
+
+	// attributes of the events emitted when a circuit breaker halts a pair
+	AttributeKeyTwap                 = "twap"
+	AttributeKeyHaltedUntilTimestamp = "halted_until_timestamp"
+
+	// attributes of the events emitted for the rent balance of a contract
+	AttributeKeyThreshold      = "threshold"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyFundingAccount = "funding_account"
+
+	AttributeValueMaker       = "maker"
+	AttributeValueTaker       = "taker"
+	AttributeValueFullFill    = "full"
+	AttributeValuePartialFill = "partial"
 
 	AttributeValueCategory = ModuleName
 )
+
+// NewFillOrderEvent returns the event emitted for one side of a fill. `entry` is the settlement
+// of the side the event is for, and `price` is the price the fill was executed at.
+func NewFillOrderEvent(
+	contractAddr string,
+	entry SettlementEntry,
+	counterpartyOrderID uint64,
+	price sdk.Dec,
+	liquidity string,
+	fillType string,
+) sdk.Event {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyOrderID, fmt.Sprint(entry.OrderId)),
+		sdk.NewAttribute(AttributeKeyCounterpartyOrderID, fmt.Sprint(counterpartyOrderID)),
+		sdk.NewAttribute(AttributeKeyContractAddress, contractAddr),
+		sdk.NewAttribute(AttributeKeyPriceDenom, entry.PriceDenom),
+		sdk.NewAttribute(AttributeKeyAssetDenom, entry.AssetDenom),
+		sdk.NewAttribute(AttributeKeyAccount, entry.Account),
+		sdk.NewAttribute(AttributeKeyPositionDirection, entry.PositionDirection),
+		sdk.NewAttribute(AttributeKeyPrice, price.String()),
+		sdk.NewAttribute(AttributeKeyQuantity, entry.Quantity.String()),
+		sdk.NewAttribute(AttributeKeyLiquidity, liquidity),
+		sdk.NewAttribute(AttributeKeyFillType, fillType),
+	}
+	if entry.Fee != nil {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyFee, entry.Fee.String()))
+	}
+	return sdk.NewEvent(EventTypeFillOrder, attributes...)
+}
+
+// NewCancellationEvent returns the event emitted when a cancellation removes an order from the
+// book, which is an `expire_order` event for expired orders and a `cancel_order` event otherwise.
+// The reason of the event is the cause of the cancellation given by its initiator.
+func NewCancellationEvent(cancellation Cancellation) sdk.Event {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyOrderID, fmt.Sprint(cancellation.Id)),
+		sdk.NewAttribute(AttributeKeyContractAddress, cancellation.ContractAddr),
+		sdk.NewAttribute(AttributeKeyPriceDenom, cancellation.PriceDenom),
+		sdk.NewAttribute(AttributeKeyAssetDenom, cancellation.AssetDenom),
+		sdk.NewAttribute(AttributeKeyAccount, cancellation.Creator),
+		sdk.NewAttribute(AttributeKeyPositionDirection, GetContractPositionDirection(cancellation.PositionDirection)),
+		sdk.NewAttribute(AttributeKeyPrice, cancellation.Price.String()),
+	}
+	if cancellation.Quantity != nil {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyQuantity, cancellation.Quantity.String()))
+	}
+	attributes = append(attributes, sdk.NewAttribute(AttributeKeyReason, CancellationReason(cancellation.Initiator)))
+	if cancellation.Initiator == CancellationInitiator_EXPIRED {
+		return sdk.NewEvent(EventTypeExpireOrder, attributes...)
+	}
+	return sdk.NewEvent(EventTypeCancelOrder, attributes...)
+}
+
+// CancellationReason returns the reason reported for a cancellation with the given initiator
+func CancellationReason(initiator CancellationInitiator) string {
+	switch initiator {
+	case CancellationInitiator_LIQUIDATED:
+		return LiquidationReason
+	case CancellationInitiator_EXPIRED:
+		return ExpiredOrderReason
+	case CancellationInitiator_SELF_TRADE_PREVENTION:
+		return SelfTradePreventionReason
+	default:
+		return UserCancellationReason
+	}
+}
+
+// NewHaltPairEvent returns the event emitted when the circuit breaker of a pair halts its matching
+func NewHaltPairEvent(halt PairHalt) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeHaltPair,
+		sdk.NewAttribute(AttributeKeyContractAddress, halt.ContractAddr),
+		sdk.NewAttribute(AttributeKeyPriceDenom, halt.PriceDenom),
+		sdk.NewAttribute(AttributeKeyAssetDenom, halt.AssetDenom),
+		sdk.NewAttribute(AttributeKeyPrice, halt.Price.String()),
+		sdk.NewAttribute(AttributeKeyTwap, halt.Twap.String()),
+		sdk.NewAttribute(AttributeKeyHaltedUntilTimestamp, fmt.Sprint(halt.HaltedUntilTimestamp)),
+	)
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCancellationEvent(t *testing.T) {
+	for _, tc := range []struct {
+		initiator types.CancellationInitiator
+		eventType string
+		reason    string
+	}{
+		{types.CancellationInitiator_USER, types.EventTypeCancelOrder, types.UserCancellationReason},
+		{types.CancellationInitiator_LIQUIDATED, types.EventTypeCancelOrder, types.LiquidationReason},
+		{types.CancellationInitiator_SELF_TRADE_PREVENTION, types.EventTypeCancelOrder, types.SelfTradePreventionReason},
+		{types.CancellationInitiator_EXPIRED, types.EventTypeExpireOrder, types.ExpiredOrderReason},
+	} {
+		event := types.NewCancellationEvent(types.Cancellation{
+			Id:                1,
+			Initiator:         tc.initiator,
+			PositionDirection: types.PositionDirection_LONG,
+			Price:             sdk.OneDec(),
+		})
+		require.Equal(t, tc.eventType, event.Type)
+		reasons := []string{}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == types.AttributeKeyReason {
+				reasons = append(reasons, string(attribute.Value))
+			}
+		}
+		require.Equal(t, []string{tc.reason}, reasons)
+	}
+}
@@ -16,3 +16,10 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
+
+// OracleKeeper defines the expected interface needed to retrieve the oracle prices that orders of
+// pairs linked to an oracle denom are checked against.
+type OracleKeeper interface {
+	GetBaseExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, sdk.Int, int64, error)
+	CalculateTwap(ctx sdk.Context, denom string, lookbackSeconds uint64) (sdk.Dec, error)
+}
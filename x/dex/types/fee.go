@@ -0,0 +1,68 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeVolumeWindowInDays is the number of days of account volume that fee tiers are based on
+const FeeVolumeWindowInDays = 30
+
+const SecondsPerDay = 24 * 60 * 60
+
+func (f FeeSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.ContractAddr); err != nil {
+		return fmt.Errorf("invalid contract address %s: %w", f.ContractAddr, err)
+	}
+	if f.PriceDenom == "" || f.AssetDenom == "" {
+		return errors.New("price and asset denoms of a fee schedule must be set")
+	}
+	if err := validateFeeRates(f.MakerFeeRate, f.TakerFeeRate); err != nil {
+		return err
+	}
+	for i, tier := range f.Tiers {
+		if tier.MinVolume.IsNil() || !tier.MinVolume.IsPositive() {
+			return errors.New("min volume of a fee tier must be positive")
+		}
+		if i > 0 && tier.MinVolume.LTE(f.Tiers[i-1].MinVolume) {
+			return errors.New("fee tiers must be ordered by strictly increasing min volume")
+		}
+		if err := validateFeeRates(tier.MakerFeeRate, tier.TakerFeeRate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFeeTier returns the tier that applies to an account with the given 30-day volume. If the
+// account hasn't reached any tier, the base rates are returned as a tier with zero min volume.
+func (f FeeSchedule) GetFeeTier(volume sdk.Dec) FeeTier {
+	res := FeeTier{
+		MinVolume:    sdk.ZeroDec(),
+		MakerFeeRate: f.MakerFeeRate,
+		TakerFeeRate: f.TakerFeeRate,
+	}
+	for _, tier := range f.Tiers {
+		if volume.LT(tier.MinVolume) {
+			break
+		}
+		res = tier
+	}
+	return res
+}
+
+func validateFeeRates(makerFeeRate sdk.Dec, takerFeeRate sdk.Dec) error {
+	for _, rate := range []sdk.Dec{makerFeeRate, takerFeeRate} {
+		if rate.IsNil() || rate.IsNegative() || rate.GTE(sdk.OneDec()) {
+			return errors.New("fee rates must be at least 0 and less than 1")
+		}
+	}
+	return nil
+}
+
+// GetDayForTimestamp returns the index of the UTC day that a unix timestamp falls in
+func GetDayForTimestamp(timestamp uint64) uint64 {
+	return timestamp / SecondsPerDay
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testFeeSchedule() types.FeeSchedule {
+	return types.FeeSchedule{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		MakerFeeRate: sdk.MustNewDecFromStr("0.002"),
+		TakerFeeRate: sdk.MustNewDecFromStr("0.004"),
+		Tiers: []types.FeeTier{
+			{MinVolume: sdk.NewDec(1000), MakerFeeRate: sdk.MustNewDecFromStr("0.001"), TakerFeeRate: sdk.MustNewDecFromStr("0.003")},
+			{MinVolume: sdk.NewDec(5000), MakerFeeRate: sdk.ZeroDec(), TakerFeeRate: sdk.MustNewDecFromStr("0.002")},
+		},
+	}
+}
+
+func TestFeeScheduleValidate(t *testing.T) {
+	require.Nil(t, testFeeSchedule().Validate())
+
+	feeSchedule := testFeeSchedule()
+	feeSchedule.ContractAddr = "invalid"
+	require.NotNil(t, feeSchedule.Validate())
+
+	feeSchedule = testFeeSchedule()
+	feeSchedule.AssetDenom = ""
+	require.NotNil(t, feeSchedule.Validate())
+
+	feeSchedule = testFeeSchedule()
+	feeSchedule.TakerFeeRate = sdk.OneDec()
+	require.NotNil(t, feeSchedule.Validate())
+
+	feeSchedule = testFeeSchedule()
+	feeSchedule.Tiers[1].MakerFeeRate = sdk.NewDec(-1)
+	require.NotNil(t, feeSchedule.Validate())
+
+	feeSchedule = testFeeSchedule()
+	feeSchedule.Tiers[1].MinVolume = sdk.NewDec(1000)
+	require.NotNil(t, feeSchedule.Validate())
+
+	feeSchedule = testFeeSchedule()
+	feeSchedule.Tiers[0].MinVolume = sdk.ZeroDec()
+	require.NotNil(t, feeSchedule.Validate())
+}
+
+func TestGetFeeTier(t *testing.T) {
+	feeSchedule := testFeeSchedule()
+	require.Equal(t, types.FeeTier{
+		MinVolume:    sdk.ZeroDec(),
+		MakerFeeRate: feeSchedule.MakerFeeRate,
+		TakerFeeRate: feeSchedule.TakerFeeRate,
+	}, feeSchedule.GetFeeTier(sdk.NewDec(999)))
+	require.Equal(t, feeSchedule.Tiers[0], feeSchedule.GetFeeTier(sdk.NewDec(1000)))
+	require.Equal(t, feeSchedule.Tiers[1], feeSchedule.GetFeeTier(sdk.NewDec(10000)))
+}
+
+func TestGetDayForTimestamp(t *testing.T) {
+	require.Equal(t, uint64(0), types.GetDayForTimestamp(types.SecondsPerDay-1))
+	require.Equal(t, uint64(1), types.GetDayForTimestamp(types.SecondsPerDay))
+}
@@ -71,5 +71,52 @@ func (cs ContractState) Validate() error {
 		}
 		shortBookPriceMap[priceElem] = struct{}{}
 	}
+	for i := range cs.PairList {
+		if err := validateSelfTradePrevention(&cs.PairList[i]); err != nil {
+			return err
+		}
+	}
+	for _, elem := range cs.FeeScheduleList {
+		if elem.ContractAddr != cs.ContractInfo.ContractAddr {
+			return fmt.Errorf("fee schedule for %s found in the state of %s", elem.ContractAddr, cs.ContractInfo.ContractAddr)
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, elem := range cs.CircuitBreakerList {
+		if elem.ContractAddr != cs.ContractInfo.ContractAddr {
+			return fmt.Errorf("circuit breaker for %s found in the state of %s", elem.ContractAddr, cs.ContractInfo.ContractAddr)
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, elem := range cs.PairHaltList {
+		if elem.ContractAddr != cs.ContractInfo.ContractAddr {
+			return fmt.Errorf("pair halt for %s found in the state of %s", elem.ContractAddr, cs.ContractInfo.ContractAddr)
+		}
+	}
+	if cs.RentLedger != nil && cs.RentLedger.ContractAddr != cs.ContractInfo.ContractAddr {
+		return fmt.Errorf("rent ledger for %s found in the state of %s", cs.RentLedger.ContractAddr, cs.ContractInfo.ContractAddr)
+	}
+	if cs.RentConfig != nil {
+		if cs.RentConfig.ContractAddr != cs.ContractInfo.ContractAddr {
+			return fmt.Errorf("rent config for %s found in the state of %s", cs.RentConfig.ContractAddr, cs.ContractInfo.ContractAddr)
+		}
+		if err := cs.RentConfig.Validate(); err != nil {
+			return err
+		}
+	}
+	suspensionHeights := map[int64]struct{}{}
+	for _, elem := range cs.SuspensionRecordList {
+		if elem.ContractAddr != cs.ContractInfo.ContractAddr {
+			return fmt.Errorf("suspension record for %s found in the state of %s", elem.ContractAddr, cs.ContractInfo.ContractAddr)
+		}
+		if _, ok := suspensionHeights[elem.Height]; ok {
+			return fmt.Errorf("duplicated suspension record at height %d for %s", elem.Height, elem.ContractAddr)
+		}
+		suspensionHeights[elem.Height] = struct{}{}
+	}
 	return nil
 }
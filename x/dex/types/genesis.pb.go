@@ -85,13 +85,22 @@ func (m *GenesisState) GetLastEpoch() uint64 {
 }
 
 type ContractState struct {
-	ContractInfo        ContractInfoV2       `protobuf:"bytes,1,opt,name=contractInfo,proto3" json:"contractInfo"`
-	LongBookList        []LongBook           `protobuf:"bytes,2,rep,name=longBookList,proto3" json:"longBookList"`
-	ShortBookList       []ShortBook          `protobuf:"bytes,3,rep,name=shortBookList,proto3" json:"shortBookList"`
-	TriggeredOrdersList []Order              `protobuf:"bytes,4,rep,name=triggeredOrdersList,proto3" json:"triggeredOrdersList"`
-	PairList            []Pair               `protobuf:"bytes,5,rep,name=pairList,proto3" json:"pairList"`
-	PriceList           []ContractPairPrices `protobuf:"bytes,6,rep,name=priceList,proto3" json:"priceList"`
-	NextOrderId         uint64               `protobuf:"varint,7,opt,name=nextOrderId,proto3" json:"nextOrderId,omitempty"`
+	ContractInfo            ContractInfoV2       `protobuf:"bytes,1,opt,name=contractInfo,proto3" json:"contractInfo"`
+	LongBookList            []LongBook           `protobuf:"bytes,2,rep,name=longBookList,proto3" json:"longBookList"`
+	ShortBookList           []ShortBook          `protobuf:"bytes,3,rep,name=shortBookList,proto3" json:"shortBookList"`
+	TriggeredOrdersList     []Order              `protobuf:"bytes,4,rep,name=triggeredOrdersList,proto3" json:"triggeredOrdersList"`
+	PairList                []Pair               `protobuf:"bytes,5,rep,name=pairList,proto3" json:"pairList"`
+	PriceList               []ContractPairPrices `protobuf:"bytes,6,rep,name=priceList,proto3" json:"priceList"`
+	NextOrderId             uint64               `protobuf:"varint,7,opt,name=nextOrderId,proto3" json:"nextOrderId,omitempty"`
+	ExpiringOrdersList      []ExpiringOrder      `protobuf:"bytes,8,rep,name=expiringOrdersList,proto3" json:"expiringOrdersList"`
+	AccountActiveOrdersList []Order              `protobuf:"bytes,9,rep,name=accountActiveOrdersList,proto3" json:"accountActiveOrdersList"`
+	FeeScheduleList         []FeeSchedule        `protobuf:"bytes,10,rep,name=feeScheduleList,proto3" json:"feeScheduleList"`
+	AccountVolumeList       []AccountVolume      `protobuf:"bytes,11,rep,name=accountVolumeList,proto3" json:"accountVolumeList"`
+	CircuitBreakerList      []CircuitBreaker     `protobuf:"bytes,12,rep,name=circuitBreakerList,proto3" json:"circuitBreakerList"`
+	PairHaltList            []PairHalt           `protobuf:"bytes,13,rep,name=pairHaltList,proto3" json:"pairHaltList"`
+	RentLedger              *RentLedger          `protobuf:"bytes,14,opt,name=rentLedger,proto3" json:"rentLedger,omitempty"`
+	RentConfig              *RentConfig          `protobuf:"bytes,15,opt,name=rentConfig,proto3" json:"rentConfig,omitempty"`
+	SuspensionRecordList    []SuspensionRecord   `protobuf:"bytes,16,rep,name=suspensionRecordList,proto3" json:"suspensionRecordList"`
 }
 
 func (m *ContractState) Reset()         { *m = ContractState{} }
@@ -176,9 +185,74 @@ func (m *ContractState) GetNextOrderId() uint64 {
 	return 0
 }
 
+func (m *ContractState) GetExpiringOrdersList() []ExpiringOrder {
+	if m != nil {
+		return m.ExpiringOrdersList
+	}
+	return nil
+}
+
+func (m *ContractState) GetAccountActiveOrdersList() []Order {
+	if m != nil {
+		return m.AccountActiveOrdersList
+	}
+	return nil
+}
+
+func (m *ContractState) GetFeeScheduleList() []FeeSchedule {
+	if m != nil {
+		return m.FeeScheduleList
+	}
+	return nil
+}
+
+func (m *ContractState) GetAccountVolumeList() []AccountVolume {
+	if m != nil {
+		return m.AccountVolumeList
+	}
+	return nil
+}
+
+func (m *ContractState) GetCircuitBreakerList() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakerList
+	}
+	return nil
+}
+
+func (m *ContractState) GetPairHaltList() []PairHalt {
+	if m != nil {
+		return m.PairHaltList
+	}
+	return nil
+}
+
+func (m *ContractState) GetRentLedger() *RentLedger {
+	if m != nil {
+		return m.RentLedger
+	}
+	return nil
+}
+
+func (m *ContractState) GetRentConfig() *RentConfig {
+	if m != nil {
+		return m.RentConfig
+	}
+	return nil
+}
+
+func (m *ContractState) GetSuspensionRecordList() []SuspensionRecord {
+	if m != nil {
+		return m.SuspensionRecordList
+	}
+	return nil
+}
+
 type ContractPairPrices struct {
-	PricePair Pair     `protobuf:"bytes,1,opt,name=pricePair,proto3" json:"pricePair"`
-	Prices    []*Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	PricePair Pair      `protobuf:"bytes,1,opt,name=pricePair,proto3" json:"pricePair"`
+	Prices    []*Price  `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	Volumes   []*Volume `protobuf:"bytes,3,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	Candles   []Candle  `protobuf:"bytes,4,rep,name=candles,proto3" json:"candles"`
 }
 
 func (m *ContractPairPrices) Reset()         { *m = ContractPairPrices{} }
@@ -228,6 +302,20 @@ func (m *ContractPairPrices) GetPrices() []*Price {
 	return nil
 }
 
+func (m *ContractPairPrices) GetVolumes() []*Volume {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+func (m *ContractPairPrices) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "seiprotocol.seichain.dex.GenesisState")
 	proto.RegisterType((*ContractState)(nil), "seiprotocol.seichain.dex.ContractState")
@@ -237,39 +325,55 @@ func init() {
 func init() { proto.RegisterFile("dex/genesis.proto", fileDescriptor_a803aaabd08db59d) }
 
 var fileDescriptor_a803aaabd08db59d = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x1b, 0x5a, 0x0a, 0x73, 0x5b, 0xfe, 0x78, 0x3b, 0x44, 0x15, 0xca, 0xa2, 0x72, 0xa0,
-	0x07, 0x96, 0x48, 0xe5, 0xc0, 0x0d, 0xa1, 0x22, 0x34, 0x4d, 0xaa, 0xb4, 0xaa, 0x95, 0x40, 0xe2,
-	0x82, 0xd2, 0xc4, 0xa4, 0xd6, 0xba, 0xbc, 0x91, 0x6d, 0xa4, 0xf2, 0x2d, 0xd8, 0x47, 0xe2, 0xb6,
-	0xe3, 0x8e, 0x9c, 0x10, 0x6a, 0xbf, 0x08, 0xf2, 0x1b, 0x7b, 0x4d, 0x04, 0x59, 0xb9, 0xc5, 0x8f,
-	0x9f, 0xf7, 0xe7, 0xf7, 0xb5, 0x9f, 0x90, 0xa7, 0x09, 0x5b, 0x87, 0x29, 0xcb, 0x98, 0xe4, 0x32,
-	0xc8, 0x05, 0x28, 0xa0, 0xae, 0x64, 0x1c, 0xbf, 0x62, 0x58, 0x05, 0x92, 0xf1, 0x78, 0x19, 0xf1,
-	0x2c, 0x48, 0xd8, 0xba, 0x7f, 0x94, 0x42, 0x0a, 0xb8, 0x15, 0xea, 0xaf, 0xc2, 0xdf, 0x7f, 0xa2,
-	0x11, 0x79, 0x24, 0xa2, 0x4b, 0x43, 0xe8, 0x1f, 0x6a, 0x65, 0x05, 0x59, 0xfa, 0x79, 0x01, 0x70,
-	0x61, 0xc4, 0x23, 0x2d, 0xca, 0x25, 0x08, 0x55, 0x56, 0x1f, 0x6b, 0x15, 0x44, 0xc2, 0x84, 0x11,
-	0xa8, 0x16, 0x62, 0xc8, 0x94, 0x88, 0x62, 0x65, 0xb4, 0x47, 0xc5, 0x09, 0x5c, 0x94, 0x8b, 0x72,
-	0xc1, 0x63, 0x56, 0x08, 0x83, 0x1f, 0x0e, 0xe9, 0x9e, 0x16, 0x43, 0xcc, 0x55, 0xa4, 0x18, 0x7d,
-	0x43, 0xda, 0x45, 0x47, 0xae, 0xe3, 0x3b, 0xc3, 0xce, 0xc8, 0x0f, 0xea, 0x86, 0x0a, 0xa6, 0xe8,
-	0x1b, 0xb7, 0xae, 0x7f, 0x1d, 0x37, 0x66, 0xa6, 0x8a, 0xce, 0x49, 0xcf, 0xf6, 0x80, 0x40, 0xf7,
-	0x9e, 0xdf, 0x1c, 0x76, 0x46, 0x2f, 0xea, 0x31, 0xef, 0xca, 0x76, 0x43, 0xab, 0x32, 0xe8, 0x33,
-	0x72, 0xb0, 0x8a, 0xa4, 0x7a, 0x9f, 0x43, 0xbc, 0x74, 0x9b, 0xbe, 0x33, 0x6c, 0xcd, 0x76, 0xc2,
-	0xe0, 0xaa, 0x45, 0x7a, 0x15, 0x08, 0x9d, 0x91, 0xae, 0x05, 0x9c, 0x65, 0x5f, 0xc0, 0x8c, 0x32,
-	0xdc, 0xdf, 0x83, 0x76, 0x7f, 0x18, 0x99, 0x26, 0x2a, 0x0c, 0x3a, 0x21, 0x5d, 0xfd, 0x30, 0x63,
-	0x80, 0x8b, 0x09, 0x97, 0xca, 0xcc, 0x35, 0xa8, 0x67, 0x4e, 0x8c, 0xdb, 0xd2, 0xca, 0xd5, 0xf4,
-	0x9c, 0xf4, 0xf0, 0x45, 0x6f, 0x71, 0x4d, 0xc4, 0x3d, 0xaf, 0xc7, 0xcd, 0xad, 0xdd, 0x5e, 0x51,
-	0xa5, 0x9e, 0x7e, 0x24, 0x87, 0x4a, 0xf0, 0x34, 0x65, 0x82, 0x25, 0xe7, 0x3a, 0x15, 0x12, 0xb1,
-	0x2d, 0xc4, 0x1e, 0xd7, 0x63, 0xd1, 0x6b, 0x90, 0xff, 0x22, 0xd0, 0xb7, 0xe4, 0xa1, 0x0e, 0x10,
-	0xd2, 0xee, 0x23, 0xcd, 0xbb, 0x2b, 0x12, 0xdc, 0xc2, 0x6e, 0xab, 0xe8, 0x94, 0x1c, 0x60, 0xe4,
-	0x10, 0xd1, 0x46, 0xc4, 0xcb, 0xfd, 0x4f, 0xa1, 0x51, 0x53, 0x5d, 0x66, 0x13, 0xb6, 0x83, 0x50,
-	0x9f, 0x74, 0x32, 0xb6, 0x56, 0xd8, 0xe5, 0x59, 0xe2, 0x3e, 0xc0, 0x44, 0x94, 0xa5, 0xc1, 0x95,
-	0x43, 0xe8, 0xdf, 0x24, 0x3a, 0x36, 0xad, 0x68, 0xc9, 0xa4, 0xe2, 0xff, 0xa6, 0xd9, 0x95, 0xd1,
-	0xd7, 0xa4, 0x8d, 0x0b, 0x69, 0x22, 0x70, 0xc7, 0xe5, 0xe2, 0xa9, 0x33, 0x63, 0x1f, 0x9f, 0x5e,
-	0x6f, 0x3c, 0xe7, 0x66, 0xe3, 0x39, 0xbf, 0x37, 0x9e, 0xf3, 0x7d, 0xeb, 0x35, 0x6e, 0xb6, 0x5e,
-	0xe3, 0xe7, 0xd6, 0x6b, 0x7c, 0x3a, 0x49, 0xb9, 0x5a, 0x7e, 0x5d, 0x04, 0x31, 0x5c, 0x86, 0x92,
-	0xf1, 0x13, 0x4b, 0xc3, 0x05, 0xe2, 0xc2, 0x75, 0xa8, 0x7f, 0x5d, 0xf5, 0x2d, 0x67, 0x72, 0xd1,
-	0xc6, 0xfd, 0x57, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x4d, 0x7c, 0x7f, 0x83, 0x04, 0x00,
-	0x00,
+	// 768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xdf, 0x4e, 0x13, 0x4f,
+	0x14, 0xc7, 0x5b, 0xda, 0x5f, 0x81, 0x69, 0xcb, 0x9f, 0x81, 0xe4, 0xb7, 0x12, 0x53, 0x9a, 0xaa,
+	0xb1, 0x31, 0xd2, 0x26, 0x78, 0x61, 0xe2, 0x85, 0x81, 0x22, 0x22, 0x49, 0x13, 0x9a, 0x6d, 0xc4,
+	0x44, 0xa3, 0x64, 0xbb, 0x3b, 0xdd, 0x4e, 0x28, 0x3b, 0x9b, 0x99, 0x29, 0xa9, 0x4f, 0xa1, 0x2f,
+	0xe1, 0x83, 0x78, 0xc7, 0x25, 0x97, 0x5e, 0x19, 0x03, 0x2f, 0x62, 0xe6, 0xec, 0x0c, 0xdd, 0x02,
+	0xcb, 0xe2, 0x5d, 0xf7, 0xdb, 0xf3, 0xfd, 0xcc, 0x9c, 0xb3, 0xe7, 0x9c, 0x45, 0xcb, 0x1e, 0x19,
+	0x37, 0x7d, 0x12, 0x10, 0x41, 0x45, 0x23, 0xe4, 0x4c, 0x32, 0x6c, 0x09, 0x42, 0xe1, 0x97, 0xcb,
+	0x86, 0x0d, 0x41, 0xa8, 0x3b, 0x70, 0x68, 0xd0, 0xf0, 0xc8, 0x78, 0x6d, 0xd5, 0x67, 0x3e, 0x83,
+	0xbf, 0x9a, 0xea, 0x57, 0x14, 0xbf, 0xb6, 0xa4, 0x10, 0xa1, 0xc3, 0x9d, 0x13, 0x4d, 0x58, 0x5b,
+	0x51, 0xca, 0x90, 0x05, 0xfe, 0x51, 0x8f, 0xb1, 0x63, 0x2d, 0xae, 0x2a, 0x51, 0x0c, 0x18, 0x97,
+	0x71, 0x75, 0x51, 0xa9, 0x8c, 0x7b, 0x84, 0x6b, 0x01, 0x2b, 0xc1, 0x65, 0x81, 0xe4, 0x8e, 0x2b,
+	0xb5, 0xb6, 0x10, 0x9d, 0x40, 0x79, 0xdc, 0x14, 0x72, 0xea, 0x92, 0xf8, 0x15, 0x4e, 0xd9, 0x70,
+	0x74, 0x62, 0x94, 0xb2, 0x52, 0xfa, 0xc4, 0x3c, 0x3e, 0x00, 0x2a, 0xe5, 0xee, 0x88, 0xca, 0xa3,
+	0x1e, 0x27, 0xce, 0x31, 0xe1, 0x71, 0x38, 0x27, 0x81, 0x9c, 0xba, 0xe7, 0x48, 0x84, 0x24, 0x10,
+	0x94, 0x05, 0x91, 0x5a, 0xfb, 0x99, 0x45, 0xa5, 0xbd, 0xa8, 0x4c, 0x5d, 0xe9, 0x48, 0x82, 0x5f,
+	0xa3, 0x42, 0x94, 0xb3, 0x95, 0xad, 0x66, 0xeb, 0xc5, 0xcd, 0x6a, 0x23, 0xa9, 0x6c, 0x8d, 0x0e,
+	0xc4, 0xb5, 0xf2, 0x67, 0xbf, 0xd7, 0x33, 0xb6, 0x76, 0xe1, 0x2e, 0x2a, 0x9b, 0x2c, 0x01, 0x68,
+	0xcd, 0x54, 0x73, 0xf5, 0xe2, 0xe6, 0xd3, 0x64, 0xcc, 0x4e, 0x3c, 0x5c, 0xd3, 0xa6, 0x19, 0xf8,
+	0x21, 0x9a, 0x1f, 0x3a, 0x42, 0xee, 0x86, 0xcc, 0x1d, 0x58, 0xb9, 0x6a, 0xb6, 0x9e, 0xb7, 0x27,
+	0x42, 0xed, 0x07, 0x42, 0xe5, 0x29, 0x08, 0xb6, 0x51, 0xc9, 0x00, 0xf6, 0x83, 0x3e, 0xd3, 0xa9,
+	0xd4, 0xd3, 0xef, 0xa0, 0xa2, 0x0f, 0x37, 0xf5, 0x25, 0xa6, 0x18, 0xb8, 0x8d, 0x4a, 0xea, 0xd5,
+	0xb7, 0x18, 0x3b, 0x6e, 0x53, 0x21, 0x75, 0x5e, 0xb5, 0x64, 0x66, 0x5b, 0x47, 0x1b, 0x5a, 0xdc,
+	0x8d, 0x0f, 0x50, 0x19, 0x7a, 0xe6, 0x0a, 0x97, 0x03, 0xdc, 0xa3, 0x64, 0x5c, 0xd7, 0x84, 0x9b,
+	0x12, 0x4d, 0xf9, 0xf1, 0x07, 0xb4, 0x22, 0x39, 0xf5, 0x7d, 0xc2, 0x89, 0x77, 0xa0, 0xfa, 0x4e,
+	0x00, 0x36, 0x0f, 0xd8, 0xf5, 0x64, 0x2c, 0xc4, 0x6a, 0xe4, 0x6d, 0x04, 0xbc, 0x85, 0xe6, 0x54,
+	0x8b, 0x02, 0xed, 0x3f, 0xa0, 0x55, 0xee, 0x6a, 0x09, 0x6a, 0x60, 0x57, 0x2e, 0xdc, 0x41, 0xf3,
+	0xd0, 0xd4, 0x80, 0x28, 0x00, 0xe2, 0x79, 0xfa, 0xab, 0x50, 0xa8, 0x8e, 0xb2, 0x99, 0x0e, 0x9b,
+	0x40, 0x70, 0x15, 0x15, 0x03, 0x32, 0x96, 0x70, 0xcb, 0x7d, 0xcf, 0x9a, 0x85, 0x8e, 0x88, 0x4b,
+	0xf8, 0x33, 0xc2, 0x64, 0x1c, 0x52, 0x4e, 0x03, 0x3f, 0x56, 0x8d, 0xb9, 0xb4, 0x5e, 0xdc, 0x8d,
+	0x7b, 0xf4, 0xb9, 0xb7, 0x80, 0xf0, 0x11, 0xfa, 0xdf, 0x71, 0x5d, 0x36, 0x0a, 0xe4, 0xb6, 0x2b,
+	0xe9, 0x29, 0x89, 0x9d, 0x31, 0xff, 0x2f, 0x15, 0x4f, 0xa2, 0xe0, 0xf7, 0x68, 0xb1, 0x4f, 0x48,
+	0xd7, 0x1d, 0x10, 0x6f, 0x34, 0x8c, 0x2a, 0x87, 0x00, 0xfc, 0x24, 0x19, 0xfc, 0x76, 0x62, 0xd0,
+	0xf8, 0xeb, 0x0c, 0xfc, 0x09, 0x2d, 0xeb, 0x13, 0x0f, 0x61, 0xab, 0x00, 0xb8, 0x98, 0x56, 0x95,
+	0xed, 0xb8, 0x45, 0xa3, 0x6f, 0x72, 0xf0, 0x17, 0x84, 0xf5, 0x2a, 0x6a, 0x45, 0x9b, 0x08, 0xe8,
+	0xa5, 0x6a, 0x2e, 0x65, 0xf6, 0xa6, 0x3c, 0xa6, 0xe8, 0x37, 0x49, 0x6a, 0x02, 0x55, 0x4f, 0xbd,
+	0x73, 0x86, 0x12, 0xc8, 0xe5, 0xb4, 0x09, 0xec, 0xe8, 0x68, 0x33, 0x81, 0x71, 0x37, 0x7e, 0x83,
+	0x90, 0xda, 0x8e, 0x6d, 0xe2, 0xf9, 0x84, 0x5b, 0x0b, 0xb0, 0x21, 0x1e, 0x27, 0xb3, 0xec, 0xab,
+	0x58, 0x3b, 0xe6, 0x33, 0x94, 0x1d, 0x16, 0xf4, 0xa9, 0x6f, 0x2d, 0xde, 0x87, 0x12, 0xc5, 0xda,
+	0x31, 0x1f, 0xf6, 0xd0, 0xea, 0x64, 0x33, 0xdb, 0xc4, 0x65, 0xdc, 0x83, 0x0c, 0x97, 0x20, 0xc3,
+	0x67, 0x77, 0x2c, 0x85, 0x6b, 0x2e, 0x9d, 0xe9, 0xad, 0xb4, 0xda, 0xb7, 0x19, 0x84, 0x6f, 0x4e,
+	0x17, 0x6e, 0xe9, 0xf1, 0x54, 0x92, 0xde, 0x94, 0xf7, 0x9b, 0xf0, 0x89, 0x0d, 0xbf, 0x44, 0x05,
+	0x78, 0x10, 0xd6, 0x4c, 0x5a, 0xfb, 0xc3, 0xa9, 0xb6, 0x0e, 0xc7, 0xaf, 0xd0, 0x6c, 0xf4, 0x7d,
+	0x13, 0x7a, 0x03, 0xde, 0xf1, 0xbd, 0x89, 0x5a, 0xcd, 0x36, 0x06, 0xbc, 0x85, 0x66, 0x5d, 0x27,
+	0xf0, 0x86, 0x44, 0x58, 0xf9, 0x34, 0xef, 0x0e, 0x04, 0xea, 0x8b, 0x1b, 0x5b, 0x6b, 0xef, 0xec,
+	0xa2, 0x92, 0x3d, 0xbf, 0xa8, 0x64, 0xff, 0x5c, 0x54, 0xb2, 0xdf, 0x2f, 0x2b, 0x99, 0xf3, 0xcb,
+	0x4a, 0xe6, 0xd7, 0x65, 0x25, 0xf3, 0x71, 0xc3, 0xa7, 0x72, 0x30, 0xea, 0x35, 0x5c, 0x76, 0xd2,
+	0x14, 0x84, 0x6e, 0x18, 0x2a, 0x3c, 0x00, 0xb6, 0x39, 0x6e, 0xaa, 0x2f, 0xaa, 0xfc, 0x1a, 0x12,
+	0xd1, 0x2b, 0xc0, 0xff, 0x2f, 0xfe, 0x0e, 0x00, 0x2f, 0xee, 0x00, 0x7f, 0x77, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -344,6 +448,130 @@ func (m *ContractState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SuspensionRecordList) > 0 {
+		for iNdEx := len(m.SuspensionRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuspensionRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.RentConfig != nil {
+		{
+			size, err := m.RentConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.RentLedger != nil {
+		{
+			size, err := m.RentLedger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.PairHaltList) > 0 {
+		for iNdEx := len(m.PairHaltList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairHaltList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.CircuitBreakerList) > 0 {
+		for iNdEx := len(m.CircuitBreakerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AccountVolumeList) > 0 {
+		for iNdEx := len(m.AccountVolumeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountVolumeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FeeScheduleList) > 0 {
+		for iNdEx := len(m.FeeScheduleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeScheduleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AccountActiveOrdersList) > 0 {
+		for iNdEx := len(m.AccountActiveOrdersList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountActiveOrdersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ExpiringOrdersList) > 0 {
+		for iNdEx := len(m.ExpiringOrdersList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpiringOrdersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.NextOrderId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOrderId))
 		i--
@@ -452,6 +680,34 @@ func (m *ContractPairPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Prices) > 0 {
 		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -551,6 +807,56 @@ func (m *ContractState) Size() (n int) {
 	if m.NextOrderId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextOrderId))
 	}
+	if len(m.ExpiringOrdersList) > 0 {
+		for _, e := range m.ExpiringOrdersList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountActiveOrdersList) > 0 {
+		for _, e := range m.AccountActiveOrdersList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeScheduleList) > 0 {
+		for _, e := range m.FeeScheduleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountVolumeList) > 0 {
+		for _, e := range m.AccountVolumeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakerList) > 0 {
+		for _, e := range m.CircuitBreakerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairHaltList) > 0 {
+		for _, e := range m.PairHaltList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RentLedger != nil {
+		l = m.RentLedger.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RentConfig != nil {
+		l = m.RentConfig.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.SuspensionRecordList) > 0 {
+		for _, e := range m.SuspensionRecordList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -568,6 +874,18 @@ func (m *ContractPairPrices) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -964,59 +1282,9 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContractPairPrices) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContractPairPrices: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractPairPrices: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PricePair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiringOrdersList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1043,13 +1311,14 @@ func (m *ContractPairPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PricePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExpiringOrdersList = append(m.ExpiringOrdersList, ExpiringOrder{})
+			if err := m.ExpiringOrdersList[len(m.ExpiringOrdersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountActiveOrdersList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1076,8 +1345,435 @@ func (m *ContractPairPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, &Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccountActiveOrdersList = append(m.AccountActiveOrdersList, Order{})
+			if err := m.AccountActiveOrdersList[len(m.AccountActiveOrdersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeScheduleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeScheduleList = append(m.FeeScheduleList, FeeSchedule{})
+			if err := m.FeeScheduleList[len(m.FeeScheduleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountVolumeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountVolumeList = append(m.AccountVolumeList, AccountVolume{})
+			if err := m.AccountVolumeList[len(m.AccountVolumeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerList = append(m.CircuitBreakerList, CircuitBreaker{})
+			if err := m.CircuitBreakerList[len(m.CircuitBreakerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairHaltList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairHaltList = append(m.PairHaltList, PairHalt{})
+			if err := m.PairHaltList[len(m.PairHaltList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentLedger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RentLedger == nil {
+				m.RentLedger = &RentLedger{}
+			}
+			if err := m.RentLedger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RentConfig == nil {
+				m.RentConfig = &RentConfig{}
+			}
+			if err := m.RentConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspensionRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuspensionRecordList = append(m.SuspensionRecordList, SuspensionRecord{})
+			if err := m.SuspensionRecordList[len(m.SuspensionRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractPairPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractPairPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractPairPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PricePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, &Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, &Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -214,6 +214,29 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "batch auction pair with self-trade prevention",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractState: []types.ContractState{
+					{
+						PairList: []types.Pair{
+							{
+								PriceDenom:          "SEI",
+								AssetDenom:          "ATOM",
+								BatchAuction:        true,
+								SelfTradePrevention: types.SelfTradePrevention_CANCEL_TAKER,
+							},
+						},
+						ContractInfo: types.ContractInfoV2{
+							CodeId:       uint64(1),
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
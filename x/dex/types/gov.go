@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,14 +10,35 @@ import (
 )
 
 const (
-	ProposalTypeAddAssetMetadata = "AddAssetMetadata"
+	ProposalTypeAddAssetMetadata     = "AddAssetMetadata"
+	ProposalTypeUpdateFeeSchedule    = "UpdateFeeSchedule"
+	ProposalTypeUpdateCircuitBreaker = "UpdateCircuitBreaker"
+	ProposalTypeRegisterPairs        = "RegisterPairs"
+	ProposalTypeUpdateTickSize       = "UpdateTickSize"
+	ProposalTypeDelistPairs          = "DelistPairs"
+	ProposalTypeSuspendContract      = "SuspendContract"
+	ProposalTypeUnsuspendContract    = "UnsuspendContract"
 )
 
 func init() {
 	// for routing
 	govtypes.RegisterProposalType(ProposalTypeAddAssetMetadata)
+	govtypes.RegisterProposalType(ProposalTypeUpdateFeeSchedule)
+	govtypes.RegisterProposalType(ProposalTypeUpdateCircuitBreaker)
+	govtypes.RegisterProposalType(ProposalTypeRegisterPairs)
+	govtypes.RegisterProposalType(ProposalTypeUpdateTickSize)
+	govtypes.RegisterProposalType(ProposalTypeDelistPairs)
+	govtypes.RegisterProposalType(ProposalTypeSuspendContract)
+	govtypes.RegisterProposalType(ProposalTypeUnsuspendContract)
 	// for marshal and unmarshal
 	govtypes.RegisterProposalTypeCodec(&AddAssetMetadataProposal{}, "dex/AddAssetMetadataProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateFeeScheduleProposal{}, "dex/UpdateFeeScheduleProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateCircuitBreakerProposal{}, "dex/UpdateCircuitBreakerProposal")
+	govtypes.RegisterProposalTypeCodec(&RegisterPairsProposal{}, "dex/RegisterPairsProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateTickSizeProposal{}, "dex/UpdateTickSizeProposal")
+	govtypes.RegisterProposalTypeCodec(&DelistPairsProposal{}, "dex/DelistPairsProposal")
+	govtypes.RegisterProposalTypeCodec(&SuspendContractProposal{}, "dex/SuspendContractProposal")
+	govtypes.RegisterProposalTypeCodec(&UnsuspendContractProposal{}, "dex/UnsuspendContractProposal")
 }
 
 func (p *AddAssetMetadataProposal) GetTitle() string { return p.Title }
@@ -55,3 +77,273 @@ func (p AddAssetMetadataProposal) String() string {
 `, p.Title, p.Description, assetRecords))
 	return b.String()
 }
+
+func (p *UpdateFeeScheduleProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateFeeScheduleProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateFeeScheduleProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateFeeScheduleProposal) ProposalType() string {
+	return ProposalTypeUpdateFeeSchedule
+}
+
+func (p *UpdateFeeScheduleProposal) ValidateBasic() error {
+	if len(p.FeeSchedules) == 0 {
+		return errors.New("no fee schedule provided")
+	}
+	for _, feeSchedule := range p.FeeSchedules {
+		if err := feeSchedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	err := govtypes.ValidateAbstract(p)
+	return err
+}
+
+func (p UpdateFeeScheduleProposal) String() string {
+	feeSchedules := ""
+	for _, feeSchedule := range p.FeeSchedules {
+		feeSchedules += feeSchedule.String()
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Fee Schedule Proposal:
+  Title:       %s
+  Description: %s
+  Schedules:   %s
+`, p.Title, p.Description, feeSchedules))
+	return b.String()
+}
+
+func (p *UpdateCircuitBreakerProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateCircuitBreakerProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateCircuitBreakerProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateCircuitBreakerProposal) ProposalType() string {
+	return ProposalTypeUpdateCircuitBreaker
+}
+
+func (p *UpdateCircuitBreakerProposal) ValidateBasic() error {
+	if len(p.CircuitBreakers) == 0 {
+		return errors.New("no circuit breaker provided")
+	}
+	for _, circuitBreaker := range p.CircuitBreakers {
+		if err := circuitBreaker.Validate(); err != nil {
+			return err
+		}
+	}
+
+	err := govtypes.ValidateAbstract(p)
+	return err
+}
+
+func (p UpdateCircuitBreakerProposal) String() string {
+	circuitBreakers := ""
+	for _, circuitBreaker := range p.CircuitBreakers {
+		circuitBreakers += circuitBreaker.String()
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Circuit Breaker Proposal:
+  Title:            %s
+  Description:      %s
+  Circuit Breakers: %s
+`, p.Title, p.Description, circuitBreakers))
+	return b.String()
+}
+
+func (p *RegisterPairsProposal) GetTitle() string { return p.Title }
+
+func (p *RegisterPairsProposal) GetDescription() string { return p.Description }
+
+func (p *RegisterPairsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RegisterPairsProposal) ProposalType() string {
+	return ProposalTypeRegisterPairs
+}
+
+func (p *RegisterPairsProposal) ValidateBasic() error {
+	if len(p.Batchcontractpair) == 0 {
+		return errors.New("no pair provided")
+	}
+	if err := validateBatchContractPairs(p.Batchcontractpair); err != nil {
+		return err
+	}
+	for _, batchContractPair := range p.Batchcontractpair {
+		for _, pair := range batchContractPair.Pairs {
+			if pair.PriceTicksize == nil || pair.QuantityTicksize == nil {
+				return errors.New("tick sizes of registered pairs must be set")
+			}
+		}
+	}
+
+	err := govtypes.ValidateAbstract(p)
+	return err
+}
+
+func (p RegisterPairsProposal) String() string {
+	batchContractPairs := ""
+	for _, batchContractPair := range p.Batchcontractpair {
+		batchContractPairs += batchContractPair.String()
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Register Pairs Proposal:
+  Title:       %s
+  Description: %s
+  Pairs:       %s
+`, p.Title, p.Description, batchContractPairs))
+	return b.String()
+}
+
+func (p *UpdateTickSizeProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateTickSizeProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateTickSizeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateTickSizeProposal) ProposalType() string {
+	return ProposalTypeUpdateTickSize
+}
+
+func (p *UpdateTickSizeProposal) ValidateBasic() error {
+	if len(p.PriceTickSizes) == 0 && len(p.QuantityTickSizes) == 0 {
+		return errors.New("no tick size provided")
+	}
+	for _, tickSize := range append(append([]TickSize{}, p.PriceTickSizes...), p.QuantityTickSizes...) {
+		if err := validateTickSize(tickSize); err != nil {
+			return err
+		}
+	}
+
+	err := govtypes.ValidateAbstract(p)
+	return err
+}
+
+func (p UpdateTickSizeProposal) String() string {
+	priceTickSizes := ""
+	for _, tickSize := range p.PriceTickSizes {
+		priceTickSizes += tickSize.String()
+	}
+	quantityTickSizes := ""
+	for _, tickSize := range p.QuantityTickSizes {
+		quantityTickSizes += tickSize.String()
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Tick Size Proposal:
+  Title:               %s
+  Description:         %s
+  Price Tick Sizes:    %s
+  Quantity Tick Sizes: %s
+`, p.Title, p.Description, priceTickSizes, quantityTickSizes))
+	return b.String()
+}
+
+func validateTickSize(tickSize TickSize) error {
+	if _, err := sdk.AccAddressFromBech32(tickSize.ContractAddr); err != nil {
+		return fmt.Errorf("invalid contract address %s: %w", tickSize.ContractAddr, err)
+	}
+	if tickSize.Pair == nil {
+		return errors.New("empty pair info")
+	}
+	if tickSize.Ticksize.IsNil() || !tickSize.Ticksize.IsPositive() {
+		return errors.New("tick size must be positive")
+	}
+	return nil
+}
+
+func (p *DelistPairsProposal) GetTitle() string { return p.Title }
+
+func (p *DelistPairsProposal) GetDescription() string { return p.Description }
+
+func (p *DelistPairsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *DelistPairsProposal) ProposalType() string {
+	return ProposalTypeDelistPairs
+}
+
+func (p *DelistPairsProposal) ValidateBasic() error {
+	if len(p.Batchcontractpair) == 0 {
+		return errors.New("no pair provided")
+	}
+	if err := validateBatchContractPairs(p.Batchcontractpair); err != nil {
+		return err
+	}
+
+	err := govtypes.ValidateAbstract(p)
+	return err
+}
+
+func (p DelistPairsProposal) String() string {
+	batchContractPairs := ""
+	for _, batchContractPair := range p.Batchcontractpair {
+		batchContractPairs += batchContractPair.String()
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Delist Pairs Proposal:
+  Title:       %s
+  Description: %s
+  Pairs:       %s
+`, p.Title, p.Description, batchContractPairs))
+	return b.String()
+}
+
+func (p *SuspendContractProposal) GetTitle() string { return p.Title }
+
+func (p *SuspendContractProposal) GetDescription() string { return p.Description }
+
+func (p *SuspendContractProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SuspendContractProposal) ProposalType() string {
+	return ProposalTypeSuspendContract
+}
+
+func (p *SuspendContractProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.ContractAddr); err != nil {
+		return fmt.Errorf("invalid contract address %s: %w", p.ContractAddr, err)
+	}
+
+	err := govtypes.ValidateAbstract(p)
+	return err
+}
+
+func (p SuspendContractProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Suspend Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Reason:      %s
+`, p.Title, p.Description, p.ContractAddr, p.Reason))
+	return b.String()
+}
+
+func (p *UnsuspendContractProposal) GetTitle() string { return p.Title }
+
+func (p *UnsuspendContractProposal) GetDescription() string { return p.Description }
+
+func (p *UnsuspendContractProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UnsuspendContractProposal) ProposalType() string {
+	return ProposalTypeUnsuspendContract
+}
+
+func (p *UnsuspendContractProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.ContractAddr); err != nil {
+		return fmt.Errorf("invalid contract address %s: %w", p.ContractAddr, err)
+	}
+
+	err := govtypes.ValidateAbstract(p)
+	return err
+}
+
+func (p UnsuspendContractProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Unsuspend Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.ContractAddr))
+	return b.String()
+}
@@ -22,6 +22,9 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_dex"
+
+	// FeeCollectorName defines the module account that trading fees are collected into
+	FeeCollectorName = "dex_fee_collector"
 )
 
 func KeyPrefix(p string) []byte {
@@ -77,10 +80,126 @@ func PriceContractPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(PriceKey), AddressKeyPrefix(contractAddr)...)
 }
 
+// `Volume` constant + contract + price denom + asset denom
+func VolumePrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		VolumeContractPrefix(contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func VolumeContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(VolumeKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `Candle` constant + contract + price denom + asset denom + interval
+func CandlePrefix(contractAddr string, priceDenom string, assetDenom string, interval CandleInterval) []byte {
+	intervalKey := make([]byte, 4)
+	binary.BigEndian.PutUint32(intervalKey, uint32(interval))
+	return append(
+		append(CandleContractPrefix(contractAddr), PairPrefix(priceDenom, assetDenom)...),
+		intervalKey...,
+	)
+}
+
+func CandleContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(CandleKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `ExpiringOrder` constant + contract + price denom + asset denom
+func ExpiringOrderPrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		ExpiringOrderContractPrefix(contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func ExpiringOrderContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(ExpiringOrderKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `ExpiringOrderByTime` constant + contract + price denom + asset denom
+func ExpiringOrderByTimePrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		ExpiringOrderByTimeContractPrefix(contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func ExpiringOrderByTimeContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(ExpiringOrderByTimeKey), AddressKeyPrefix(contractAddr)...)
+}
+
 func RegisteredPairPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(RegisteredPairKey), AddressKeyPrefix(contractAddr)...)
 }
 
+// `AccountActiveOrders` constant + contract + account + price denom + asset denom
+func AccountActiveOrdersPrefix(contractAddr string, priceDenom string, assetDenom string, account string) []byte {
+	return append(
+		AccountActiveOrdersAccountPrefix(contractAddr, account),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func AccountActiveOrdersAccountPrefix(contractAddr string, account string) []byte {
+	return append(AccountActiveOrdersContractPrefix(contractAddr), AddressKeyPrefix(account)...)
+}
+
+func AccountActiveOrdersContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(AccountActiveOrdersKey), AddressKeyPrefix(contractAddr)...)
+}
+
+func FeeSchedulePrefix(contractAddr string) []byte {
+	return append(KeyPrefix(FeeScheduleKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `AccountVolume` constant + contract + price denom + asset denom + account
+func AccountVolumePrefix(contractAddr string, priceDenom string, assetDenom string, account string) []byte {
+	return append(
+		append(AccountVolumeContractPrefix(contractAddr), PairPrefix(priceDenom, assetDenom)...),
+		AddressKeyPrefix(account)...,
+	)
+}
+
+func AccountVolumeContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(AccountVolumeKey), AddressKeyPrefix(contractAddr)...)
+}
+
+func CircuitBreakerPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(CircuitBreakerKey), AddressKeyPrefix(contractAddr)...)
+}
+
+func RentLedgerKeyForContract(contractAddr string) []byte {
+	return append(KeyPrefix(RentLedgerKey), AddressKeyPrefix(contractAddr)...)
+}
+
+func RentConfigKeyForContract(contractAddr string) []byte {
+	return append(KeyPrefix(RentConfigKey), AddressKeyPrefix(contractAddr)...)
+}
+
+func SuspensionRecordPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(SuspensionRecordKey), AddressKeyPrefix(contractAddr)...)
+}
+
+func PairHaltPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(PairHaltKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `AccountTrade` constant + contract + account
+func AccountTradePrefix(contractAddr string, account string) []byte {
+	return append(AccountTradeContractPrefix(contractAddr), AddressKeyPrefix(account)...)
+}
+
+func AccountTradeContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(AccountTradeKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `AccountTradeCount` constant + contract, keyed by account
+func AccountTradeCountPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(AccountTradeCountKey), AddressKeyPrefix(contractAddr)...)
+}
+
 func OrderPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(OrderKey), AddressKeyPrefix(contractAddr)...)
 }
@@ -110,6 +229,31 @@ func GetSettlementKey(orderID uint64, account string, settlementID uint64) []byt
 	return append(GetSettlementOrderIDPrefix(orderID, account), settlementIDBytes...)
 }
 
+// `TriggerBook` constant + contract + price denom + asset denom
+func TriggerOrderBookPrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		TriggerOrderBookContractPrefix(contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func TriggerOrderBookContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(TriggerBookKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `TriggeredOrder` constant + contract + price denom + asset denom, keyed by the IDs of the stop
+// orders that have been triggered
+func TriggeredOrderPrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		TriggeredOrderContractPrefix(contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func TriggeredOrderContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(TriggeredOrderKey), AddressKeyPrefix(contractAddr)...)
+}
+
 func MemOrderPrefixForPair(contractAddr string, priceDenom string, assetDenom string) []byte {
 	return append(
 		append(KeyPrefix(MemOrderKey), AddressKeyPrefix(contractAddr)...),
@@ -159,20 +303,37 @@ const (
 
 	ShortBookKey = "ShortBook-value-"
 
+	TriggerBookKey    = "TriggerBook-value-"
+	TriggeredOrderKey = "TriggeredOrder-"
+
+	ExpiringOrderKey       = "ExpiringOrder-"
+	ExpiringOrderByTimeKey = "ExpiringOrderByTime-"
+
 	OrderKey               = "order"
 	AccountActiveOrdersKey = "account-active-orders"
 	CancelKey              = "cancel"
 
-	TwapKey             = "TWAP-"
-	PriceKey            = "Price-"
-	SettlementEntryKey  = "SettlementEntry-"
-	NextSettlementIDKey = "NextSettlementID-"
-	NextOrderIDKey      = "noid"
-	RegisteredPairKey   = "rp"
-	AssetListKey        = "AssetList-"
-	MatchResultKey      = "MatchResult-"
-	LongOrderCountKey   = "loc-"
-	ShortOrderCountKey  = "soc-"
+	TwapKey              = "TWAP-"
+	PriceKey             = "Price-"
+	VolumeKey            = "Volume-"
+	CandleKey            = "Candle-"
+	SettlementEntryKey   = "SettlementEntry-"
+	NextSettlementIDKey  = "NextSettlementID-"
+	NextOrderIDKey       = "noid"
+	RegisteredPairKey    = "rp"
+	AssetListKey         = "AssetList-"
+	MatchResultKey       = "MatchResult-"
+	LongOrderCountKey    = "loc-"
+	ShortOrderCountKey   = "soc-"
+	FeeScheduleKey       = "FeeSchedule-"
+	AccountVolumeKey     = "AccountVolume-"
+	AccountTradeKey      = "AccountTrade-"
+	AccountTradeCountKey = "AccountTradeCount-"
+	CircuitBreakerKey    = "CircuitBreaker-"
+	PairHaltKey          = "PairHalt-"
+	RentLedgerKey        = "RentLedger-"
+	RentConfigKey        = "RentConfig-"
+	SuspensionRecordKey  = "SuspensionRecord-"
 
 	MemOrderKey   = "MemOrder-"
 	MemDepositKey = "MemDeposit-"
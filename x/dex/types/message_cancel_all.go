@@ -0,0 +1,100 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelAll = "cancel_all"
+
+var _ sdk.Msg = &MsgCancelAll{}
+
+func NewMsgCancelAll(
+	creator string,
+	contractAddr string,
+	priceDenom string,
+	assetDenom string,
+	positionDirections []PositionDirection,
+) *MsgCancelAll {
+	return &MsgCancelAll{
+		Creator:            creator,
+		ContractAddr:       contractAddr,
+		PriceDenom:         priceDenom,
+		AssetDenom:         assetDenom,
+		PositionDirections: positionDirections,
+	}
+}
+
+func (msg *MsgCancelAll) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelAll) Type() string {
+	return TypeMsgCancelAll
+}
+
+func (msg *MsgCancelAll) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelAll) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelAll) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ContractAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	if (len(msg.PriceDenom) == 0) != (len(msg.AssetDenom) == 0) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "price denom and asset denom must be either both set or both empty")
+	}
+	if len(msg.PriceDenom) > 0 {
+		if sdk.ValidateDenom(msg.PriceDenom) != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid price denom %s", msg.PriceDenom)
+		}
+		if sdk.ValidateDenom(msg.AssetDenom) != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid asset denom %s", msg.AssetDenom)
+		}
+	}
+
+	for _, direction := range msg.PositionDirections {
+		if _, ok := PositionDirection_name[int32(direction)]; !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown position direction %d", direction)
+		}
+	}
+
+	return nil
+}
+
+// GetPair returns the pair the cancellation is restricted to, if any
+func (msg *MsgCancelAll) GetPair() (Pair, bool) {
+	if len(msg.PriceDenom) == 0 {
+		return Pair{}, false
+	}
+	return Pair{PriceDenom: msg.PriceDenom, AssetDenom: msg.AssetDenom}, true
+}
+
+// MatchesDirection returns whether orders of the given direction are to be cancelled
+func (msg *MsgCancelAll) MatchesDirection(direction PositionDirection) bool {
+	if len(msg.PositionDirections) == 0 {
+		return true
+	}
+	for _, d := range msg.PositionDirections {
+		if d == direction {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgCancelAll(t *testing.T) {
+	msg := &types.MsgCancelAll{
+		Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+		ContractAddr: "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc",
+	}
+	require.NoError(t, msg.ValidateBasic())
+	require.True(t, msg.MatchesDirection(types.PositionDirection_LONG))
+	require.True(t, msg.MatchesDirection(types.PositionDirection_SHORT))
+
+	// both denoms of the pair are needed
+	msg.PriceDenom = "denom1"
+	require.Error(t, msg.ValidateBasic())
+	msg.AssetDenom = "invalid denom"
+	require.Error(t, msg.ValidateBasic())
+	msg.AssetDenom = "denom2"
+	require.NoError(t, msg.ValidateBasic())
+	pair, ok := msg.GetPair()
+	require.True(t, ok)
+	require.Equal(t, "denom1", pair.PriceDenom)
+	require.Equal(t, "denom2", pair.AssetDenom)
+
+	msg.PositionDirections = []types.PositionDirection{types.PositionDirection_SHORT}
+	require.NoError(t, msg.ValidateBasic())
+	require.False(t, msg.MatchesDirection(types.PositionDirection_LONG))
+	require.True(t, msg.MatchesDirection(types.PositionDirection_SHORT))
+	msg.PositionDirections = []types.PositionDirection{types.PositionDirection(5)}
+	require.Error(t, msg.ValidateBasic())
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelReplace = "cancel_replace"
+
+var _ sdk.Msg = &MsgCancelReplace{}
+
+func NewMsgCancelReplace(
+	creator string,
+	contractAddr string,
+	cancellation *Cancellation,
+	order *Order,
+	fund sdk.Coins,
+) *MsgCancelReplace {
+	return &MsgCancelReplace{
+		Creator:      creator,
+		ContractAddr: contractAddr,
+		Cancellation: cancellation,
+		Order:        order,
+		Funds:        fund,
+	}
+}
+
+func (msg *MsgCancelReplace) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelReplace) Type() string {
+	return TypeMsgCancelReplace
+}
+
+func (msg *MsgCancelReplace) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelReplace) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelReplace) ValidateBasic() error {
+	if msg.Cancellation == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the order to cancel must be specified")
+	}
+	if msg.Order == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the order to place must be specified")
+	}
+	if err := msg.GetCancelOrders().ValidateBasic(); err != nil {
+		return err
+	}
+	return msg.GetPlaceOrders().ValidateBasic()
+}
+
+// GetCancelOrders returns the cancellation half of the message
+func (msg *MsgCancelReplace) GetCancelOrders() *MsgCancelOrders {
+	return NewMsgCancelOrders(msg.Creator, []*Cancellation{msg.Cancellation}, msg.ContractAddr)
+}
+
+// GetPlaceOrders returns the placement half of the message
+func (msg *MsgCancelReplace) GetPlaceOrders() *MsgPlaceOrders {
+	return NewMsgPlaceOrders(msg.Creator, []*Order{msg.Order}, msg.ContractAddr, msg.Funds)
+}
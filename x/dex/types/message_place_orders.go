@@ -76,10 +76,38 @@ func (msg *MsgPlaceOrders) ValidateBasic() error {
 		if order.OrderType == OrderType_FOKMARKETBYVALUE || order.OrderType == OrderType_FOKMARKET {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "FOK orders are temporarily disabled")
 		}
+		if order.TriggerStatus {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order, trigger status cannot be set by creator")
+		}
 		if order.OrderType == OrderType_STOPLIMIT || order.OrderType == OrderType_STOPLOSS {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "stop loss/limit order (%s) are not supported yet", err)
+			if order.TriggerPrice.IsNil() || !order.TriggerPrice.IsPositive() {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid trigger price for stop loss/limit order")
+			}
+		}
+		if err := validateTimeInForce(order); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
+
+// post-only, immediate-or-cancel, good-till-height and good-till-time only apply to limit orders
+func validateTimeInForce(order *Order) error {
+	if order.OrderType != OrderType_LIMIT && (order.PostOnly || order.TimeInForce != TimeInForce_GOOD_TILL_CANCEL) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order, post-only and time in force are only supported for limit orders")
+	}
+	if order.PostOnly && order.TimeInForce == TimeInForce_IMMEDIATE_OR_CANCEL {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order, post-only order cannot be immediate-or-cancel")
+	}
+	if _, ok := TimeInForce_name[int32(order.TimeInForce)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order, unknown time in force %d", order.TimeInForce)
+	}
+	if (order.TimeInForce == TimeInForce_GOOD_TILL_HEIGHT) != (order.ExpiryHeight > 0) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order, expiry height must be positive for and only for good-till-height orders")
+	}
+	if (order.TimeInForce == TimeInForce_GOOD_TILL_TIME) != (order.ExpiryTimestamp > 0) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order, expiry timestamp must be positive for and only for good-till-time orders")
+	}
+	return nil
+}
@@ -100,3 +100,85 @@ func TestValidateMsgPlaceOrder(t *testing.T) {
 	}
 	require.Error(t, msg.ValidateBasic())
 }
+
+func TestValidateMsgPlaceStopOrder(t *testing.T) {
+	TEST_CONTRACT := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	order := &types.Order{
+		Id:           1,
+		Account:      "test",
+		ContractAddr: TEST_CONTRACT,
+		Quantity:     sdk.OneDec(),
+		Price:        sdk.OneDec(),
+		AssetDenom:   "denom1",
+		PriceDenom:   "denom2",
+		OrderType:    types.OrderType_STOPLIMIT,
+		TriggerPrice: sdk.OneDec(),
+	}
+	msg := &types.MsgPlaceOrders{
+		Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+		ContractAddr: TEST_CONTRACT,
+		Orders:       []*types.Order{order},
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	// stop orders need a positive trigger price
+	order.TriggerPrice = sdk.ZeroDec()
+	require.Error(t, msg.ValidateBasic())
+	order.OrderType = types.OrderType_STOPLOSS
+	require.Error(t, msg.ValidateBasic())
+
+	// orders cannot be placed as triggered
+	order.TriggerPrice = sdk.OneDec()
+	order.TriggerStatus = true
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestValidateMsgPlaceOrderTimeInForce(t *testing.T) {
+	TEST_CONTRACT := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	order := &types.Order{
+		Id:           1,
+		Account:      "test",
+		ContractAddr: TEST_CONTRACT,
+		Quantity:     sdk.OneDec(),
+		Price:        sdk.OneDec(),
+		AssetDenom:   "denom1",
+		PriceDenom:   "denom2",
+		OrderType:    types.OrderType_LIMIT,
+		PostOnly:     true,
+	}
+	msg := &types.MsgPlaceOrders{
+		Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+		ContractAddr: TEST_CONTRACT,
+		Orders:       []*types.Order{order},
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	// post-only orders cannot be immediate-or-cancel
+	order.TimeInForce = types.TimeInForce_IMMEDIATE_OR_CANCEL
+	require.Error(t, msg.ValidateBasic())
+	order.PostOnly = false
+	require.NoError(t, msg.ValidateBasic())
+
+	// good-till-height orders need an expiry height, and only they can have one
+	order.TimeInForce = types.TimeInForce_GOOD_TILL_HEIGHT
+	require.Error(t, msg.ValidateBasic())
+	order.ExpiryHeight = 10
+	require.NoError(t, msg.ValidateBasic())
+	order.TimeInForce = types.TimeInForce_GOOD_TILL_CANCEL
+	require.Error(t, msg.ValidateBasic())
+
+	// good-till-time orders need an expiry timestamp, and only they can have one
+	order.ExpiryHeight = 0
+	order.TimeInForce = types.TimeInForce_GOOD_TILL_TIME
+	require.Error(t, msg.ValidateBasic())
+	order.ExpiryTimestamp = 10000
+	require.NoError(t, msg.ValidateBasic())
+	order.TimeInForce = types.TimeInForce_GOOD_TILL_CANCEL
+	require.Error(t, msg.ValidateBasic())
+
+	// time in force only applies to limit orders
+	order.ExpiryTimestamp = 0
+	order.TimeInForce = types.TimeInForce_IMMEDIATE_OR_CANCEL
+	order.OrderType = types.OrderType_MARKET
+	require.Error(t, msg.ValidateBasic())
+}
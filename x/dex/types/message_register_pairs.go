@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -56,28 +57,83 @@ func (msg *MsgRegisterPairs) ValidateBasic() error {
 		return errors.New("no data provided in register pairs transaction")
 	}
 
-	for _, batchContractPair := range msg.Batchcontractpair {
+	return validateBatchContractPairs(msg.Batchcontractpair)
+}
+
+func validateBatchContractPairs(batchContractPairs []BatchContractPair) error {
+	for _, batchContractPair := range batchContractPairs {
 		contractAddress := batchContractPair.ContractAddr
 
 		if contractAddress == "" {
 			return errors.New("contract address is empty")
 		}
 
-		_, err = sdk.AccAddressFromBech32(contractAddress)
+		_, err := sdk.AccAddressFromBech32(contractAddress)
 		if err != nil {
 			return errors.New("contract address format is not bech32")
 		}
 
 		if len(batchContractPair.Pairs) == 0 {
-			return errors.New("no pairs provided in register pairs transaction")
+			return fmt.Errorf("no pairs provided for contract %s", contractAddress)
 		}
 
 		for _, pair := range batchContractPair.Pairs {
 			if pair == nil {
 				return errors.New("empty pair info")
 			}
+			if err := validateMatchingPolicy(pair); err != nil {
+				return err
+			}
+			if err := validateSelfTradePrevention(pair); err != nil {
+				return err
+			}
+			if err := validateOraclePriceBand(pair); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
+
+func validateSelfTradePrevention(pair *Pair) error {
+	if _, ok := SelfTradePrevention_name[int32(pair.SelfTradePrevention)]; !ok {
+		return fmt.Errorf("unknown self-trade prevention mode %d", pair.SelfTradePrevention)
+	}
+	// fills of a batch auction aren't matched against resting orders one at a time, so there is
+	// no taker or maker to apply self-trade prevention to. Pairs can't combine the two rather than
+	// have the mode silently ignored by the auction.
+	if pair.BatchAuction && pair.SelfTradePrevention != SelfTradePrevention_NONE {
+		return errors.New("self-trade prevention is not supported by batch auctions")
+	}
+	return nil
+}
+
+func validateMatchingPolicy(pair *Pair) error {
+	if _, ok := MatchingPolicy_name[int32(pair.MatchingPolicy)]; !ok {
+		return fmt.Errorf("unknown matching policy %d", pair.MatchingPolicy)
+	}
+	if pair.MatchingPolicy != MatchingPolicy_PRO_RATA_WITH_TOP_OF_QUEUE_BONUS {
+		if pair.TopOfQueueBonus != nil {
+			return errors.New("top of queue bonus is only allowed with the pro-rata with top of queue bonus matching policy")
+		}
+		return nil
+	}
+	if pair.TopOfQueueBonus == nil || !pair.TopOfQueueBonus.IsPositive() || pair.TopOfQueueBonus.GT(sdk.OneDec()) {
+		return errors.New("top of queue bonus must be greater than 0 and at most 1")
+	}
+	return nil
+}
+
+func validateOraclePriceBand(pair *Pair) error {
+	if pair.OracleDenom == "" {
+		if pair.OraclePriceBand != nil || pair.OracleTwapLookbackSeconds != 0 {
+			return errors.New("oracle price band and TWAP lookback are only allowed with an oracle denom")
+		}
+		return nil
+	}
+	if pair.OraclePriceBand == nil || !pair.OraclePriceBand.IsPositive() || pair.OraclePriceBand.GTE(sdk.OneDec()) {
+		return errors.New("oracle price band must be greater than 0 and less than 1")
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetRentAutoTopup = "set_rent_auto_topup"
+
+var _ sdk.Msg = &MsgSetRentAutoTopup{}
+
+func NewMsgSetRentAutoTopup(
+	fundingAccount string,
+	contractAddr string,
+	threshold uint64,
+	amount uint64,
+) *MsgSetRentAutoTopup {
+	return &MsgSetRentAutoTopup{
+		FundingAccount: fundingAccount,
+		ContractAddr:   contractAddr,
+		Threshold:      threshold,
+		Amount:         amount,
+	}
+}
+
+func (msg *MsgSetRentAutoTopup) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRentAutoTopup) Type() string {
+	return TypeMsgSetRentAutoTopup
+}
+
+func (msg *MsgSetRentAutoTopup) GetSigners() []sdk.AccAddress {
+	fundingAccount, err := sdk.AccAddressFromBech32(msg.FundingAccount)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{fundingAccount}
+}
+
+func (msg *MsgSetRentAutoTopup) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRentAutoTopup) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FundingAccount)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid funding account address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ContractAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	// a zero amount disables auto top-ups
+	if msg.Amount > 0 && msg.Threshold == 0 {
+		return errors.New("auto top-up threshold must be positive")
+	}
+	return nil
+}
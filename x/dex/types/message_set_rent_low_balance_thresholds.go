@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetRentLowBalanceThresholds = "set_rent_low_balance_thresholds"
+
+// MaxRentLowBalanceThresholds is the maximum number of low balance thresholds of a contract
+const MaxRentLowBalanceThresholds = 10
+
+var _ sdk.Msg = &MsgSetRentLowBalanceThresholds{}
+
+func NewMsgSetRentLowBalanceThresholds(
+	creator string,
+	contractAddr string,
+	thresholds []uint64,
+) *MsgSetRentLowBalanceThresholds {
+	return &MsgSetRentLowBalanceThresholds{
+		Creator:      creator,
+		ContractAddr: contractAddr,
+		Thresholds:   thresholds,
+	}
+}
+
+func (msg *MsgSetRentLowBalanceThresholds) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRentLowBalanceThresholds) Type() string {
+	return TypeMsgSetRentLowBalanceThresholds
+}
+
+func (msg *MsgSetRentLowBalanceThresholds) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetRentLowBalanceThresholds) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRentLowBalanceThresholds) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ContractAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	return ValidateRentLowBalanceThresholds(msg.Thresholds)
+}
@@ -40,6 +40,12 @@ type Order struct {
 	Nominal           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=nominal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"nominal" yaml:"nominal"`
 	TriggerPrice      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price" yaml:"trigger_price"`
 	TriggerStatus     bool                                   `protobuf:"varint,15,opt,name=triggerStatus,proto3" json:"trigger_status"`
+	PostOnly          bool                                   `protobuf:"varint,16,opt,name=postOnly,proto3" json:"post_only"`
+	TimeInForce       TimeInForce                            `protobuf:"varint,17,opt,name=timeInForce,proto3,enum=seiprotocol.seichain.dex.TimeInForce" json:"time_in_force"`
+	ExpiryHeight      int64                                  `protobuf:"varint,18,opt,name=expiryHeight,proto3" json:"expiry_height"`
+	ExpiryTimestamp   uint64                                 `protobuf:"varint,19,opt,name=expiryTimestamp,proto3" json:"expiry_timestamp"`
+	// height at which the order was added to the order book
+	PlacementHeight int64 `protobuf:"varint,20,opt,name=placementHeight,proto3" json:"placement_height"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -152,6 +158,41 @@ func (m *Order) GetTriggerStatus() bool {
 	return false
 }
 
+func (m *Order) GetPostOnly() bool {
+	if m != nil {
+		return m.PostOnly
+	}
+	return false
+}
+
+func (m *Order) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TimeInForce_GOOD_TILL_CANCEL
+}
+
+func (m *Order) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *Order) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
+func (m *Order) GetPlacementHeight() int64 {
+	if m != nil {
+		return m.PlacementHeight
+	}
+	return 0
+}
+
 type Cancellation struct {
 	Id                uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
 	Initiator         CancellationInitiator                  `protobuf:"varint,2,opt,name=initiator,proto3,enum=seiprotocol.seichain.dex.CancellationInitiator" json:"initiator"`
@@ -161,6 +202,9 @@ type Cancellation struct {
 	AssetDenom        string                                 `protobuf:"bytes,6,opt,name=assetDenom,proto3" json:"asset_denom"`
 	PositionDirection PositionDirection                      `protobuf:"varint,7,opt,name=positionDirection,proto3,enum=seiprotocol.seichain.dex.PositionDirection" json:"position_direction"`
 	Price             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	// the quantity removed from the order if it is only partially cancelled, e.g. by self-trade
+	// prevention under DECREMENT_AND_CANCEL. Unset if the whole order is cancelled.
+	Quantity *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity,omitempty"`
 }
 
 func (m *Cancellation) Reset()         { *m = Cancellation{} }
@@ -245,6 +289,66 @@ func (m *Cancellation) GetPositionDirection() PositionDirection {
 	return PositionDirection_LONG
 }
 
+type ExpiringOrder struct {
+	ExpiryHeight    int64        `protobuf:"varint,1,opt,name=expiryHeight,proto3" json:"expiry_height"`
+	Cancellation    Cancellation `protobuf:"bytes,2,opt,name=cancellation,proto3" json:"cancellation"`
+	ExpiryTimestamp uint64       `protobuf:"varint,3,opt,name=expiryTimestamp,proto3" json:"expiry_timestamp"`
+}
+
+func (m *ExpiringOrder) Reset()         { *m = ExpiringOrder{} }
+func (m *ExpiringOrder) String() string { return proto.CompactTextString(m) }
+func (*ExpiringOrder) ProtoMessage()    {}
+func (*ExpiringOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d5fab85368797d, []int{2}
+}
+func (m *ExpiringOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiringOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiringOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiringOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiringOrder.Merge(m, src)
+}
+func (m *ExpiringOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiringOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiringOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiringOrder proto.InternalMessageInfo
+
+func (m *ExpiringOrder) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *ExpiringOrder) GetCancellation() Cancellation {
+	if m != nil {
+		return m.Cancellation
+	}
+	return Cancellation{}
+}
+
+func (m *ExpiringOrder) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
 type ActiveOrders struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids"`
 }
@@ -253,7 +357,7 @@ func (m *ActiveOrders) Reset()         { *m = ActiveOrders{} }
 func (m *ActiveOrders) String() string { return proto.CompactTextString(m) }
 func (*ActiveOrders) ProtoMessage()    {}
 func (*ActiveOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2d5fab85368797d, []int{2}
+	return fileDescriptor_c2d5fab85368797d, []int{3}
 }
 func (m *ActiveOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,60 +396,73 @@ func (m *ActiveOrders) GetIds() []uint64 {
 func init() {
 	proto.RegisterType((*Order)(nil), "seiprotocol.seichain.dex.Order")
 	proto.RegisterType((*Cancellation)(nil), "seiprotocol.seichain.dex.Cancellation")
+	proto.RegisterType((*ExpiringOrder)(nil), "seiprotocol.seichain.dex.ExpiringOrder")
 	proto.RegisterType((*ActiveOrders)(nil), "seiprotocol.seichain.dex.ActiveOrders")
 }
 
 func init() { proto.RegisterFile("dex/order.proto", fileDescriptor_c2d5fab85368797d) }
 
 var fileDescriptor_c2d5fab85368797d = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6a, 0xdb, 0x4c,
-	0x14, 0xb5, 0x1c, 0xff, 0x4e, 0x1c, 0xfb, 0xcb, 0x10, 0x82, 0xbe, 0x50, 0x2c, 0xa3, 0xd2, 0xe2,
-	0x50, 0x62, 0x41, 0xbb, 0x09, 0xa5, 0x9b, 0xa8, 0x86, 0x50, 0x4a, 0x68, 0x3a, 0x2d, 0x14, 0x4a,
-	0x8b, 0x51, 0x34, 0x83, 0x33, 0xd4, 0xd6, 0x38, 0x9a, 0x71, 0x89, 0xe9, 0x4b, 0xf4, 0x05, 0xfa,
-	0x3e, 0xd9, 0x35, 0xcb, 0xd2, 0x85, 0x28, 0xc9, 0x4e, 0xcb, 0x3c, 0x41, 0xd1, 0x95, 0x26, 0xb6,
-	0xf3, 0x43, 0xea, 0x45, 0x36, 0xd6, 0x9d, 0x73, 0xcf, 0x39, 0x57, 0x9a, 0x99, 0x7b, 0x8d, 0x1a,
-	0x94, 0x1d, 0x3b, 0x22, 0xa4, 0x2c, 0xec, 0x8c, 0x42, 0xa1, 0x04, 0x36, 0x25, 0xe3, 0x10, 0xf9,
-	0x62, 0xd0, 0x91, 0x8c, 0xfb, 0x87, 0x1e, 0x0f, 0x3a, 0x94, 0x1d, 0x6f, 0xac, 0xf5, 0x45, 0x5f,
-	0x40, 0xca, 0x49, 0xa2, 0x94, 0xbf, 0x01, 0x06, 0x2c, 0x18, 0x0f, 0x65, 0x0a, 0xd8, 0x3f, 0x2b,
-	0xa8, 0xf8, 0x26, 0x31, 0xc4, 0x1b, 0x28, 0xcf, 0xa9, 0x69, 0xb4, 0x8c, 0x76, 0xc1, 0x45, 0x27,
-	0x91, 0x65, 0xc4, 0x91, 0x95, 0xe7, 0x94, 0xe4, 0x39, 0xc5, 0x7b, 0xa8, 0x24, 0x95, 0xa7, 0xc6,
-	0xd2, 0xcc, 0xb7, 0x8c, 0x76, 0xfd, 0xe9, 0xa3, 0xce, 0x6d, 0x75, 0x3b, 0x60, 0xf6, 0x0e, 0xc8,
-	0x6e, 0x3d, 0xb3, 0xc9, 0xc4, 0x24, 0x7b, 0xe2, 0x4d, 0x54, 0xf6, 0x7c, 0x5f, 0x8c, 0x03, 0x65,
-	0x2e, 0xb5, 0x8c, 0x76, 0xd5, 0x6d, 0x64, 0x44, 0x0d, 0x13, 0x1d, 0xe0, 0x17, 0xa8, 0xe6, 0x8b,
-	0x40, 0x85, 0x9e, 0xaf, 0x76, 0x28, 0x0d, 0xcd, 0x02, 0xf0, 0xcd, 0x8c, 0xff, 0x9f, 0xce, 0xf5,
-	0x3c, 0x4a, 0x43, 0x26, 0x25, 0x99, 0x63, 0xe3, 0xcf, 0xa8, 0x38, 0x0a, 0xb9, 0xcf, 0xcc, 0x22,
-	0xc8, 0x76, 0x4f, 0x22, 0x2b, 0xf7, 0x3b, 0xb2, 0x1e, 0xf7, 0xb9, 0x3a, 0x1c, 0x1f, 0x74, 0x7c,
-	0x31, 0x74, 0x7c, 0x21, 0x87, 0x42, 0x66, 0x8f, 0x2d, 0x49, 0xbf, 0x38, 0x6a, 0x32, 0x62, 0xb2,
-	0xd3, 0x65, 0x7e, 0x1c, 0x59, 0xa9, 0xfc, 0x22, 0xb2, 0x6a, 0x13, 0x6f, 0x38, 0x78, 0x6e, 0xc3,
-	0xd2, 0x26, 0x29, 0x8c, 0x39, 0xaa, 0x1c, 0x8d, 0xbd, 0x40, 0x71, 0x35, 0x31, 0x4b, 0x50, 0x61,
-	0x6f, 0xe1, 0x0a, 0x97, 0x0e, 0x17, 0x91, 0xd5, 0x48, 0x8b, 0x68, 0xc4, 0x26, 0x97, 0x49, 0xec,
-	0x20, 0x04, 0x35, 0xbb, 0x2c, 0x10, 0x43, 0xb3, 0x9c, 0xee, 0x5a, 0x1c, 0x59, 0xcb, 0x80, 0xf6,
-	0x68, 0x02, 0x93, 0x19, 0x4a, 0x22, 0xf0, 0xa4, 0x64, 0x2a, 0x15, 0x54, 0xa6, 0x02, 0x40, 0xb5,
-	0x60, 0x4a, 0xc1, 0x6f, 0x51, 0x15, 0x6e, 0xd6, 0xfb, 0xc9, 0x88, 0x99, 0x55, 0x38, 0xe6, 0x87,
-	0x77, 0x1c, 0x73, 0x42, 0x75, 0xeb, 0x71, 0x64, 0x21, 0x50, 0xf6, 0x92, 0xef, 0x22, 0x53, 0x17,
-	0x7c, 0x84, 0x56, 0x47, 0x42, 0x72, 0xc5, 0x45, 0xd0, 0xe5, 0x21, 0xf3, 0x93, 0xc0, 0x44, 0x60,
-	0xfd, 0xe4, 0x76, 0xeb, 0xfd, 0xab, 0x12, 0x77, 0x3d, 0x8e, 0x2c, 0xac, 0x9d, 0x7a, 0x54, 0xe3,
-	0xe4, 0xba, 0x3b, 0x7e, 0x80, 0x0a, 0xd4, 0x53, 0x9e, 0xb9, 0x0c, 0x1f, 0x5c, 0x89, 0x23, 0x0b,
-	0xd6, 0x04, 0x7e, 0x71, 0x17, 0xad, 0xa6, 0x57, 0xb0, 0xcb, 0xa4, 0x1f, 0xf2, 0x11, 0xbc, 0x50,
-	0x0d, 0xa8, 0x50, 0x23, 0x4d, 0xf6, 0xe8, 0x34, 0x4b, 0xae, 0x0b, 0x30, 0x43, 0xe5, 0x40, 0x0c,
-	0x79, 0xe0, 0x0d, 0xcc, 0x15, 0xd0, 0xbe, 0x5e, 0xf8, 0xd4, 0xb5, 0xc1, 0x45, 0x64, 0xd5, 0xd3,
-	0x43, 0xcf, 0x00, 0x9b, 0xe8, 0x14, 0xfe, 0x86, 0x6a, 0x2a, 0xe4, 0xfd, 0x3e, 0x0b, 0xf7, 0xe1,
-	0x0e, 0xd7, 0xa1, 0xd6, 0x87, 0x85, 0x6b, 0xad, 0x64, 0x2e, 0x3d, 0x7d, 0x97, 0xd7, 0xd2, 0x8a,
-	0x73, 0xb0, 0x4d, 0xe6, 0x8a, 0xe1, 0x6d, 0xa4, 0x65, 0x69, 0x2f, 0x9b, 0x8d, 0x96, 0xd1, 0xae,
-	0xb8, 0x38, 0x8e, 0xac, 0xba, 0x16, 0x66, 0x5d, 0x3d, 0x4f, 0xb4, 0x7f, 0x14, 0x50, 0xed, 0xa5,
-	0x17, 0xf8, 0x6c, 0x30, 0xf0, 0x60, 0xbb, 0xd6, 0x67, 0x06, 0x4b, 0x69, 0x66, 0xa8, 0x7c, 0x42,
-	0x55, 0x1e, 0x70, 0xc5, 0x3d, 0x25, 0xc2, 0x6c, 0xae, 0x38, 0xb7, 0xdf, 0x8a, 0x59, 0xcb, 0x57,
-	0x5a, 0xe6, 0xae, 0xc4, 0x91, 0x35, 0x75, 0x21, 0xd3, 0x30, 0x99, 0x31, 0x7e, 0xc8, 0xc0, 0xfb,
-	0xca, 0x8c, 0xc9, 0x60, 0xa2, 0x03, 0xbc, 0x7d, 0xe3, 0x8c, 0x59, 0xfb, 0x87, 0xf9, 0x32, 0xdf,
-	0x95, 0xc5, 0x45, 0xbb, 0xb2, 0x74, 0x77, 0x57, 0xde, 0xd8, 0x42, 0xe5, 0x7b, 0x6d, 0xa1, 0xcb,
-	0xa1, 0x59, 0xb9, 0x8f, 0xa1, 0x69, 0x6f, 0xa2, 0xda, 0x8e, 0xaf, 0xf8, 0x57, 0x06, 0x23, 0x44,
-	0xe2, 0xff, 0xd1, 0x12, 0xa7, 0xd2, 0x34, 0x5a, 0x4b, 0xed, 0x82, 0x5b, 0x8e, 0x23, 0x2b, 0x59,
-	0x92, 0xe4, 0xc7, 0xdd, 0x3d, 0x39, 0x6b, 0x1a, 0xa7, 0x67, 0x4d, 0xe3, 0xcf, 0x59, 0xd3, 0xf8,
-	0x7e, 0xde, 0xcc, 0x9d, 0x9e, 0x37, 0x73, 0xbf, 0xce, 0x9b, 0xb9, 0x8f, 0x5b, 0x33, 0x2f, 0x23,
-	0x19, 0xdf, 0xd2, 0xdb, 0x00, 0x0b, 0xd8, 0x07, 0xe7, 0xd8, 0x49, 0xfe, 0xeb, 0xe0, 0xbd, 0x0e,
-	0x4a, 0x90, 0x7f, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x4e, 0x8b, 0xbe, 0x40, 0x07, 0x00,
-	0x00,
+	// 942 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5d, 0x6b, 0x1b, 0x47,
+	0x14, 0xf5, 0x5a, 0xb2, 0x3e, 0x46, 0xb2, 0x14, 0x4f, 0x45, 0x98, 0x9a, 0xa2, 0x15, 0x2a, 0x0d,
+	0x32, 0xad, 0x25, 0x48, 0x29, 0x84, 0x52, 0x0a, 0xd9, 0xaa, 0x4d, 0x43, 0x09, 0x49, 0xa7, 0x81,
+	0x42, 0x68, 0xd9, 0x6c, 0x76, 0xa7, 0xf2, 0x50, 0xed, 0xce, 0x66, 0x67, 0x54, 0x2c, 0xfa, 0x1b,
+	0x0a, 0xfd, 0x59, 0x7e, 0xcc, 0x63, 0xe9, 0xc3, 0x52, 0xec, 0x97, 0xb2, 0x8f, 0xfe, 0x03, 0x2d,
+	0x73, 0x77, 0x47, 0x5f, 0xb1, 0xb1, 0xf5, 0x90, 0x17, 0x69, 0xe6, 0xde, 0x7b, 0xce, 0xd1, 0xcc,
+	0xdc, 0x39, 0x23, 0xd4, 0x0e, 0xd8, 0xe9, 0x48, 0x24, 0x01, 0x4b, 0x86, 0x71, 0x22, 0x94, 0xc0,
+	0x44, 0x32, 0x0e, 0x23, 0x5f, 0x4c, 0x87, 0x92, 0x71, 0xff, 0xc4, 0xe3, 0xd1, 0x30, 0x60, 0xa7,
+	0x87, 0x9d, 0x89, 0x98, 0x08, 0x48, 0x8d, 0xf4, 0x28, 0xaf, 0x3f, 0x04, 0x02, 0x16, 0xcd, 0x42,
+	0x99, 0x07, 0xfa, 0x7f, 0x34, 0xd0, 0xde, 0x53, 0x4d, 0x88, 0x0f, 0xd1, 0x2e, 0x0f, 0x88, 0xd5,
+	0xb3, 0x06, 0x65, 0x07, 0x9d, 0xa5, 0xb6, 0x95, 0xa5, 0xf6, 0x2e, 0x0f, 0xe8, 0x2e, 0x0f, 0xf0,
+	0x13, 0x54, 0x91, 0xca, 0x53, 0x33, 0x49, 0x76, 0x7b, 0xd6, 0xa0, 0x75, 0xff, 0xa3, 0xe1, 0x75,
+	0xba, 0x43, 0x20, 0xfb, 0x01, 0x8a, 0x9d, 0x56, 0x41, 0x53, 0x80, 0x69, 0xf1, 0x8d, 0x8f, 0x50,
+	0xd5, 0xf3, 0x7d, 0x31, 0x8b, 0x14, 0x29, 0xf5, 0xac, 0x41, 0xdd, 0x69, 0x17, 0x85, 0x26, 0x4c,
+	0xcd, 0x00, 0x7f, 0x81, 0x9a, 0xbe, 0x88, 0x54, 0xe2, 0xf9, 0xea, 0x61, 0x10, 0x24, 0xa4, 0x0c,
+	0xf5, 0xa4, 0xa8, 0xbf, 0x63, 0x72, 0xae, 0x17, 0x04, 0x09, 0x93, 0x92, 0xae, 0x55, 0xe3, 0x9f,
+	0xd1, 0x5e, 0x9c, 0x70, 0x9f, 0x91, 0x3d, 0x80, 0x3d, 0x3a, 0x4b, 0xed, 0x9d, 0xbf, 0x53, 0xfb,
+	0xde, 0x84, 0xab, 0x93, 0xd9, 0xab, 0xa1, 0x2f, 0xc2, 0x91, 0x2f, 0x64, 0x28, 0x64, 0xf1, 0x75,
+	0x2c, 0x83, 0x5f, 0x47, 0x6a, 0x1e, 0x33, 0x39, 0x1c, 0x33, 0x3f, 0x4b, 0xed, 0x1c, 0x7e, 0x99,
+	0xda, 0xcd, 0xb9, 0x17, 0x4e, 0x3f, 0xef, 0xc3, 0xb4, 0x4f, 0xf3, 0x30, 0xe6, 0xa8, 0xf6, 0x7a,
+	0xe6, 0x45, 0x8a, 0xab, 0x39, 0xa9, 0x80, 0xc2, 0x93, 0xad, 0x15, 0x16, 0x0c, 0x97, 0xa9, 0xdd,
+	0xce, 0x45, 0x4c, 0xa4, 0x4f, 0x17, 0x49, 0x3c, 0x42, 0x08, 0x34, 0xc7, 0x2c, 0x12, 0x21, 0xa9,
+	0xe6, 0xbb, 0x96, 0xa5, 0x76, 0x03, 0xa2, 0x6e, 0xa0, 0xc3, 0x74, 0xa5, 0x44, 0x03, 0x3c, 0x29,
+	0x99, 0xca, 0x01, 0xb5, 0x25, 0x00, 0xa2, 0x06, 0xb0, 0x2c, 0xc1, 0xdf, 0xa3, 0x3a, 0x74, 0xd6,
+	0xf3, 0x79, 0xcc, 0x48, 0x1d, 0x8e, 0xf9, 0xc3, 0x1b, 0x8e, 0x59, 0x97, 0x3a, 0xad, 0x2c, 0xb5,
+	0x11, 0x20, 0x5d, 0xbd, 0x2e, 0xba, 0x64, 0xc1, 0xaf, 0xd1, 0x41, 0x2c, 0x24, 0x57, 0x5c, 0x44,
+	0x63, 0x9e, 0x30, 0x5f, 0x0f, 0x08, 0x02, 0xea, 0x8f, 0xaf, 0xa7, 0x7e, 0xb6, 0x09, 0x71, 0xee,
+	0x66, 0xa9, 0x8d, 0x0d, 0x93, 0x1b, 0x98, 0x38, 0x7d, 0x9b, 0x1d, 0x7f, 0x80, 0xca, 0x81, 0xa7,
+	0x3c, 0xd2, 0x80, 0x05, 0xd7, 0xb2, 0xd4, 0x86, 0x39, 0x85, 0x4f, 0x3c, 0x46, 0x07, 0x79, 0x0b,
+	0x8e, 0x99, 0xf4, 0x13, 0x1e, 0xc3, 0x0f, 0x6a, 0x42, 0x29, 0x68, 0xe4, 0x49, 0x37, 0x58, 0x66,
+	0xe9, 0xdb, 0x00, 0xcc, 0x50, 0x35, 0x12, 0x21, 0x8f, 0xbc, 0x29, 0xd9, 0x07, 0xec, 0x77, 0x5b,
+	0x9f, 0xba, 0x21, 0xb8, 0x4c, 0xed, 0x56, 0x7e, 0xe8, 0x45, 0xa0, 0x4f, 0x4d, 0x0a, 0xff, 0x8e,
+	0x9a, 0x2a, 0xe1, 0x93, 0x09, 0x4b, 0x9e, 0x41, 0x0f, 0xb7, 0x40, 0xeb, 0xc7, 0xad, 0xb5, 0xf6,
+	0x0b, 0x16, 0xd7, 0xf4, 0x72, 0x27, 0x57, 0x5c, 0x0b, 0xf7, 0xe9, 0x9a, 0x18, 0x7e, 0x80, 0x0c,
+	0x2c, 0xbf, 0xcb, 0xa4, 0xdd, 0xb3, 0x06, 0x35, 0x07, 0x67, 0xa9, 0xdd, 0x32, 0xc0, 0xe2, 0x56,
+	0xaf, 0x17, 0xe2, 0x23, 0x54, 0x8b, 0x85, 0x54, 0x4f, 0xa3, 0xe9, 0x9c, 0xdc, 0x01, 0xd0, 0x7e,
+	0x96, 0xda, 0x75, 0x1d, 0x73, 0x45, 0x34, 0x9d, 0xd3, 0x45, 0x1a, 0xbf, 0x40, 0x0d, 0xc5, 0x43,
+	0xf6, 0x38, 0xfa, 0x46, 0x24, 0x3e, 0x23, 0x07, 0x37, 0x79, 0xcb, 0xf3, 0x65, 0xb1, 0x73, 0x00,
+	0x2b, 0xe3, 0x21, 0x73, 0x79, 0xe4, 0xfe, 0xa2, 0x43, 0x74, 0x95, 0x0c, 0x7f, 0x86, 0x9a, 0xec,
+	0x34, 0xe6, 0xc9, 0xfc, 0x5b, 0xc6, 0x27, 0x27, 0x8a, 0xe0, 0x9e, 0x35, 0x28, 0xe5, 0xa8, 0x3c,
+	0xee, 0x9e, 0x40, 0x82, 0xae, 0x95, 0xe1, 0x2f, 0x51, 0x3b, 0x9f, 0x6b, 0x2d, 0xa9, 0xbc, 0x30,
+	0x26, 0xef, 0x81, 0x25, 0x76, 0xb4, 0xdd, 0x14, 0x48, 0x65, 0x72, 0x74, 0xb3, 0x58, 0xe3, 0xe3,
+	0xa9, 0xe7, 0xb3, 0x90, 0x45, 0xaa, 0x50, 0xee, 0x80, 0x32, 0xe0, 0x17, 0x29, 0x23, 0xbe, 0x59,
+	0xdc, 0xff, 0xaf, 0x8c, 0x9a, 0x5f, 0x79, 0x91, 0xcf, 0xa6, 0x53, 0x0f, 0x9a, 0xed, 0xee, 0x8a,
+	0x2d, 0x57, 0x56, 0x2c, 0xf9, 0x27, 0x54, 0xe7, 0x11, 0x57, 0xdc, 0x53, 0x22, 0x29, 0x5c, 0x79,
+	0x74, 0xfd, 0xce, 0xad, 0x52, 0x3e, 0x36, 0xb0, 0xfc, 0x60, 0x16, 0x2c, 0x74, 0x39, 0xd4, 0x0e,
+	0xed, 0x27, 0x0c, 0xb8, 0x37, 0x1c, 0xba, 0x08, 0x53, 0x33, 0xc0, 0x0f, 0xae, 0x74, 0xe8, 0xce,
+	0x2d, 0xdc, 0x79, 0xdd, 0xd3, 0xf6, 0xb6, 0xf5, 0xb4, 0xca, 0xcd, 0x9e, 0x76, 0xa5, 0x01, 0x55,
+	0xdf, 0xa9, 0x01, 0x2d, 0x9e, 0x9c, 0xda, 0x3b, 0x79, 0x72, 0x5e, 0xae, 0x3c, 0x39, 0x75, 0x50,
+	0x18, 0xeb, 0x93, 0xd9, 0x4a, 0x01, 0x1b, 0x86, 0x4f, 0x44, 0xc8, 0x15, 0x0b, 0x63, 0x35, 0x5f,
+	0xbe, 0x34, 0xfd, 0x7f, 0x2d, 0xb4, 0xff, 0xb5, 0xee, 0x6a, 0x1e, 0x4d, 0xf2, 0x7f, 0x06, 0x9b,
+	0x57, 0xc9, 0xba, 0xdd, 0x55, 0x7a, 0x89, 0x9a, 0xfe, 0x4a, 0xdb, 0x41, 0x93, 0x36, 0xee, 0xdf,
+	0xbb, 0x5d, 0x93, 0x3a, 0x1d, 0xbd, 0x71, 0x59, 0x6a, 0xaf, 0x71, 0xd0, 0xb5, 0xd9, 0x55, 0x97,
+	0xb5, 0xb4, 0xc5, 0x65, 0xed, 0x1f, 0xa1, 0xe6, 0x43, 0x5f, 0xf1, 0xdf, 0x18, 0xac, 0x53, 0xe2,
+	0xf7, 0x51, 0x89, 0x07, 0x92, 0x58, 0xbd, 0xd2, 0xa0, 0xec, 0x54, 0xb3, 0xd4, 0xd6, 0x53, 0xaa,
+	0x3f, 0x9c, 0x47, 0x67, 0xe7, 0x5d, 0xeb, 0xcd, 0x79, 0xd7, 0xfa, 0xe7, 0xbc, 0x6b, 0xfd, 0x79,
+	0xd1, 0xdd, 0x79, 0x73, 0xd1, 0xdd, 0xf9, 0xeb, 0xa2, 0xbb, 0xf3, 0xe2, 0x78, 0x65, 0xdf, 0x25,
+	0xe3, 0xc7, 0x66, 0x6d, 0x30, 0x81, 0xc5, 0x8d, 0x4e, 0x47, 0xfa, 0x6f, 0x17, 0x1c, 0xc1, 0xab,
+	0x0a, 0xe4, 0x3f, 0xfd, 0x7f, 0x00, 0x0e, 0x83, 0x13, 0x20, 0xcb, 0x09, 0x00, 0x00,
 }
 
 func (m *Order) Marshal() (dAtA []byte, err error) {
@@ -368,6 +485,46 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PlacementHeight != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.PlacementHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.PostOnly {
+		i--
+		if m.PostOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.TriggerStatus {
 		i--
 		if m.TriggerStatus {
@@ -503,6 +660,18 @@ func (m *Cancellation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Quantity != nil {
+		{
+			size := m.Quantity.Size()
+			i -= size
+			if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	{
 		size := m.Price.Size()
 		i -= size
@@ -559,6 +728,49 @@ func (m *Cancellation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExpiringOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpiringOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiringOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Cancellation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ActiveOrders) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -580,20 +792,20 @@ func (m *ActiveOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Ids) > 0 {
-		dAtA2 := make([]byte, len(m.Ids)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.Ids)*10)
+		var j2 int
 		for _, num := range m.Ids {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintOrder(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintOrder(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -664,6 +876,21 @@ func (m *Order) Size() (n int) {
 	if m.TriggerStatus {
 		n += 2
 	}
+	if m.PostOnly {
+		n += 3
+	}
+	if m.TimeInForce != 0 {
+		n += 2 + sovOrder(uint64(m.TimeInForce))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 2 + sovOrder(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 2 + sovOrder(uint64(m.ExpiryTimestamp))
+	}
+	if m.PlacementHeight != 0 {
+		n += 2 + sovOrder(uint64(m.PlacementHeight))
+	}
 	return n
 }
 
@@ -700,6 +927,27 @@ func (m *Cancellation) Size() (n int) {
 	}
 	l = m.Price.Size()
 	n += 1 + l + sovOrder(uint64(l))
+	if m.Quantity != nil {
+		l = m.Quantity.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *ExpiringOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovOrder(uint64(m.ExpiryHeight))
+	}
+	l = m.Cancellation.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovOrder(uint64(m.ExpiryTimestamp))
+	}
 	return n
 }
 
@@ -1178,6 +1426,102 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.TriggerStatus = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PostOnly = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementHeight", wireType)
+			}
+			m.PlacementHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlacementHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -1447,6 +1791,163 @@ func (m *Cancellation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Quantity = &v
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiringOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiringOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiringOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancellation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cancellation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -1,9 +1,20 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 type SudoOrderCancellationMsg struct {
 	OrderCancellations OrderCancellationMsgDetails `json:"bulk_order_cancellations"`
 }
 
 type OrderCancellationMsgDetails struct {
 	IdsToCancel []uint64 `json:"ids"`
+	// orders that are only partially cancelled, e.g. by self-trade prevention
+	Reductions []OrderReduction `json:"reductions,omitempty"`
+}
+
+type OrderReduction struct {
+	ID       uint64  `json:"id"`
+	Quantity sdk.Dec `json:"quantity"`
 }
@@ -4,6 +4,17 @@ import (
 	"fmt"
 )
 
+const (
+	PostOnlyRejectionReason          = "post-only order would cross the book"
+	ImmediateOrCancelRemainderReason = "unfilled remainder of immediate-or-cancel order"
+	UserCancellationReason           = "cancelled by user"
+	SelfTradePreventionReason        = "cancelled by self-trade prevention"
+	LiquidationReason                = "cancelled by liquidation"
+	ExpiredOrderReason               = "order expired"
+	DelistedPairReason               = "pair is delisted"
+	FillOrKillBatchAuctionReason     = "fill-or-kill orders are not supported by batch auctions"
+)
+
 type SudoOrderPlacementMsg struct {
 	OrderPlacements OrderPlacementMsgDetails `json:"bulk_order_placements"`
 }
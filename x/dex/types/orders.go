@@ -19,11 +19,32 @@ type CachedSortedOrderBookEntries struct {
 	currentPtr     int
 	currentChanged bool
 
-	loader  func(ctx sdk.Context, startingPriceExclusive sdk.Dec, withLimit bool) []OrderBookEntry
-	setter  func(sdk.Context, OrderBookEntry)
-	deleter func(sdk.Context, OrderBookEntry)
+	loader    func(ctx sdk.Context, startingPriceExclusive sdk.Dec, withLimit bool) []OrderBookEntry
+	setter    func(sdk.Context, OrderBookEntry)
+	deleter   func(sdk.Context, OrderBookEntry)
+	allocator Allocator
+
+	selfTradePrevention SelfTradePrevention
+	// orders removed by self-trade prevention that are committed by the next Flush, or discarded by
+	// the next Refresh
+	pendingSelfTradeCancellations []SelfTradeCancellation
+	selfTradeCancellations        []SelfTradeCancellation
+}
+
+// SelfTradeCancellation is a resting order that is removed from the order book by self-trade
+// prevention, with the quantity it had left, or, if `Partial` is set, a resting order that is only
+// reduced, with the quantity it was reduced by.
+type SelfTradeCancellation struct {
+	ToSettle
+	Price   sdk.Dec
+	Partial bool
 }
 
+// Allocator splits a quantity among the allocations of an order book entry. It is only called
+// with a positive quantity that is strictly smaller than the total quantity of the allocations,
+// and must return amounts that add up to exactly that quantity without exceeding any allocation.
+type Allocator func(allocations []*Allocation, quantity sdk.Dec) []ToSettle
+
 func NewCachedSortedOrderBookEntries(
 	loader func(ctx sdk.Context, startingPriceExclusive sdk.Dec, withLimit bool) []OrderBookEntry,
 	setter func(sdk.Context, OrderBookEntry),
@@ -39,6 +60,89 @@ func NewCachedSortedOrderBookEntries(
 	}
 }
 
+// WithAllocator makes partial settlements of an order book entry use the given allocator instead
+// of allocating in FIFO order.
+func (c *CachedSortedOrderBookEntries) WithAllocator(allocator Allocator) *CachedSortedOrderBookEntries {
+	c.allocator = allocator
+	return c
+}
+
+// WithSelfTradePrevention sets the self-trade prevention mode that applies when an order would match
+// against an order of the same account resting on this side of the book.
+func (c *CachedSortedOrderBookEntries) WithSelfTradePrevention(mode SelfTradePrevention) *CachedSortedOrderBookEntries {
+	c.selfTradePrevention = mode
+	return c
+}
+
+func (c *CachedSortedOrderBookEntries) SelfTradePrevention() SelfTradePrevention {
+	return c.selfTradePrevention
+}
+
+// AccountQuantity returns the total quantity of the allocations of `account` in the order book
+// entry currently being pointed at, and the largest order ID among them.
+func (c *CachedSortedOrderBookEntries) AccountQuantity(account string) (quantity sdk.Dec, latestOrderID uint64) {
+	quantity = sdk.ZeroDec()
+	for _, a := range c.CachedEntries[c.currentPtr].GetOrderEntry().Allocations {
+		if a.Account == account {
+			quantity = quantity.Add(a.Quantity)
+			if a.OrderId > latestOrderID {
+				latestOrderID = a.OrderId
+			}
+		}
+	}
+	return quantity, latestOrderID
+}
+
+// ReduceAccountQuantity reduces the allocations of `account` in the order book entry currently
+// being pointed at by `quantity` in total, in FIFO order, without settling anything. Allocations
+// that are reduced to zero are removed from the entry and recorded as self-trade cancellations,
+// and an allocation that is only partially reduced is recorded as a partial one so that the
+// contract can be told about the reduction. `quantity` must not exceed the total quantity of the
+// account's allocations.
+func (c *CachedSortedOrderBookEntries) ReduceAccountQuantity(account string, quantity sdk.Dec) {
+	if !quantity.IsPositive() {
+		return
+	}
+	currentEntry := c.CachedEntries[c.currentPtr].GetOrderEntry()
+	c.currentChanged = true
+	remaining := quantity
+	newAllocations := []*Allocation{}
+	for _, a := range currentEntry.Allocations {
+		if a.Account != account || !remaining.IsPositive() {
+			newAllocations = append(newAllocations, a)
+			continue
+		}
+		if remaining.LT(a.Quantity) {
+			reduced := AllocationToSettle(a)
+			reduced.Amount = remaining
+			c.pendingSelfTradeCancellations = append(c.pendingSelfTradeCancellations, SelfTradeCancellation{
+				ToSettle: reduced,
+				Price:    currentEntry.Price,
+				Partial:  true,
+			})
+			a.Quantity = a.Quantity.Sub(remaining)
+			remaining = sdk.ZeroDec()
+			newAllocations = append(newAllocations, a)
+			continue
+		}
+		remaining = remaining.Sub(a.Quantity)
+		c.pendingSelfTradeCancellations = append(c.pendingSelfTradeCancellations, SelfTradeCancellation{
+			ToSettle: AllocationToSettle(a),
+			Price:    currentEntry.Price,
+		})
+	}
+	currentEntry.Quantity = currentEntry.Quantity.Sub(quantity.Sub(remaining))
+	currentEntry.Allocations = newAllocations
+}
+
+// TakeSelfTradeCancellations returns the orders removed by self-trade prevention that have been
+// flushed since the last call.
+func (c *CachedSortedOrderBookEntries) TakeSelfTradeCancellations() []SelfTradeCancellation {
+	res := c.selfTradeCancellations
+	c.selfTradeCancellations = nil
+	return res
+}
+
 func (c *CachedSortedOrderBookEntries) load(ctx sdk.Context) {
 	var loaded []OrderBookEntry
 	if len(c.CachedEntries) == 0 {
@@ -50,7 +154,8 @@ func (c *CachedSortedOrderBookEntries) load(ctx sdk.Context) {
 }
 
 // Reduce quantity of the order book entry currently being pointed at by the specified quantity.
-// Also remove/reduce allocations of the order book entry in FIFO order. If the order book entry
+// Also remove/reduce allocations of the order book entry in FIFO order, or as decided by the
+// allocator if one is set. If the order book entry
 // does not have enough quantity to settle against, the returned `settled` value will equal to
 // the quantity of the order book entry; otherwise it will equal to the specified quantity.
 func (c *CachedSortedOrderBookEntries) SettleQuantity(_ sdk.Context, quantity sdk.Dec) (res []ToSettle, settled sdk.Dec) {
@@ -68,6 +173,10 @@ func (c *CachedSortedOrderBookEntries) SettleQuantity(_ sdk.Context, quantity sd
 		return res, settled
 	}
 
+	if c.allocator != nil {
+		return c.settleQuantityWithAllocator(currentEntry, quantity)
+	}
+
 	settled = sdk.ZeroDec()
 	newFirstAllocationIdx := 0
 	for idx, a := range currentEntry.Allocations {
@@ -95,11 +204,34 @@ func (c *CachedSortedOrderBookEntries) SettleQuantity(_ sdk.Context, quantity sd
 	return res, settled
 }
 
+func (c *CachedSortedOrderBookEntries) settleQuantityWithAllocator(currentEntry *OrderEntry, quantity sdk.Dec) (res []ToSettle, settled sdk.Dec) {
+	amounts := map[uint64]sdk.Dec{}
+	for _, toSettle := range c.allocator(currentEntry.Allocations, quantity) {
+		if toSettle.Amount.IsPositive() {
+			res = append(res, toSettle)
+			amounts[toSettle.OrderID] = toSettle.Amount
+		}
+	}
+	newAllocations := []*Allocation{}
+	for _, a := range currentEntry.Allocations {
+		if amount, ok := amounts[a.OrderId]; ok {
+			a.Quantity = a.Quantity.Sub(amount)
+		}
+		if a.Quantity.IsPositive() {
+			newAllocations = append(newAllocations, a)
+		}
+	}
+	currentEntry.Quantity = currentEntry.Quantity.Sub(quantity)
+	currentEntry.Allocations = newAllocations
+	return res, quantity
+}
+
 // Discard all dirty changes and reload
 func (c *CachedSortedOrderBookEntries) Refresh(ctx sdk.Context) {
 	c.CachedEntries = c.loader(ctx, sdk.ZeroDec(), false)
 	c.currentPtr = 0
 	c.currentChanged = false
+	c.pendingSelfTradeCancellations = nil
 }
 
 func (c *CachedSortedOrderBookEntries) Flush(ctx sdk.Context) {
@@ -121,6 +253,8 @@ func (c *CachedSortedOrderBookEntries) Flush(ctx sdk.Context) {
 	c.CachedEntries = c.CachedEntries[c.currentPtr:]
 	c.currentPtr = 0
 	c.currentChanged = false
+	c.selfTradeCancellations = append(c.selfTradeCancellations, c.pendingSelfTradeCancellations...)
+	c.pendingSelfTradeCancellations = nil
 }
 
 // Next will only move on to the next order if the current order quantity hits zero.
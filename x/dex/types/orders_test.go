@@ -164,3 +164,51 @@ func TestRefreshAndFlush(t *testing.T) {
 	require.Equal(t, TestEntryOne.Price, entry.GetPrice())
 	require.Equal(t, TestEntryOne, *entry.GetOrderEntry())
 }
+
+func TestReduceAccountQuantity(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	populateEntries(ctx, dexkeeper)
+	cache := getCachedSortedOrderBookEntries(dexkeeper)
+	_ = cache.Next(ctx)
+	quantity, latestOrderID := cache.AccountQuantity("ghi")
+	require.Equal(t, sdk.NewDec(2), quantity)
+	require.Equal(t, uint64(3), latestOrderID)
+	quantity, _ = cache.AccountQuantity("abc")
+	require.True(t, quantity.IsZero())
+
+	// cancellations are discarded by refresh
+	cache.ReduceAccountQuantity("ghi", sdk.OneDec())
+	cache.Refresh(ctx)
+	cache.Flush(ctx)
+	require.Empty(t, cache.TakeSelfTradeCancellations())
+
+	// a partial reduction keeps the allocation, and is committed by flush
+	entry := cache.Next(ctx)
+	require.Equal(t, TestEntryTwo.Price, entry.GetPrice())
+	cache.ReduceAccountQuantity("ghi", sdk.OneDec())
+	require.Equal(t, sdk.OneDec(), entry.GetOrderEntry().Quantity)
+	require.Equal(t, sdk.OneDec(), entry.GetOrderEntry().Allocations[0].Quantity)
+	require.Empty(t, cache.TakeSelfTradeCancellations())
+	cache.Flush(ctx)
+	require.Equal(t, []types.SelfTradeCancellation{{
+		ToSettle: types.ToSettle{OrderID: 3, Account: "ghi", Amount: sdk.OneDec()},
+		Price:    TestEntryTwo.Price,
+		Partial:  true,
+	}}, cache.TakeSelfTradeCancellations())
+
+	// reducing the rest removes the allocation
+	cache.Refresh(ctx)
+	entry = cache.Next(ctx)
+	cache.ReduceAccountQuantity("ghi", sdk.OneDec())
+	require.True(t, entry.GetOrderEntry().Quantity.IsZero())
+	require.Empty(t, entry.GetOrderEntry().Allocations)
+	cache.Flush(ctx)
+	require.Equal(t, []types.SelfTradeCancellation{{
+		ToSettle: types.ToSettle{OrderID: 3, Account: "ghi", Amount: sdk.OneDec()},
+		Price:    TestEntryTwo.Price,
+	}}, cache.TakeSelfTradeCancellations())
+	require.Empty(t, cache.TakeSelfTradeCancellations())
+	cache.Refresh(ctx)
+	entry = cache.Next(ctx)
+	require.Equal(t, TestEntryOne.Price, entry.GetPrice())
+}
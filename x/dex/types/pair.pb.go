@@ -29,6 +29,27 @@ type Pair struct {
 	AssetDenom       string                                  `protobuf:"bytes,2,opt,name=assetDenom,proto3" json:"asset_denom"`
 	PriceTicksize    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=priceTicksize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_tick_size"`
 	QuantityTicksize *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quantityTicksize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity_tick_size"`
+	MatchingPolicy   MatchingPolicy                          `protobuf:"varint,5,opt,name=matchingPolicy,proto3,enum=seiprotocol.seichain.dex.MatchingPolicy" json:"matching_policy"`
+	// fraction of the matched quantity at a price level that is allocated to the earliest
+	// maker before the rest is split pro-rata. Only used by PRO_RATA_WITH_TOP_OF_QUEUE_BONUS.
+	TopOfQueueBonus *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=topOfQueueBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"top_of_queue_bonus"`
+	// how orders of the same account that would match each other are handled. Must be NONE for
+	// batch auction pairs.
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,7,opt,name=selfTradePrevention,proto3,enum=seiprotocol.seichain.dex.SelfTradePrevention" json:"self_trade_prevention"`
+	// oracle denom whose exchange rate is the reference price of the pair. Limit orders priced
+	// outside of the oracle price band are rejected, and market orders can't be filled outside of
+	// it. Orders are not checked if this is unset or the oracle has no price for the denom.
+	OracleDenom string `protobuf:"bytes,8,opt,name=oracleDenom,proto3" json:"oracle_denom"`
+	// max deviation of order prices from the reference price, as a fraction of it
+	OraclePriceBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=oraclePriceBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"oracle_price_band"`
+	// if set, the reference price is the oracle TWAP over this lookback instead of the latest
+	// exchange rate
+	OracleTwapLookbackSeconds uint64 `protobuf:"varint,10,opt,name=oracleTwapLookbackSeconds,proto3" json:"oracle_twap_lookback_seconds"`
+	// if set, the pair is matched as a frequent batch auction: all crossing limit and market
+	// orders of a block are filled at the single price that maximizes the executed quantity,
+	// instead of crossing pairwise at their own prices. Self-trade prevention is not supported by
+	// batch auctions, so orders of the same account can fill each other.
+	BatchAuction bool `protobuf:"varint,11,opt,name=batchAuction,proto3" json:"batch_auction"`
 }
 
 func (m *Pair) Reset()         { *m = Pair{} }
@@ -78,6 +99,41 @@ func (m *Pair) GetAssetDenom() string {
 	return ""
 }
 
+func (m *Pair) GetMatchingPolicy() MatchingPolicy {
+	if m != nil {
+		return m.MatchingPolicy
+	}
+	return MatchingPolicy_FIFO
+}
+
+func (m *Pair) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_NONE
+}
+
+func (m *Pair) GetOracleDenom() string {
+	if m != nil {
+		return m.OracleDenom
+	}
+	return ""
+}
+
+func (m *Pair) GetOracleTwapLookbackSeconds() uint64 {
+	if m != nil {
+		return m.OracleTwapLookbackSeconds
+	}
+	return 0
+}
+
+func (m *Pair) GetBatchAuction() bool {
+	if m != nil {
+		return m.BatchAuction
+	}
+	return false
+}
+
 type BatchContractPair struct {
 	ContractAddr string  `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_addr"`
 	Pairs        []*Pair `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs"`
@@ -138,30 +194,46 @@ func init() {
 func init() { proto.RegisterFile("dex/pair.proto", fileDescriptor_d4350ebee878f69a) }
 
 var fileDescriptor_d4350ebee878f69a = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x4f, 0xf2, 0x40,
-	0x18, 0xa7, 0xc0, 0xfb, 0x26, 0x1c, 0x22, 0xd2, 0x38, 0x34, 0x0e, 0x2d, 0x61, 0x30, 0x2c, 0xf4,
-	0x12, 0x8d, 0xb3, 0xa1, 0x92, 0xb8, 0x9a, 0xc6, 0xc9, 0x85, 0x1c, 0x77, 0x97, 0x72, 0x41, 0x7a,
-	0xf5, 0xee, 0x48, 0xc0, 0xaf, 0xe0, 0xe2, 0x77, 0x72, 0x61, 0x64, 0x34, 0x0e, 0x8d, 0x81, 0xad,
-	0x9f, 0xc2, 0xdc, 0x95, 0x0a, 0xc4, 0x38, 0x38, 0xdd, 0x3d, 0xbf, 0xe7, 0xf7, 0xa7, 0x7d, 0x9e,
-	0x03, 0xc7, 0x84, 0xce, 0x61, 0x82, 0x98, 0xf0, 0x13, 0xc1, 0x15, 0xb7, 0x1d, 0x49, 0x99, 0xb9,
-	0x61, 0xfe, 0xe8, 0x4b, 0xca, 0xf0, 0x18, 0xb1, 0xd8, 0x27, 0x74, 0x7e, 0x76, 0x1a, 0xf1, 0x88,
-	0x9b, 0x16, 0xd4, 0xb7, 0x9c, 0xdf, 0x79, 0x2b, 0x83, 0xea, 0x1d, 0x62, 0xc2, 0x86, 0x00, 0x24,
-	0x82, 0x61, 0x3a, 0xa0, 0x31, 0x9f, 0x3a, 0x56, 0xdb, 0xea, 0xd6, 0x82, 0x66, 0x96, 0x7a, 0x75,
-	0x83, 0x0e, 0x89, 0x86, 0xc3, 0x3d, 0x8a, 0x16, 0x20, 0x29, 0xa9, 0xca, 0x05, 0xe5, 0x9d, 0xc0,
-	0xa0, 0x85, 0x60, 0x47, 0xb1, 0x23, 0xd0, 0x30, 0xf2, 0x7b, 0x86, 0x27, 0x92, 0x3d, 0x53, 0xa7,
-	0x62, 0x34, 0xfd, 0x65, 0xea, 0x59, 0x1f, 0xa9, 0x77, 0x1e, 0x31, 0x35, 0x9e, 0x8d, 0x7c, 0xcc,
-	0xa7, 0x10, 0x73, 0x39, 0xe5, 0x72, 0x7b, 0xf4, 0x24, 0x99, 0x40, 0xb5, 0x48, 0xa8, 0xf4, 0x07,
-	0x14, 0x67, 0xa9, 0xd7, 0xcc, 0x3f, 0x49, 0x31, 0x3c, 0x19, 0x6a, 0xa3, 0xf0, 0xd0, 0xd7, 0x4e,
-	0xc0, 0xc9, 0xd3, 0x0c, 0xc5, 0x8a, 0xa9, 0xc5, 0x77, 0x56, 0xd5, 0x64, 0x0d, 0xfe, 0x9c, 0x65,
-	0x17, 0x4e, 0x7b, 0x71, 0x3f, 0xdc, 0x3b, 0x2f, 0x16, 0x68, 0x05, 0x48, 0xe1, 0xf1, 0x0d, 0x8f,
-	0x95, 0x40, 0x58, 0x99, 0x91, 0x5e, 0x81, 0x23, 0xbc, 0xad, 0xfb, 0x84, 0x88, 0xed, 0x50, 0x5b,
-	0x59, 0xea, 0x35, 0x0a, 0x7c, 0x88, 0x08, 0x11, 0xe1, 0x01, 0xcd, 0xbe, 0x06, 0xff, 0xf4, 0x42,
-	0xa5, 0x53, 0x6e, 0x57, 0xba, 0xf5, 0x0b, 0xd7, 0xff, 0x6d, 0xa5, 0xbe, 0x4e, 0x09, 0x6a, 0x59,
-	0xea, 0xe5, 0x82, 0x30, 0x3f, 0x82, 0xdb, 0xe5, 0xda, 0xb5, 0x56, 0x6b, 0xd7, 0xfa, 0x5c, 0xbb,
-	0xd6, 0xeb, 0xc6, 0x2d, 0xad, 0x36, 0x6e, 0xe9, 0x7d, 0xe3, 0x96, 0x1e, 0x7a, 0x7b, 0xff, 0x2d,
-	0x29, 0xeb, 0x15, 0xb6, 0xa6, 0x30, 0xbe, 0x70, 0x0e, 0xf5, 0x8b, 0x32, 0x23, 0x18, 0xfd, 0x37,
-	0xfd, 0xcb, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x03, 0x32, 0x50, 0x65, 0x02, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0xdb, 0x30,
+	0x14, 0x26, 0xfc, 0xc6, 0x05, 0x0a, 0x66, 0x93, 0x02, 0x9a, 0x92, 0x8a, 0xc3, 0xd4, 0x4b, 0x13,
+	0x89, 0x89, 0xf3, 0x44, 0x40, 0xda, 0x65, 0xd3, 0xba, 0xc0, 0x69, 0x87, 0x45, 0xae, 0x6d, 0x8a,
+	0xd5, 0xc6, 0x0e, 0xb1, 0x33, 0x7e, 0xfc, 0x0b, 0xbb, 0xec, 0xcf, 0xe2, 0xc8, 0x71, 0xda, 0x21,
+	0x9a, 0xe0, 0x96, 0xbf, 0x62, 0xb2, 0x9d, 0x42, 0x0b, 0xeb, 0x81, 0x9d, 0x62, 0x7f, 0xef, 0xfb,
+	0xbe, 0x97, 0xe7, 0xf7, 0x6c, 0xb0, 0x4e, 0xe8, 0x65, 0x98, 0x21, 0x96, 0x07, 0x59, 0x2e, 0x94,
+	0x80, 0xae, 0xa4, 0xcc, 0xac, 0xb0, 0x18, 0x06, 0x92, 0x32, 0x7c, 0x86, 0x18, 0x0f, 0x08, 0xbd,
+	0xdc, 0x79, 0xd5, 0x17, 0x7d, 0x61, 0x42, 0xa1, 0x5e, 0x59, 0xfe, 0x4e, 0x53, 0xeb, 0x29, 0x2f,
+	0x52, 0x69, 0x81, 0xdd, 0xdb, 0x25, 0x30, 0xdf, 0x45, 0x2c, 0x87, 0x21, 0x00, 0x59, 0xce, 0x30,
+	0x3d, 0xa2, 0x5c, 0xa4, 0xae, 0xd3, 0x72, 0xda, 0x2b, 0x51, 0xb3, 0x2a, 0xfd, 0x86, 0x41, 0x13,
+	0xa2, 0xe1, 0x78, 0x8c, 0xa2, 0x05, 0x48, 0x4a, 0xaa, 0xac, 0x60, 0xf6, 0x51, 0x60, 0xd0, 0x91,
+	0xe0, 0x91, 0x02, 0xfb, 0x60, 0xcd, 0xc8, 0x4f, 0x18, 0x1e, 0x48, 0x76, 0x4d, 0xdd, 0x39, 0xa3,
+	0x39, 0xb8, 0x29, 0x7d, 0xe7, 0x77, 0xe9, 0xbf, 0xed, 0x33, 0x75, 0x56, 0xf4, 0x02, 0x2c, 0xd2,
+	0x10, 0x0b, 0x99, 0x0a, 0x59, 0x7f, 0x3a, 0x92, 0x0c, 0x42, 0x75, 0x95, 0x51, 0x19, 0x1c, 0x51,
+	0x5c, 0x95, 0x7e, 0xd3, 0xfe, 0x92, 0x62, 0x78, 0x90, 0x68, 0xa3, 0x78, 0xd2, 0x17, 0x66, 0x60,
+	0xe3, 0xbc, 0x40, 0x5c, 0x31, 0x75, 0xf5, 0x90, 0x6b, 0xde, 0xe4, 0x3a, 0x7a, 0x71, 0x2e, 0x38,
+	0x72, 0x1a, 0x4b, 0xf7, 0xcc, 0x1d, 0x52, 0xb0, 0x9e, 0x22, 0x85, 0xcf, 0x18, 0xef, 0x77, 0xc5,
+	0x90, 0xe1, 0x2b, 0x77, 0xa1, 0xe5, 0xb4, 0xd7, 0xf7, 0xda, 0xc1, 0xb4, 0xfe, 0x04, 0x9f, 0x26,
+	0xf8, 0xd1, 0x96, 0xae, 0x6b, 0xe4, 0x91, 0x64, 0x06, 0x8c, 0x9f, 0x98, 0x42, 0x0e, 0x9a, 0x4a,
+	0x64, 0x9f, 0x4f, 0xbf, 0x14, 0xb4, 0xa0, 0x91, 0xe0, 0x85, 0x74, 0x17, 0xff, 0xb7, 0x2e, 0x25,
+	0xb2, 0x44, 0x9c, 0x26, 0xe7, 0xda, 0x2a, 0xe9, 0x69, 0xaf, 0xf8, 0xa9, 0x39, 0xbc, 0x06, 0x5b,
+	0x92, 0x0e, 0x4f, 0x4f, 0x72, 0x44, 0x68, 0x37, 0xa7, 0xdf, 0x29, 0x57, 0x4c, 0x70, 0x77, 0xc9,
+	0xd4, 0xd6, 0x99, 0x5e, 0xdb, 0xf1, 0x73, 0x51, 0xb4, 0x5d, 0x95, 0xfe, 0x6b, 0xed, 0x96, 0x28,
+	0x1d, 0x49, 0xb2, 0x87, 0x50, 0xfc, 0xaf, 0x24, 0x70, 0x0f, 0x34, 0x44, 0x8e, 0xf0, 0xb0, 0x1e,
+	0xc8, 0x65, 0x53, 0xe7, 0x46, 0x55, 0xfa, 0xab, 0x16, 0xae, 0x07, 0x6c, 0x9c, 0x04, 0x53, 0xd0,
+	0xb4, 0xdb, 0xae, 0x9e, 0x87, 0x08, 0x71, 0xe2, 0xae, 0x18, 0xdd, 0xe1, 0x8b, 0xcf, 0x67, 0xb3,
+	0xce, 0x62, 0x47, 0xad, 0x87, 0x38, 0x89, 0x9f, 0x7a, 0xc3, 0x6f, 0x60, 0xdb, 0x42, 0x27, 0x17,
+	0x28, 0xfb, 0x28, 0xc4, 0xa0, 0x87, 0xf0, 0xe0, 0x98, 0x62, 0xc1, 0x89, 0x74, 0x41, 0xcb, 0x69,
+	0xcf, 0x47, 0xad, 0xaa, 0xf4, 0xdf, 0xd4, 0x56, 0xea, 0x02, 0x65, 0xc9, 0xb0, 0xa6, 0x25, 0xd2,
+	0xf2, 0xe2, 0xe9, 0x16, 0x70, 0x1f, 0xac, 0xf6, 0xf4, 0x00, 0x1c, 0x14, 0xd8, 0x9c, 0x7b, 0xa3,
+	0xe5, 0xb4, 0x97, 0xa3, 0xcd, 0xaa, 0xf4, 0xd7, 0x0c, 0x9e, 0x20, 0x1b, 0x88, 0x27, 0x68, 0xbb,
+	0x3f, 0x1c, 0xb0, 0x19, 0x69, 0xe0, 0x50, 0x70, 0x95, 0x23, 0xac, 0xcc, 0xfd, 0xde, 0x07, 0xab,
+	0xb8, 0xde, 0x1f, 0x10, 0x92, 0xd7, 0x37, 0xdc, 0x98, 0x8d, 0xf0, 0x04, 0x11, 0x92, 0xc7, 0x13,
+	0x34, 0xf8, 0x1e, 0x2c, 0xe8, 0xe7, 0x46, 0xba, 0xb3, 0xad, 0xb9, 0x76, 0x63, 0xcf, 0x9b, 0xde,
+	0x74, 0x9d, 0x25, 0x5a, 0xa9, 0x4a, 0xdf, 0x0a, 0x62, 0xfb, 0x89, 0x3e, 0xdc, 0xdc, 0x79, 0xce,
+	0xed, 0x9d, 0xe7, 0xfc, 0xb9, 0xf3, 0x9c, 0x9f, 0xf7, 0xde, 0xcc, 0xed, 0xbd, 0x37, 0xf3, 0xeb,
+	0xde, 0x9b, 0xf9, 0xda, 0x19, 0x6b, 0x86, 0xa4, 0xac, 0x33, 0xb2, 0x35, 0x1b, 0xe3, 0x1b, 0x5e,
+	0x86, 0xfa, 0xbd, 0x32, 0x7d, 0xe9, 0x2d, 0x9a, 0xf8, 0xbb, 0xbf, 0x03, 0x00, 0x91, 0x15, 0xb9,
+	0xa5, 0x03, 0x05, 0x00, 0x00,
 }
 
 func (m *Pair) Marshal() (dAtA []byte, err error) {
@@ -184,6 +256,62 @@ func (m *Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchAuction {
+		i--
+		if m.BatchAuction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.OracleTwapLookbackSeconds != 0 {
+		i = encodeVarintPair(dAtA, i, uint64(m.OracleTwapLookbackSeconds))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.OraclePriceBand != nil {
+		{
+			size := m.OraclePriceBand.Size()
+			i -= size
+			if _, err := m.OraclePriceBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPair(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.OracleDenom) > 0 {
+		i -= len(m.OracleDenom)
+		copy(dAtA[i:], m.OracleDenom)
+		i = encodeVarintPair(dAtA, i, uint64(len(m.OracleDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintPair(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TopOfQueueBonus != nil {
+		{
+			size := m.TopOfQueueBonus.Size()
+			i -= size
+			if _, err := m.TopOfQueueBonus.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPair(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MatchingPolicy != 0 {
+		i = encodeVarintPair(dAtA, i, uint64(m.MatchingPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.QuantityTicksize != nil {
 		{
 			size := m.QuantityTicksize.Size()
@@ -302,6 +430,30 @@ func (m *Pair) Size() (n int) {
 		l = m.QuantityTicksize.Size()
 		n += 1 + l + sovPair(uint64(l))
 	}
+	if m.MatchingPolicy != 0 {
+		n += 1 + sovPair(uint64(m.MatchingPolicy))
+	}
+	if m.TopOfQueueBonus != nil {
+		l = m.TopOfQueueBonus.Size()
+		n += 1 + l + sovPair(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovPair(uint64(m.SelfTradePrevention))
+	}
+	l = len(m.OracleDenom)
+	if l > 0 {
+		n += 1 + l + sovPair(uint64(l))
+	}
+	if m.OraclePriceBand != nil {
+		l = m.OraclePriceBand.Size()
+		n += 1 + l + sovPair(uint64(l))
+	}
+	if m.OracleTwapLookbackSeconds != 0 {
+		n += 1 + sovPair(uint64(m.OracleTwapLookbackSeconds))
+	}
+	if m.BatchAuction {
+		n += 2
+	}
 	return n
 }
 
@@ -495,6 +647,187 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchingPolicy", wireType)
+			}
+			m.MatchingPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchingPolicy |= MatchingPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopOfQueueBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TopOfQueueBonus = &v
+			if err := m.TopOfQueueBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePriceBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.OraclePriceBand = &v
+			if err := m.OraclePriceBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleTwapLookbackSeconds", wireType)
+			}
+			m.OracleTwapLookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleTwapLookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchAuction = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPair(dAtA[iNdEx:])
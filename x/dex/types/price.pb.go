@@ -134,45 +134,102 @@ func (m *PriceCandlestick) GetEndTimestamp() uint64 {
 	return 0
 }
 
+type Candle struct {
+	Interval    CandleInterval   `protobuf:"varint,1,opt,name=interval,proto3,enum=seiprotocol.seichain.dex.CandleInterval" json:"interval"`
+	Candlestick PriceCandlestick `protobuf:"bytes,2,opt,name=candlestick,proto3" json:"candlestick"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd5d1c9d490efb8c, []int{2}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetInterval() CandleInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return CandleInterval_ONE_MINUTE
+}
+
+func (m *Candle) GetCandlestick() PriceCandlestick {
+	if m != nil {
+		return m.Candlestick
+	}
+	return PriceCandlestick{}
+}
+
 func init() {
 	proto.RegisterType((*Price)(nil), "seiprotocol.seichain.dex.Price")
 	proto.RegisterType((*PriceCandlestick)(nil), "seiprotocol.seichain.dex.PriceCandlestick")
+	proto.RegisterType((*Candle)(nil), "seiprotocol.seichain.dex.Candle")
 }
 
 func init() { proto.RegisterFile("dex/price.proto", fileDescriptor_bd5d1c9d490efb8c) }
 
 var fileDescriptor_bd5d1c9d490efb8c = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0xfc, 0x83, 0x2e, 0xa5, 0x85, 0x85, 0x83, 0x15, 0x09, 0x3b, 0xe4, 0x80, 0x72,
-	0x89, 0x2d, 0x5a, 0xb8, 0x00, 0x27, 0x17, 0x51, 0x55, 0xe2, 0x50, 0x2d, 0x9c, 0x90, 0x50, 0xe4,
-	0xac, 0x47, 0xf6, 0xaa, 0xf6, 0xae, 0x95, 0x75, 0x21, 0x7d, 0x02, 0xae, 0x3c, 0x56, 0x8f, 0x3d,
-	0x22, 0x0e, 0x16, 0x4a, 0x6e, 0x3e, 0x22, 0x1e, 0x00, 0xed, 0x38, 0xa1, 0x01, 0x09, 0x24, 0x73,
-	0x99, 0xd9, 0xf1, 0x7c, 0xdf, 0x4f, 0x9e, 0xf1, 0x9a, 0xec, 0x47, 0xb0, 0xf0, 0xf3, 0xb9, 0xe0,
-	0xe0, 0xe5, 0x73, 0x55, 0x28, 0x6a, 0x6b, 0x10, 0x78, 0xe2, 0x2a, 0xf5, 0x34, 0x08, 0x9e, 0x84,
-	0x42, 0x7a, 0x11, 0x2c, 0x06, 0xf7, 0x63, 0x15, 0x2b, 0x6c, 0xf9, 0xe6, 0x54, 0xeb, 0x07, 0x7b,
-	0x08, 0x08, 0xc5, 0xbc, 0xae, 0x47, 0x9f, 0xda, 0xa4, 0x77, 0x6a, 0x78, 0x34, 0x24, 0x03, 0x2d,
-	0xc3, 0x5c, 0x27, 0xaa, 0x78, 0x2b, 0x32, 0xd0, 0x45, 0x98, 0xe5, 0x27, 0xf2, 0x0d, 0x70, 0x25,
-	0x23, 0x6d, 0x5b, 0x43, 0x6b, 0xdc, 0x0d, 0x1e, 0x56, 0xa5, 0xfb, 0x60, 0xa3, 0x9a, 0x16, 0x1b,
-	0xd9, 0x54, 0xc8, 0xa9, 0xae, 0x85, 0xec, 0x1f, 0x10, 0xfa, 0x9e, 0xf4, 0xf0, 0xdd, 0xed, 0xf6,
-	0xd0, 0x1a, 0xef, 0x04, 0xc7, 0x97, 0xa5, 0xdb, 0xfa, 0x5a, 0xba, 0x8f, 0x62, 0x51, 0x24, 0xe7,
-	0x33, 0x8f, 0xab, 0xcc, 0xe7, 0x4a, 0x67, 0x4a, 0xaf, 0xd3, 0x44, 0x47, 0x67, 0x7e, 0x71, 0x91,
-	0x83, 0xf6, 0x5e, 0x02, 0xaf, 0x4a, 0xb7, 0xb6, 0x7f, 0x2f, 0xdd, 0xdd, 0x8b, 0x30, 0x4b, 0x9f,
-	0x8d, 0xb0, 0x1c, 0xb1, 0xfa, 0x31, 0x7d, 0x41, 0xba, 0x66, 0x32, 0xbb, 0x33, 0xb4, 0xc6, 0xb7,
-	0x0e, 0x1c, 0xef, 0x6f, 0xab, 0xf1, 0x4e, 0x43, 0x31, 0x0f, 0x6e, 0x56, 0xa5, 0x8b, 0x7a, 0x86,
-	0x71, 0xf4, 0xa3, 0x43, 0xee, 0xe0, 0x26, 0x8e, 0x42, 0x19, 0xa5, 0xa0, 0x0b, 0xc1, 0xcf, 0xe8,
-	0x73, 0xb2, 0x37, 0x83, 0x58, 0xc8, 0x5f, 0xc3, 0xac, 0x17, 0x71, 0xaf, 0x2a, 0xdd, 0x7d, 0xec,
-	0x5c, 0x6f, 0x81, 0xfd, 0x21, 0xa5, 0x4f, 0xc9, 0x2e, 0xc8, 0xe8, 0xda, 0xda, 0x46, 0xeb, 0xdd,
-	0xaa, 0x74, 0x6f, 0x83, 0x8c, 0xb6, 0x8c, 0xbf, 0xc9, 0xe8, 0x2b, 0xd2, 0x55, 0x39, 0x48, 0x1c,
-	0x63, 0x27, 0x38, 0x68, 0xb4, 0x20, 0x74, 0x32, 0x8c, 0x86, 0x93, 0x88, 0x38, 0xb1, 0xbb, 0xff,
-	0xc3, 0x31, 0x4e, 0x86, 0x91, 0x1e, 0x91, 0x4e, 0xaa, 0x3e, 0xda, 0x3d, 0xc4, 0x3c, 0x6e, 0x84,
-	0x31, 0x46, 0x66, 0x02, 0x3d, 0x21, 0x3d, 0x9e, 0x2a, 0x0d, 0x76, 0x1f, 0x31, 0x87, 0xcd, 0x3e,
-	0x3b, 0x5a, 0x59, 0x9d, 0xe8, 0x6b, 0xd2, 0xff, 0xa0, 0xd2, 0xf3, 0x0c, 0xec, 0x1b, 0xc8, 0x7a,
-	0xd2, 0x88, 0xb5, 0xf6, 0xb2, 0x75, 0x0e, 0x8e, 0x2f, 0x97, 0x8e, 0x75, 0xb5, 0x74, 0xac, 0x6f,
-	0x4b, 0xc7, 0xfa, 0xbc, 0x72, 0x5a, 0x57, 0x2b, 0xa7, 0xf5, 0x65, 0xe5, 0xb4, 0xde, 0x4d, 0xb6,
-	0x98, 0x1a, 0xc4, 0x64, 0x73, 0x97, 0xb0, 0xc0, 0xcb, 0xe4, 0x2f, 0x7c, 0xf3, 0x3b, 0x21, 0x7e,
-	0xd6, 0xc7, 0xfe, 0xe1, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xe7, 0x11, 0xeb, 0xa3, 0x03,
-	0x00, 0x00,
+	// 536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xd6, 0x1f, 0x6c, 0x6e, 0xe9, 0xc0, 0xe3, 0x10, 0x55, 0x22, 0x29, 0x3d, 0xa0, 0x0a,
+	0xa9, 0x89, 0xe8, 0xe0, 0x02, 0x9c, 0x32, 0xc4, 0x54, 0x89, 0xc3, 0x64, 0x38, 0x21, 0xa1, 0x2a,
+	0x75, 0xac, 0xd6, 0x5a, 0x62, 0x47, 0x75, 0x3a, 0xba, 0xbf, 0x80, 0x2b, 0xff, 0x11, 0xd7, 0x1d,
+	0x77, 0x44, 0x1c, 0x2c, 0xd4, 0xde, 0x72, 0x44, 0xfc, 0x01, 0x28, 0x2f, 0xe9, 0x0f, 0x26, 0x6d,
+	0x52, 0xb9, 0x3c, 0xfb, 0xd9, 0xdf, 0xf7, 0x59, 0xef, 0x7b, 0x2f, 0x41, 0x87, 0x01, 0x9b, 0xbb,
+	0xf1, 0x94, 0x53, 0xe6, 0xc4, 0x53, 0x99, 0x48, 0x6c, 0x2a, 0xc6, 0x61, 0x47, 0x65, 0xe8, 0x28,
+	0xc6, 0xe9, 0xc4, 0xe7, 0xc2, 0x09, 0xd8, 0xbc, 0xf5, 0x68, 0x2c, 0xc7, 0x12, 0xae, 0xdc, 0x6c,
+	0x97, 0xe3, 0x5b, 0x4d, 0x10, 0xf0, 0xf9, 0xb4, 0xc8, 0x41, 0x90, 0x89, 0x59, 0xa4, 0xf2, 0x83,
+	0xce, 0xd7, 0x3d, 0x54, 0x3d, 0xcb, 0x1e, 0xc0, 0x3e, 0x6a, 0x29, 0xe1, 0xc7, 0x6a, 0x22, 0x93,
+	0x8f, 0x3c, 0x62, 0x2a, 0xf1, 0xa3, 0x78, 0x20, 0x3e, 0x30, 0x2a, 0x45, 0xa0, 0x4c, 0xa3, 0x6d,
+	0x74, 0x2b, 0xde, 0x93, 0x54, 0xdb, 0x8f, 0x57, 0xa8, 0x61, 0xb2, 0x82, 0x0d, 0xb9, 0x18, 0xaa,
+	0x1c, 0x48, 0xee, 0x10, 0xc1, 0x9f, 0x51, 0x15, 0x8a, 0x31, 0xf7, 0xda, 0x46, 0xf7, 0xc0, 0x3b,
+	0xbd, 0xd2, 0x76, 0xe9, 0xa7, 0xb6, 0x9f, 0x8e, 0x79, 0x32, 0x99, 0x8d, 0x1c, 0x2a, 0x23, 0x97,
+	0x4a, 0x15, 0x49, 0x55, 0x2c, 0x3d, 0x15, 0x9c, 0xbb, 0xc9, 0x65, 0xcc, 0x94, 0xf3, 0x96, 0xd1,
+	0x54, 0xdb, 0x39, 0xfd, 0xb7, 0xb6, 0x1b, 0x97, 0x7e, 0x14, 0xbe, 0xea, 0x40, 0xda, 0x21, 0xf9,
+	0x31, 0x7e, 0x83, 0x2a, 0x59, 0xa9, 0x66, 0xb9, 0x6d, 0x74, 0xeb, 0x7d, 0xcb, 0xb9, 0xcd, 0x2b,
+	0xe7, 0xcc, 0xe7, 0x53, 0x6f, 0x3f, 0xd5, 0x36, 0xe0, 0x09, 0xc4, 0xce, 0x9f, 0x32, 0x7a, 0x00,
+	0x4e, 0x9c, 0xf8, 0x22, 0x08, 0x99, 0x4a, 0x38, 0x3d, 0xc7, 0xaf, 0x51, 0x73, 0xc4, 0xc6, 0x5c,
+	0xac, 0x8b, 0x29, 0x8c, 0x38, 0x4a, 0xb5, 0x7d, 0x08, 0x37, 0x1b, 0x17, 0xc8, 0x0d, 0x28, 0x7e,
+	0x89, 0x1a, 0x4c, 0x04, 0x1b, 0xea, 0x1e, 0x50, 0x1f, 0xa6, 0xda, 0xbe, 0xcf, 0x44, 0xb0, 0x45,
+	0xfc, 0x07, 0x86, 0xdf, 0xa1, 0x8a, 0x8c, 0x99, 0x80, 0x32, 0x0e, 0xbc, 0xfe, 0x4e, 0x06, 0x01,
+	0x93, 0x40, 0xcc, 0x74, 0x26, 0x7c, 0x3c, 0x31, 0x2b, 0xff, 0xa3, 0x93, 0x31, 0x09, 0x44, 0x7c,
+	0x82, 0xca, 0xa1, 0xfc, 0x62, 0x56, 0x41, 0xe6, 0xf9, 0x4e, 0x32, 0x19, 0x91, 0x64, 0x01, 0x0f,
+	0x50, 0x95, 0x86, 0x52, 0x31, 0xb3, 0x06, 0x32, 0xc7, 0xbb, 0xb5, 0x1d, 0xa8, 0x24, 0x5f, 0xf0,
+	0x7b, 0x54, 0xbb, 0x90, 0xe1, 0x2c, 0x62, 0xe6, 0x3d, 0xd0, 0x7a, 0xb1, 0x93, 0x56, 0xc1, 0x25,
+	0xc5, 0xda, 0xf9, 0x6e, 0xa0, 0x5a, 0xde, 0x71, 0x4c, 0xd0, 0x3e, 0x17, 0x09, 0x9b, 0x5e, 0xf8,
+	0x21, 0xb4, 0xb9, 0xd9, 0xef, 0xde, 0x3e, 0x43, 0x39, 0x67, 0x50, 0xe0, 0xbd, 0x46, 0xaa, 0xed,
+	0x35, 0x9b, 0xac, 0x77, 0xd8, 0x47, 0x75, 0xba, 0x99, 0x27, 0x18, 0x81, 0x7a, 0xff, 0xd9, 0x1d,
+	0xa3, 0x79, 0x63, 0x02, 0xbd, 0xa3, 0xec, 0x23, 0x49, 0xb5, 0xbd, 0x2d, 0x43, 0xb6, 0x13, 0xef,
+	0xf4, 0x6a, 0x61, 0x19, 0xd7, 0x0b, 0xcb, 0xf8, 0xb5, 0xb0, 0x8c, 0x6f, 0x4b, 0xab, 0x74, 0xbd,
+	0xb4, 0x4a, 0x3f, 0x96, 0x56, 0xe9, 0x53, 0x6f, 0xcb, 0x15, 0xc5, 0x78, 0x6f, 0xf5, 0x24, 0x24,
+	0xf0, 0xa6, 0x3b, 0x77, 0xb3, 0x3f, 0x02, 0x18, 0x34, 0xaa, 0xc1, 0xfd, 0xf1, 0xdf, 0x01, 0x00,
+	0x6b, 0x27, 0x59, 0x8c, 0x76, 0x04, 0x00, 0x00,
 }
 
 func (m *Price) Marshal() (dAtA []byte, err error) {
@@ -318,6 +375,44 @@ func (m *PriceCandlestick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Candlestick.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPrice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Interval != 0 {
+		i = encodeVarintPrice(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPrice(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPrice(v)
 	base := offset
@@ -382,6 +477,20 @@ func (m *PriceCandlestick) Size() (n int) {
 	return n
 }
 
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		n += 1 + sovPrice(uint64(m.Interval))
+	}
+	l = m.Candlestick.Size()
+	n += 1 + l + sovPrice(uint64(l))
+	return n
+}
+
 func sovPrice(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -795,6 +904,108 @@ func (m *PriceCandlestick) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= CandleInterval(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candlestick", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Candlestick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPrice(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RentBurnRateWindow is the number of most recent blocks that the rent burn rate of a contract is
+// averaged over
+const RentBurnRateWindow = 100
+
+func NewRentLedger(contractAddr string) RentLedger {
+	return RentLedger{
+		ContractAddr:  contractAddr,
+		SudoUsages:    []SudoRentUsage{},
+		RecentCharges: []BlockRentCharge{},
+	}
+}
+
+// AddCharge records rent charged in the block at `height` and drops the charges of blocks that
+// have fallen out of the burn rate window
+func (l *RentLedger) AddCharge(height int64, amount uint64) {
+	if amount == 0 {
+		return
+	}
+	l.TotalCharged += amount
+	if n := len(l.RecentCharges); n > 0 && l.RecentCharges[n-1].Height == height {
+		l.RecentCharges[n-1].Amount += amount
+	} else {
+		l.RecentCharges = append(l.RecentCharges, BlockRentCharge{Height: height, Amount: amount})
+	}
+	l.pruneCharges(height)
+}
+
+// AddSudoUsage records the gas consumed by a sudo call of type `msgType` and the rent charged for
+// it
+func (l *RentLedger) AddSudoUsage(height int64, msgType string, gasConsumed uint64, rentCharged uint64) {
+	found := false
+	for i, usage := range l.SudoUsages {
+		if usage.MsgType == msgType {
+			l.SudoUsages[i].Calls++
+			l.SudoUsages[i].GasConsumed += gasConsumed
+			l.SudoUsages[i].RentCharged += rentCharged
+			found = true
+			break
+		}
+	}
+	if !found {
+		l.SudoUsages = append(l.SudoUsages, SudoRentUsage{
+			MsgType:     msgType,
+			Calls:       1,
+			GasConsumed: gasConsumed,
+			RentCharged: rentCharged,
+		})
+	}
+	l.AddCharge(height, rentCharged)
+}
+
+// BurnRatePerBlock returns the average rent charged per block over the burn rate window ending at
+// the block at `height`
+func (l RentLedger) BurnRatePerBlock(height int64) sdk.Dec {
+	total := uint64(0)
+	for _, charge := range l.RecentCharges {
+		if charge.Height > height-RentBurnRateWindow {
+			total += charge.Amount
+		}
+	}
+	return sdk.NewDecFromInt(sdk.NewIntFromUint64(total)).QuoInt64(RentBurnRateWindow)
+}
+
+// TotalSudoGasConsumed returns the gas consumed by all sudo calls recorded in the ledger
+func (l RentLedger) TotalSudoGasConsumed() uint64 {
+	total := uint64(0)
+	for _, usage := range l.SudoUsages {
+		total += usage.GasConsumed
+	}
+	return total
+}
+
+func (l *RentLedger) pruneCharges(height int64) {
+	firstInWindow := 0
+	for firstInWindow < len(l.RecentCharges) && l.RecentCharges[firstInWindow].Height <= height-RentBurnRateWindow {
+		firstInWindow++
+	}
+	l.RecentCharges = l.RecentCharges[firstInWindow:]
+}
+
+// LowestThresholdAbove returns the lowest of the low balance thresholds that `rentBalance` is below,
+// or 0 if it isn't below any of them
+func (c RentConfig) LowestThresholdAbove(rentBalance uint64) uint64 {
+	lowest := uint64(0)
+	for _, threshold := range c.LowBalanceThresholds {
+		if rentBalance < threshold && (lowest == 0 || threshold < lowest) {
+			lowest = threshold
+		}
+	}
+	return lowest
+}
+
+func (c RentConfig) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.ContractAddr); err != nil {
+		return fmt.Errorf("invalid contract address %s in rent config", c.ContractAddr)
+	}
+	if err := ValidateRentLowBalanceThresholds(c.LowBalanceThresholds); err != nil {
+		return err
+	}
+	if c.AutoTopup == nil {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(c.AutoTopup.FundingAccount); err != nil {
+		return fmt.Errorf("invalid auto top-up funding account %s", c.AutoTopup.FundingAccount)
+	}
+	if c.AutoTopup.Threshold == 0 || c.AutoTopup.Amount == 0 {
+		return errors.New("auto top-up threshold and amount must be positive")
+	}
+	return nil
+}
+
+func ValidateRentLowBalanceThresholds(thresholds []uint64) error {
+	if len(thresholds) > MaxRentLowBalanceThresholds {
+		return fmt.Errorf("at most %d low balance thresholds are allowed", MaxRentLowBalanceThresholds)
+	}
+	for _, threshold := range thresholds {
+		if threshold == 0 {
+			return errors.New("low balance thresholds must be positive")
+		}
+	}
+	return nil
+}
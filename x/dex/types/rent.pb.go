@@ -0,0 +1,1664 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/rent.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Rent deposited into and charged from the rent balance of a contract
+type RentLedger struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_addr"`
+	// including auto top-ups
+	TotalDeposited uint64          `protobuf:"varint,2,opt,name=totalDeposited,proto3" json:"total_deposited"`
+	TotalCharged   uint64          `protobuf:"varint,3,opt,name=totalCharged,proto3" json:"total_charged"`
+	TotalRefunded  uint64          `protobuf:"varint,4,opt,name=totalRefunded,proto3" json:"total_refunded"`
+	SudoUsages     []SudoRentUsage `protobuf:"bytes,5,rep,name=sudoUsages,proto3" json:"sudo_usages"`
+	// rent charged in each of the recent blocks that rent was charged in, oldest first. Only blocks
+	// within the burn rate window are kept.
+	RecentCharges []BlockRentCharge `protobuf:"bytes,6,rep,name=recentCharges,proto3" json:"recent_charges"`
+	// the lowest low balance threshold that the rent balance has dropped below, or 0 if the rent
+	// balance is above all thresholds
+	LowBalanceThresholdReached uint64 `protobuf:"varint,7,opt,name=lowBalanceThresholdReached,proto3" json:"low_balance_threshold_reached"`
+}
+
+func (m *RentLedger) Reset()         { *m = RentLedger{} }
+func (m *RentLedger) String() string { return proto.CompactTextString(m) }
+func (*RentLedger) ProtoMessage()    {}
+func (*RentLedger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b7f75d2683d900, []int{0}
+}
+func (m *RentLedger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RentLedger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RentLedger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RentLedger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RentLedger.Merge(m, src)
+}
+func (m *RentLedger) XXX_Size() int {
+	return m.Size()
+}
+func (m *RentLedger) XXX_DiscardUnknown() {
+	xxx_messageInfo_RentLedger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RentLedger proto.InternalMessageInfo
+
+func (m *RentLedger) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *RentLedger) GetTotalDeposited() uint64 {
+	if m != nil {
+		return m.TotalDeposited
+	}
+	return 0
+}
+
+func (m *RentLedger) GetTotalCharged() uint64 {
+	if m != nil {
+		return m.TotalCharged
+	}
+	return 0
+}
+
+func (m *RentLedger) GetTotalRefunded() uint64 {
+	if m != nil {
+		return m.TotalRefunded
+	}
+	return 0
+}
+
+func (m *RentLedger) GetSudoUsages() []SudoRentUsage {
+	if m != nil {
+		return m.SudoUsages
+	}
+	return nil
+}
+
+func (m *RentLedger) GetRecentCharges() []BlockRentCharge {
+	if m != nil {
+		return m.RecentCharges
+	}
+	return nil
+}
+
+func (m *RentLedger) GetLowBalanceThresholdReached() uint64 {
+	if m != nil {
+		return m.LowBalanceThresholdReached
+	}
+	return 0
+}
+
+// Gas consumed by and rent charged for the sudo calls of one type to a contract
+type SudoRentUsage struct {
+	MsgType     string `protobuf:"bytes,1,opt,name=msgType,proto3" json:"msg_type"`
+	Calls       uint64 `protobuf:"varint,2,opt,name=calls,proto3" json:"calls"`
+	GasConsumed uint64 `protobuf:"varint,3,opt,name=gasConsumed,proto3" json:"gas_consumed"`
+	RentCharged uint64 `protobuf:"varint,4,opt,name=rentCharged,proto3" json:"rent_charged"`
+}
+
+func (m *SudoRentUsage) Reset()         { *m = SudoRentUsage{} }
+func (m *SudoRentUsage) String() string { return proto.CompactTextString(m) }
+func (*SudoRentUsage) ProtoMessage()    {}
+func (*SudoRentUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b7f75d2683d900, []int{1}
+}
+func (m *SudoRentUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SudoRentUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SudoRentUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SudoRentUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SudoRentUsage.Merge(m, src)
+}
+func (m *SudoRentUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SudoRentUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SudoRentUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SudoRentUsage proto.InternalMessageInfo
+
+func (m *SudoRentUsage) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+func (m *SudoRentUsage) GetCalls() uint64 {
+	if m != nil {
+		return m.Calls
+	}
+	return 0
+}
+
+func (m *SudoRentUsage) GetGasConsumed() uint64 {
+	if m != nil {
+		return m.GasConsumed
+	}
+	return 0
+}
+
+func (m *SudoRentUsage) GetRentCharged() uint64 {
+	if m != nil {
+		return m.RentCharged
+	}
+	return 0
+}
+
+type BlockRentCharge struct {
+	Height int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *BlockRentCharge) Reset()         { *m = BlockRentCharge{} }
+func (m *BlockRentCharge) String() string { return proto.CompactTextString(m) }
+func (*BlockRentCharge) ProtoMessage()    {}
+func (*BlockRentCharge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b7f75d2683d900, []int{2}
+}
+func (m *BlockRentCharge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockRentCharge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockRentCharge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockRentCharge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRentCharge.Merge(m, src)
+}
+func (m *BlockRentCharge) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockRentCharge) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRentCharge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRentCharge proto.InternalMessageInfo
+
+func (m *BlockRentCharge) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockRentCharge) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type RentConfig struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_addr"`
+	// an event is emitted whenever the rent balance drops below one of these
+	LowBalanceThresholds []uint64       `protobuf:"varint,2,rep,packed,name=lowBalanceThresholds,proto3" json:"low_balance_thresholds"`
+	AutoTopup            *RentAutoTopup `protobuf:"bytes,3,opt,name=autoTopup,proto3" json:"auto_topup"`
+}
+
+func (m *RentConfig) Reset()         { *m = RentConfig{} }
+func (m *RentConfig) String() string { return proto.CompactTextString(m) }
+func (*RentConfig) ProtoMessage()    {}
+func (*RentConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b7f75d2683d900, []int{3}
+}
+func (m *RentConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RentConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RentConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RentConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RentConfig.Merge(m, src)
+}
+func (m *RentConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *RentConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RentConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RentConfig proto.InternalMessageInfo
+
+func (m *RentConfig) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *RentConfig) GetLowBalanceThresholds() []uint64 {
+	if m != nil {
+		return m.LowBalanceThresholds
+	}
+	return nil
+}
+
+func (m *RentConfig) GetAutoTopup() *RentAutoTopup {
+	if m != nil {
+		return m.AutoTopup
+	}
+	return nil
+}
+
+// Tops up the rent balance of a contract from a funding account by amount at the end of every block
+// that the rent balance is below threshold in
+type RentAutoTopup struct {
+	FundingAccount string `protobuf:"bytes,1,opt,name=fundingAccount,proto3" json:"funding_account"`
+	Threshold      uint64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold"`
+	Amount         uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *RentAutoTopup) Reset()         { *m = RentAutoTopup{} }
+func (m *RentAutoTopup) String() string { return proto.CompactTextString(m) }
+func (*RentAutoTopup) ProtoMessage()    {}
+func (*RentAutoTopup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b7f75d2683d900, []int{4}
+}
+func (m *RentAutoTopup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RentAutoTopup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RentAutoTopup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RentAutoTopup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RentAutoTopup.Merge(m, src)
+}
+func (m *RentAutoTopup) XXX_Size() int {
+	return m.Size()
+}
+func (m *RentAutoTopup) XXX_DiscardUnknown() {
+	xxx_messageInfo_RentAutoTopup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RentAutoTopup proto.InternalMessageInfo
+
+func (m *RentAutoTopup) GetFundingAccount() string {
+	if m != nil {
+		return m.FundingAccount
+	}
+	return ""
+}
+
+func (m *RentAutoTopup) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *RentAutoTopup) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RentLedger)(nil), "seiprotocol.seichain.dex.RentLedger")
+	proto.RegisterType((*SudoRentUsage)(nil), "seiprotocol.seichain.dex.SudoRentUsage")
+	proto.RegisterType((*BlockRentCharge)(nil), "seiprotocol.seichain.dex.BlockRentCharge")
+	proto.RegisterType((*RentConfig)(nil), "seiprotocol.seichain.dex.RentConfig")
+	proto.RegisterType((*RentAutoTopup)(nil), "seiprotocol.seichain.dex.RentAutoTopup")
+}
+
+func init() { proto.RegisterFile("dex/rent.proto", fileDescriptor_a7b7f75d2683d900) }
+
+var fileDescriptor_a7b7f75d2683d900 = []byte{
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6e, 0xd4, 0x3e,
+	0x10, 0xde, 0xfc, 0x76, 0xdb, 0xfe, 0xd6, 0xed, 0x6e, 0xc1, 0xad, 0xaa, 0xa8, 0x12, 0xeb, 0xb2,
+	0x07, 0x58, 0x84, 0xba, 0x2b, 0x15, 0x21, 0x21, 0x71, 0x6a, 0x8a, 0xc4, 0x05, 0x71, 0x30, 0xed,
+	0x01, 0x84, 0x14, 0xb9, 0xb6, 0xeb, 0x44, 0x64, 0xe3, 0x55, 0xec, 0xa8, 0xed, 0x5b, 0x70, 0x47,
+	0xbc, 0x0b, 0xc7, 0x1e, 0x7b, 0xe4, 0x14, 0xa1, 0xf6, 0x96, 0xa7, 0x40, 0xb6, 0x93, 0xfd, 0x53,
+	0x75, 0x7b, 0xe0, 0x64, 0x67, 0xbe, 0x6f, 0x66, 0x3c, 0xdf, 0xcc, 0x04, 0x74, 0x19, 0xbf, 0x18,
+	0x65, 0x3c, 0xd5, 0xc3, 0x49, 0x26, 0xb5, 0x84, 0xbe, 0xe2, 0xb1, 0xbd, 0x51, 0x99, 0x0c, 0x15,
+	0x8f, 0x69, 0x44, 0xe2, 0x74, 0xc8, 0xf8, 0xc5, 0xee, 0xb6, 0x90, 0x42, 0x5a, 0x68, 0x64, 0x6e,
+	0x8e, 0xdf, 0xff, 0xd1, 0x02, 0x00, 0xf3, 0x54, 0x7f, 0xe0, 0x4c, 0xf0, 0x0c, 0xbe, 0x06, 0x1b,
+	0x54, 0xa6, 0x3a, 0x23, 0x54, 0x1f, 0x32, 0x96, 0xf9, 0xde, 0x9e, 0x37, 0x68, 0x07, 0x8f, 0xcb,
+	0x02, 0x75, 0x6a, 0x7b, 0x48, 0x18, 0xcb, 0xf0, 0x02, 0x0d, 0xbe, 0x05, 0x5d, 0x2d, 0x35, 0x49,
+	0xde, 0xf1, 0x89, 0x54, 0xb1, 0xe6, 0xcc, 0xff, 0x6f, 0xcf, 0x1b, 0xb4, 0x82, 0xad, 0xb2, 0x40,
+	0x9b, 0x16, 0x09, 0x59, 0x0d, 0xe1, 0x3b, 0x54, 0x93, 0xd3, 0x5a, 0x8e, 0x22, 0x92, 0x09, 0xce,
+	0xfc, 0xa6, 0x75, 0xb5, 0x39, 0x9d, 0x2b, 0x75, 0x00, 0x5e, 0xa0, 0xc1, 0x37, 0xc0, 0xc1, 0x98,
+	0x9f, 0xe5, 0x29, 0xe3, 0xcc, 0x6f, 0x59, 0x3f, 0x58, 0x16, 0xc8, 0x65, 0x08, 0xb3, 0x0a, 0xc1,
+	0x8b, 0x44, 0xf8, 0x15, 0x00, 0x95, 0x33, 0x79, 0xa2, 0x88, 0xe0, 0xca, 0x5f, 0xd9, 0x6b, 0x0e,
+	0xd6, 0x0f, 0x9e, 0x0f, 0x97, 0x09, 0x37, 0xfc, 0x94, 0x33, 0x69, 0x24, 0xb2, 0xfc, 0x60, 0xeb,
+	0xaa, 0x40, 0x8d, 0xb2, 0x40, 0xeb, 0x26, 0x44, 0x98, 0xdb, 0x18, 0x78, 0x2e, 0x1e, 0x14, 0xa0,
+	0x93, 0x71, 0xca, 0x53, 0xed, 0x1e, 0xaa, 0xfc, 0x55, 0x9b, 0xe0, 0xc5, 0xf2, 0x04, 0x41, 0x22,
+	0xe9, 0x37, 0x3c, 0xf5, 0x08, 0x76, 0xaa, 0x14, 0x5d, 0x17, 0xa7, 0xaa, 0x5f, 0xe1, 0xc5, 0xb8,
+	0x90, 0x80, 0xdd, 0x44, 0x9e, 0x07, 0x24, 0x21, 0x29, 0xe5, 0xc7, 0x51, 0xc6, 0x55, 0x24, 0x13,
+	0x86, 0x39, 0xa1, 0x11, 0x67, 0xfe, 0x9a, 0x55, 0xe3, 0x69, 0x59, 0xa0, 0x27, 0x89, 0x3c, 0x0f,
+	0x4f, 0x1d, 0x2d, 0xd4, 0x35, 0x2f, 0xcc, 0x1c, 0x11, 0x3f, 0x10, 0xa4, 0xff, 0xcb, 0x03, 0x9d,
+	0x85, 0xf2, 0xe1, 0x33, 0xb0, 0x36, 0x56, 0xe2, 0xf8, 0x72, 0xc2, 0xab, 0xd9, 0xd8, 0x28, 0x0b,
+	0xf4, 0xff, 0x58, 0x89, 0x50, 0x5f, 0x4e, 0x38, 0xae, 0x41, 0x88, 0xc0, 0x0a, 0x25, 0x49, 0xa2,
+	0xaa, 0x41, 0x68, 0x97, 0x05, 0x72, 0x06, 0xec, 0x0e, 0x78, 0x00, 0xd6, 0x05, 0x51, 0x47, 0x32,
+	0x55, 0xf9, 0x78, 0xda, 0xf4, 0x47, 0x65, 0x81, 0x36, 0x04, 0x51, 0x21, 0xad, 0xec, 0x78, 0x9e,
+	0x64, 0x7c, 0xb2, 0xa9, 0x00, 0x75, 0xc3, 0xad, 0x4f, 0x36, 0xd3, 0x89, 0xe1, 0x79, 0x52, 0xff,
+	0x33, 0xd8, 0xbc, 0xa3, 0x2f, 0xec, 0x83, 0xd5, 0x88, 0xc7, 0x22, 0xd2, 0xb6, 0x84, 0x66, 0x00,
+	0xca, 0x02, 0x55, 0x16, 0x5c, 0x9d, 0x86, 0x43, 0xc6, 0x32, 0x4f, 0x75, 0x55, 0x80, 0xe5, 0x38,
+	0x0b, 0xae, 0xce, 0x7e, 0xe9, 0xb9, 0xdd, 0x39, 0x92, 0xe9, 0x59, 0x2c, 0xfe, 0x75, 0x77, 0x3e,
+	0x82, 0xed, 0x7b, 0x3a, 0x60, 0x84, 0x6b, 0x0e, 0x5a, 0xc1, 0x6e, 0x59, 0xa0, 0x9d, 0x7b, 0x1b,
+	0xa8, 0xf0, 0xbd, 0x7e, 0xf0, 0x04, 0xb4, 0x49, 0xae, 0xe5, 0xb1, 0x9c, 0xe4, 0x13, 0x2b, 0xeb,
+	0x83, 0xc3, 0x6d, 0xde, 0x7f, 0x58, 0xd3, 0x83, 0x6e, 0x59, 0x20, 0x60, 0xbc, 0x43, 0x6d, 0xbe,
+	0xf1, 0x2c, 0x52, 0xff, 0xa7, 0x07, 0x3a, 0x0b, 0x64, 0xb3, 0xf4, 0x66, 0xa1, 0xe2, 0x54, 0x1c,
+	0x52, 0x6a, 0xa5, 0x72, 0x15, 0xdb, 0xa5, 0xaf, 0x90, 0x90, 0x38, 0x08, 0xdf, 0xa1, 0xc2, 0x97,
+	0xa0, 0x3d, 0xad, 0xa4, 0x92, 0xb8, 0x53, 0x16, 0x68, 0x66, 0xc4, 0xb3, 0xeb, 0x5c, 0x33, 0x9a,
+	0xcb, 0x9a, 0x11, 0xbc, 0xbf, 0xba, 0xe9, 0x79, 0xd7, 0x37, 0x3d, 0xef, 0xcf, 0x4d, 0xcf, 0xfb,
+	0x7e, 0xdb, 0x6b, 0x5c, 0xdf, 0xf6, 0x1a, 0xbf, 0x6f, 0x7b, 0x8d, 0x2f, 0xfb, 0x22, 0xd6, 0x51,
+	0x7e, 0x3a, 0xa4, 0x72, 0x3c, 0x52, 0x3c, 0xde, 0xaf, 0x85, 0xb0, 0x1f, 0x56, 0x89, 0xd1, 0xc5,
+	0xc8, 0xfc, 0x46, 0xcd, 0x04, 0xab, 0xd3, 0x55, 0x8b, 0xbf, 0xfa, 0x3b, 0x00, 0x2c, 0x68, 0xf6,
+	0xde, 0x5a, 0x05, 0x00, 0x00,
+}
+
+func (m *RentLedger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RentLedger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RentLedger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LowBalanceThresholdReached != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.LowBalanceThresholdReached))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RecentCharges) > 0 {
+		for iNdEx := len(m.RecentCharges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentCharges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SudoUsages) > 0 {
+		for iNdEx := len(m.SudoUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SudoUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TotalRefunded != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.TotalRefunded))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalCharged != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.TotalCharged))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalDeposited != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.TotalDeposited))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintRent(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SudoRentUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SudoRentUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SudoRentUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RentCharged != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.RentCharged))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasConsumed != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.GasConsumed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Calls != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintRent(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockRentCharge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRentCharge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRentCharge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RentConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RentConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RentConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoTopup != nil {
+		{
+			size, err := m.AutoTopup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LowBalanceThresholds) > 0 {
+		dAtA3 := make([]byte, len(m.LowBalanceThresholds)*10)
+		var j2 int
+		for _, num := range m.LowBalanceThresholds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintRent(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintRent(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RentAutoTopup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RentAutoTopup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RentAutoTopup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FundingAccount) > 0 {
+		i -= len(m.FundingAccount)
+		copy(dAtA[i:], m.FundingAccount)
+		i = encodeVarintRent(dAtA, i, uint64(len(m.FundingAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RentLedger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovRent(uint64(l))
+	}
+	if m.TotalDeposited != 0 {
+		n += 1 + sovRent(uint64(m.TotalDeposited))
+	}
+	if m.TotalCharged != 0 {
+		n += 1 + sovRent(uint64(m.TotalCharged))
+	}
+	if m.TotalRefunded != 0 {
+		n += 1 + sovRent(uint64(m.TotalRefunded))
+	}
+	if len(m.SudoUsages) > 0 {
+		for _, e := range m.SudoUsages {
+			l = e.Size()
+			n += 1 + l + sovRent(uint64(l))
+		}
+	}
+	if len(m.RecentCharges) > 0 {
+		for _, e := range m.RecentCharges {
+			l = e.Size()
+			n += 1 + l + sovRent(uint64(l))
+		}
+	}
+	if m.LowBalanceThresholdReached != 0 {
+		n += 1 + sovRent(uint64(m.LowBalanceThresholdReached))
+	}
+	return n
+}
+
+func (m *SudoRentUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovRent(uint64(l))
+	}
+	if m.Calls != 0 {
+		n += 1 + sovRent(uint64(m.Calls))
+	}
+	if m.GasConsumed != 0 {
+		n += 1 + sovRent(uint64(m.GasConsumed))
+	}
+	if m.RentCharged != 0 {
+		n += 1 + sovRent(uint64(m.RentCharged))
+	}
+	return n
+}
+
+func (m *BlockRentCharge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRent(uint64(m.Height))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovRent(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *RentConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovRent(uint64(l))
+	}
+	if len(m.LowBalanceThresholds) > 0 {
+		l = 0
+		for _, e := range m.LowBalanceThresholds {
+			l += sovRent(uint64(e))
+		}
+		n += 1 + sovRent(uint64(l)) + l
+	}
+	if m.AutoTopup != nil {
+		l = m.AutoTopup.Size()
+		n += 1 + l + sovRent(uint64(l))
+	}
+	return n
+}
+
+func (m *RentAutoTopup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FundingAccount)
+	if l > 0 {
+		n += 1 + l + sovRent(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovRent(uint64(m.Threshold))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovRent(uint64(m.Amount))
+	}
+	return n
+}
+
+func sovRent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRent(x uint64) (n int) {
+	return sovRent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RentLedger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RentLedger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RentLedger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposited", wireType)
+			}
+			m.TotalDeposited = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDeposited |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCharged", wireType)
+			}
+			m.TotalCharged = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCharged |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRefunded", wireType)
+			}
+			m.TotalRefunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRefunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoUsages = append(m.SudoUsages, SudoRentUsage{})
+			if err := m.SudoUsages[len(m.SudoUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentCharges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentCharges = append(m.RecentCharges, BlockRentCharge{})
+			if err := m.RecentCharges[len(m.RecentCharges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowBalanceThresholdReached", wireType)
+			}
+			m.LowBalanceThresholdReached = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowBalanceThresholdReached |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SudoRentUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SudoRentUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SudoRentUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			m.Calls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Calls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasConsumed", wireType)
+			}
+			m.GasConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentCharged", wireType)
+			}
+			m.RentCharged = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RentCharged |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRentCharge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRentCharge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRentCharge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RentConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RentConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RentConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LowBalanceThresholds = append(m.LowBalanceThresholds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LowBalanceThresholds) == 0 {
+					m.LowBalanceThresholds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LowBalanceThresholds = append(m.LowBalanceThresholds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowBalanceThresholds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoTopup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoTopup == nil {
+				m.AutoTopup = &RentAutoTopup{}
+			}
+			if err := m.AutoTopup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RentAutoTopup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RentAutoTopup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RentAutoTopup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRent = fmt.Errorf("proto: unexpected end of group")
+)
@@ -37,6 +37,8 @@ type SettlementEntry struct {
 	Timestamp              uint64                                 `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp"`
 	Height                 uint64                                 `protobuf:"varint,11,opt,name=height,proto3" json:"height"`
 	SettlementId           uint64                                 `protobuf:"varint,12,opt,name=settlementId,proto3" json:"settlement_id"`
+	// fee charged for this fill in the price denom, unset if the pair charges no fee
+	Fee *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee,omitempty"`
 }
 
 func (m *SettlementEntry) Reset()         { *m = SettlementEntry{} }
@@ -195,44 +197,46 @@ func init() {
 func init() { proto.RegisterFile("dex/settlement.proto", fileDescriptor_c24d83c09612bb1c) }
 
 var fileDescriptor_c24d83c09612bb1c = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x6f, 0xdb, 0x3c,
-	0x10, 0xc6, 0xad, 0xfc, 0xb1, 0x63, 0x3a, 0x79, 0x83, 0x10, 0x79, 0x03, 0xb6, 0x83, 0x68, 0x08,
-	0x68, 0x90, 0xa2, 0x8d, 0x04, 0xb4, 0xe8, 0xd2, 0xd1, 0x75, 0x51, 0x64, 0x28, 0x1a, 0xb0, 0x9d,
-	0xba, 0x08, 0x0a, 0x75, 0xb0, 0x89, 0x46, 0xa2, 0x2a, 0xd2, 0x80, 0xbd, 0xf5, 0x23, 0xf4, 0x3b,
-	0x74, 0xe8, 0x57, 0xc9, 0x98, 0xb1, 0xe8, 0x40, 0x14, 0xc9, 0xa6, 0x31, 0x5b, 0xb7, 0x42, 0x94,
-	0x65, 0x25, 0xad, 0x3d, 0x64, 0xe2, 0xf1, 0xb9, 0xdf, 0xdd, 0x3d, 0x86, 0x79, 0x42, 0xfb, 0x31,
-	0x4c, 0x03, 0x05, 0x5a, 0x9f, 0x43, 0x02, 0xa9, 0xf6, 0xb3, 0x5c, 0x6a, 0x89, 0x89, 0x02, 0x61,
-	0x23, 0x2e, 0xcf, 0x7d, 0x05, 0x82, 0x8f, 0x23, 0x91, 0xfa, 0x31, 0x4c, 0x1f, 0xee, 0x8f, 0xe4,
-	0x48, 0xda, 0x54, 0x50, 0x46, 0x15, 0xef, 0xfd, 0x6e, 0xa3, 0xdd, 0xf7, 0x8b, 0x26, 0xaf, 0x53,
-	0x9d, 0xcf, 0xf0, 0x23, 0xd4, 0x89, 0x38, 0x97, 0x93, 0x54, 0x13, 0xa7, 0xef, 0x1c, 0x75, 0x07,
-	0xbd, 0xc2, 0xd0, 0x5a, 0x62, 0x75, 0x80, 0x03, 0x84, 0xb2, 0x5c, 0x70, 0x18, 0x42, 0x2a, 0x13,
-	0xb2, 0x66, 0xc9, 0xdd, 0xc2, 0xd0, 0x9e, 0x55, 0xc3, 0xb8, 0x94, 0xd9, 0x2d, 0xa4, 0x2c, 0x88,
-	0x94, 0x02, 0x5d, 0x15, 0xac, 0x37, 0x05, 0x56, 0xad, 0x0b, 0x1a, 0x04, 0x0b, 0xb4, 0xf5, 0x79,
-	0x12, 0xa5, 0x5a, 0xe8, 0x19, 0xd9, 0xb0, 0xf8, 0xdb, 0x0b, 0x43, 0x5b, 0x3f, 0x0d, 0x3d, 0x1c,
-	0x09, 0x3d, 0x9e, 0x9c, 0xf9, 0x5c, 0x26, 0x01, 0x97, 0x2a, 0x91, 0x6a, 0x7e, 0x1c, 0xab, 0xf8,
-	0x53, 0xa0, 0x67, 0x19, 0x28, 0x7f, 0x08, 0xbc, 0x30, 0x74, 0xd1, 0xe1, 0xc6, 0xd0, 0xdd, 0x59,
-	0x94, 0x9c, 0xbf, 0xf4, 0x6a, 0xc5, 0x63, 0x8b, 0x24, 0xfe, 0xee, 0xa0, 0x03, 0x98, 0x02, 0x9f,
-	0x68, 0x21, 0xd3, 0x57, 0x52, 0xe9, 0x77, 0xf9, 0x69, 0x2e, 0x39, 0x40, 0x4c, 0x36, 0xed, 0x64,
-	0x79, 0xef, 0xc9, 0x0f, 0x16, 0xfd, 0x42, 0x2e, 0x95, 0x0e, 0x65, 0x1e, 0x66, 0x55, 0xcb, 0x1b,
-	0x43, 0xfb, 0x95, 0x95, 0x95, 0x88, 0xc7, 0x56, 0xd8, 0xc1, 0xdf, 0x1c, 0xf4, 0x3f, 0x4c, 0x33,
-	0xe0, 0x1a, 0xe2, 0xbb, 0x46, 0xdb, 0xd6, 0x68, 0x72, 0x6f, 0xa3, 0xa4, 0x6e, 0xb7, 0xc4, 0x27,
-	0xad, 0x7d, 0x2e, 0x27, 0x3c, 0xb6, 0xdc, 0x0b, 0x1e, 0xa2, 0xbd, 0x4c, 0x2a, 0x51, 0xda, 0x1f,
-	0x8a, 0x1c, 0x78, 0x19, 0x90, 0x8e, 0x35, 0x78, 0x50, 0x18, 0x8a, 0xeb, 0x64, 0x18, 0xd7, 0x59,
-	0xf6, 0x6f, 0x01, 0x7e, 0x8a, 0xba, 0x32, 0x8f, 0x21, 0xff, 0x30, 0xcb, 0x80, 0x6c, 0xd9, 0xea,
-	0xff, 0x0a, 0x43, 0x91, 0x15, 0xc3, 0xf2, 0x37, 0xb0, 0x06, 0xc0, 0x87, 0xa8, 0x63, 0x2f, 0x27,
-	0x31, 0xe9, 0xf6, 0x9d, 0xa3, 0x8d, 0xc1, 0x76, 0xf9, 0xff, 0x57, 0xac, 0x88, 0x59, 0x9d, 0xc4,
-	0x4f, 0x50, 0x57, 0x8b, 0x04, 0x94, 0x8e, 0x92, 0x8c, 0x20, 0x4b, 0xee, 0x14, 0x86, 0x36, 0x22,
-	0x6b, 0x42, 0xec, 0xa1, 0xf6, 0x18, 0xc4, 0x68, 0xac, 0x49, 0xcf, 0x92, 0xa8, 0x30, 0x74, 0xae,
-	0xb0, 0xf9, 0x89, 0x5f, 0xa0, 0xed, 0x66, 0x11, 0x4f, 0x62, 0xb2, 0x6d, 0xc9, 0xbd, 0xc2, 0xd0,
-	0x9d, 0x46, 0x2f, 0x2d, 0xdc, 0xc1, 0xbc, 0x2f, 0x0e, 0xea, 0x35, 0xbb, 0xa7, 0x30, 0x45, 0x9b,
-	0x90, 0x49, 0x3e, 0xb6, 0x5b, 0xb7, 0x3e, 0xe8, 0x16, 0x86, 0x56, 0x02, 0xab, 0x0e, 0x7c, 0x8a,
-	0x3a, 0x90, 0xea, 0x5c, 0x80, 0x22, 0x6b, 0xfd, 0xf5, 0xa3, 0xde, 0xb3, 0xc7, 0xfe, 0xaa, 0x75,
-	0xf7, 0xff, 0x5a, 0xea, 0x6a, 0x87, 0xe7, 0xd5, 0xac, 0x0e, 0x06, 0x6f, 0x2e, 0xae, 0x5c, 0xe7,
-	0xf2, 0xca, 0x75, 0x7e, 0x5d, 0xb9, 0xce, 0xd7, 0x6b, 0xb7, 0x75, 0x79, 0xed, 0xb6, 0x7e, 0x5c,
-	0xbb, 0xad, 0x8f, 0xc7, 0xb7, 0x9e, 0x8f, 0x02, 0x71, 0x5c, 0x4f, 0xb1, 0x17, 0x3b, 0x26, 0x98,
-	0x06, 0xe5, 0x27, 0xc8, 0xbe, 0xa4, 0xb3, 0xb6, 0xcd, 0x3f, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0x07, 0xa3, 0x0d, 0xb2, 0x96, 0x04, 0x00, 0x00,
+	// 609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x9b, 0xb6, 0x69, 0x2e, 0x0d, 0x55, 0x4f, 0xa5, 0x3a, 0x18, 0x72, 0x91, 0x25, 0xaa,
+	0x22, 0x5a, 0x5b, 0x02, 0xb1, 0xb0, 0x20, 0x85, 0x20, 0xd4, 0x01, 0x51, 0x0e, 0x26, 0x96, 0xc8,
+	0x3d, 0xbf, 0x26, 0x27, 0x6a, 0x9f, 0xf1, 0x5d, 0xa4, 0x78, 0xe3, 0x27, 0xf0, 0x1f, 0x18, 0xf8,
+	0x2b, 0x1d, 0x18, 0x3a, 0x22, 0x86, 0x13, 0x6a, 0x37, 0x8f, 0xfd, 0x05, 0xc8, 0xe7, 0x38, 0x6e,
+	0xa1, 0x1d, 0x3a, 0xdd, 0xbb, 0xef, 0x7d, 0xdf, 0x7b, 0xdf, 0xd3, 0xe9, 0x1e, 0xda, 0x0a, 0x61,
+	0xe6, 0x2b, 0xd0, 0xfa, 0x04, 0x22, 0x88, 0xb5, 0x97, 0xa4, 0x52, 0x4b, 0x4c, 0x14, 0x08, 0x1b,
+	0x71, 0x79, 0xe2, 0x29, 0x10, 0x7c, 0x12, 0x88, 0xd8, 0x0b, 0x61, 0xf6, 0x70, 0x6b, 0x2c, 0xc7,
+	0xd2, 0xa6, 0xfc, 0x22, 0x2a, 0xf9, 0xee, 0xcf, 0x16, 0xda, 0xf8, 0xb0, 0x28, 0xf2, 0x3a, 0xd6,
+	0x69, 0x86, 0x1f, 0xa1, 0x56, 0xc0, 0xb9, 0x9c, 0xc6, 0x9a, 0x38, 0x7d, 0x67, 0xb7, 0x3d, 0xe8,
+	0xe4, 0x86, 0x56, 0x10, 0xab, 0x02, 0xec, 0x23, 0x94, 0xa4, 0x82, 0xc3, 0x10, 0x62, 0x19, 0x91,
+	0x25, 0xcb, 0xdc, 0xc8, 0x0d, 0xed, 0x58, 0x74, 0x14, 0x16, 0x30, 0xbb, 0x42, 0x29, 0x04, 0x81,
+	0x52, 0xa0, 0x4b, 0x41, 0xb3, 0x16, 0x58, 0xb4, 0x12, 0xd4, 0x14, 0x2c, 0xd0, 0xda, 0x97, 0x69,
+	0x10, 0x6b, 0xa1, 0x33, 0xb2, 0x6c, 0xe9, 0x6f, 0x4f, 0x0d, 0x6d, 0xfc, 0x36, 0x74, 0x67, 0x2c,
+	0xf4, 0x64, 0x7a, 0xe4, 0x71, 0x19, 0xf9, 0x5c, 0xaa, 0x48, 0xaa, 0xf9, 0xb1, 0xaf, 0xc2, 0xcf,
+	0xbe, 0xce, 0x12, 0x50, 0xde, 0x10, 0x78, 0x6e, 0xe8, 0xa2, 0xc2, 0xa5, 0xa1, 0x1b, 0x59, 0x10,
+	0x9d, 0xbc, 0x70, 0x2b, 0xc4, 0x65, 0x8b, 0x24, 0xfe, 0xe1, 0xa0, 0x6d, 0x98, 0x01, 0x9f, 0x6a,
+	0x21, 0xe3, 0x57, 0x52, 0xe9, 0x77, 0xe9, 0x61, 0x2a, 0x39, 0x40, 0x48, 0x56, 0x6c, 0x67, 0x79,
+	0xe7, 0xce, 0x0f, 0x16, 0xf5, 0x46, 0x5c, 0x2a, 0x3d, 0x92, 0xe9, 0x28, 0x29, 0x4b, 0x5e, 0x1a,
+	0xda, 0x2f, 0xad, 0xdc, 0x4a, 0x71, 0xd9, 0x2d, 0x76, 0xf0, 0x77, 0x07, 0xdd, 0x87, 0x59, 0x02,
+	0x5c, 0x43, 0x78, 0xdd, 0xe8, 0xaa, 0x35, 0x1a, 0xdd, 0xd9, 0x28, 0xa9, 0xca, 0xdd, 0xe0, 0x93,
+	0x56, 0x3e, 0x6f, 0x66, 0xb8, 0xec, 0x66, 0x2f, 0x78, 0x88, 0x36, 0x13, 0xa9, 0x44, 0x61, 0x7f,
+	0x28, 0x52, 0xe0, 0x45, 0x40, 0x5a, 0xd6, 0xe0, 0x76, 0x6e, 0x28, 0xae, 0x92, 0xa3, 0xb0, 0xca,
+	0xb2, 0xff, 0x05, 0x78, 0x0f, 0xb5, 0x65, 0x1a, 0x42, 0xfa, 0x31, 0x4b, 0x80, 0xac, 0x59, 0xf5,
+	0xbd, 0xdc, 0x50, 0x64, 0xc1, 0x51, 0x31, 0x03, 0xab, 0x09, 0x78, 0x07, 0xb5, 0xec, 0xe5, 0x20,
+	0x24, 0xed, 0xbe, 0xb3, 0xbb, 0x3c, 0x58, 0x2f, 0xde, 0xbf, 0xe4, 0x8a, 0x90, 0x55, 0x49, 0xfc,
+	0x04, 0xb5, 0xb5, 0x88, 0x40, 0xe9, 0x20, 0x4a, 0x08, 0xb2, 0xcc, 0x6e, 0x6e, 0x68, 0x0d, 0xb2,
+	0x3a, 0xc4, 0x2e, 0x5a, 0x9d, 0x80, 0x18, 0x4f, 0x34, 0xe9, 0x58, 0x26, 0xca, 0x0d, 0x9d, 0x23,
+	0x6c, 0x7e, 0xe2, 0xe7, 0x68, 0xbd, 0xfe, 0x88, 0x07, 0x21, 0x59, 0xb7, 0xcc, 0xcd, 0xdc, 0xd0,
+	0x6e, 0x8d, 0x17, 0x16, 0xae, 0xd1, 0xf0, 0x7b, 0xd4, 0x3c, 0x06, 0x20, 0x5d, 0x3b, 0xd7, 0xcb,
+	0x53, 0x43, 0x9d, 0x3b, 0x3d, 0x5b, 0xf7, 0x18, 0x60, 0x4f, 0x46, 0x42, 0x43, 0x94, 0xe8, 0x8c,
+	0x15, 0xb5, 0xdc, 0xaf, 0x0e, 0xea, 0xd4, 0xdf, 0x59, 0x61, 0x8a, 0x56, 0x20, 0x91, 0x7c, 0x62,
+	0x3f, 0x72, 0x73, 0xd0, 0xce, 0x0d, 0x2d, 0x01, 0x56, 0x1e, 0xf8, 0x10, 0xb5, 0x20, 0xd6, 0xa9,
+	0x00, 0x45, 0x96, 0xfa, 0xcd, 0xdd, 0xce, 0xd3, 0xc7, 0xde, 0x6d, 0x1b, 0xc4, 0xfb, 0x67, 0x4f,
+	0x94, 0x6b, 0x61, 0xae, 0x66, 0x55, 0x30, 0x78, 0x73, 0x7a, 0xde, 0x73, 0xce, 0xce, 0x7b, 0xce,
+	0x9f, 0xf3, 0x9e, 0xf3, 0xed, 0xa2, 0xd7, 0x38, 0xbb, 0xe8, 0x35, 0x7e, 0x5d, 0xf4, 0x1a, 0x9f,
+	0xf6, 0xaf, 0x8c, 0xa6, 0x40, 0xec, 0x57, 0x5d, 0xec, 0xc5, 0xb6, 0xf1, 0x67, 0x7e, 0xb1, 0xd5,
+	0xec, 0x94, 0x47, 0xab, 0x36, 0xff, 0xec, 0xef, 0x00, 0x81, 0x9e, 0x32, 0x26, 0xe9, 0x04, 0x00,
+	0x00,
 }
 
 func (m *SettlementEntry) Marshal() (dAtA []byte, err error) {
@@ -255,6 +259,18 @@ func (m *SettlementEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Fee != nil {
+		{
+			size := m.Fee.Size()
+			i -= size
+			if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSettlement(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.SettlementId != 0 {
 		i = encodeVarintSettlement(dAtA, i, uint64(m.SettlementId))
 		i--
@@ -440,6 +456,10 @@ func (m *SettlementEntry) Size() (n int) {
 	if m.SettlementId != 0 {
 		n += 1 + sovSettlement(uint64(m.SettlementId))
 	}
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovSettlement(uint64(l))
+	}
 	return n
 }
 
@@ -834,6 +854,42 @@ func (m *SettlementEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Fee = &v
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettlement(dAtA[iNdEx:])
@@ -0,0 +1,594 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/suspension.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Why and in what state a contract was suspended by the dex EndBlock
+type SuspensionRecord struct {
+	ContractAddr string               `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_addr"`
+	Height       int64                `protobuf:"varint,2,opt,name=height,proto3" json:"height"`
+	Phase        ContractFailurePhase `protobuf:"varint,3,opt,name=phase,proto3,enum=seiprotocol.seichain.dex.ContractFailurePhase" json:"phase"`
+	// the full error, whereas the suspension reason of the contract is truncated
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error"`
+	// gas consumed by the sudo calls to the contract in the block before it failed
+	GasUsed           uint64 `protobuf:"varint,5,opt,name=gasUsed,proto3" json:"gas_used"`
+	OrderCount        uint64 `protobuf:"varint,6,opt,name=orderCount,proto3" json:"order_count"`
+	CancellationCount uint64 `protobuf:"varint,7,opt,name=cancellationCount,proto3" json:"cancellation_count"`
+	DepositCount      uint64 `protobuf:"varint,8,opt,name=depositCount,proto3" json:"deposit_count"`
+}
+
+func (m *SuspensionRecord) Reset()         { *m = SuspensionRecord{} }
+func (m *SuspensionRecord) String() string { return proto.CompactTextString(m) }
+func (*SuspensionRecord) ProtoMessage()    {}
+func (*SuspensionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a2a1104563bdfbc, []int{0}
+}
+func (m *SuspensionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuspensionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuspensionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuspensionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspensionRecord.Merge(m, src)
+}
+func (m *SuspensionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuspensionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspensionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspensionRecord proto.InternalMessageInfo
+
+func (m *SuspensionRecord) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *SuspensionRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SuspensionRecord) GetPhase() ContractFailurePhase {
+	if m != nil {
+		return m.Phase
+	}
+	return ContractFailurePhase_DEPOSIT
+}
+
+func (m *SuspensionRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SuspensionRecord) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *SuspensionRecord) GetOrderCount() uint64 {
+	if m != nil {
+		return m.OrderCount
+	}
+	return 0
+}
+
+func (m *SuspensionRecord) GetCancellationCount() uint64 {
+	if m != nil {
+		return m.CancellationCount
+	}
+	return 0
+}
+
+func (m *SuspensionRecord) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SuspensionRecord)(nil), "seiprotocol.seichain.dex.SuspensionRecord")
+}
+
+func init() { proto.RegisterFile("dex/suspension.proto", fileDescriptor_8a2a1104563bdfbc) }
+
+var fileDescriptor_8a2a1104563bdfbc = []byte{
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xbf, 0x8e, 0xd3, 0x30,
+	0x18, 0xaf, 0xe9, 0xb5, 0x77, 0x67, 0x0e, 0x8e, 0xb3, 0x2a, 0x14, 0x75, 0x48, 0xa2, 0x0e, 0x28,
+	0x4b, 0x13, 0x09, 0xc4, 0x03, 0x90, 0x22, 0x18, 0x41, 0x41, 0x2c, 0x2c, 0x95, 0x6b, 0x7f, 0x4a,
+	0x2c, 0xa5, 0x76, 0x64, 0x3b, 0x52, 0x79, 0x0b, 0x5e, 0x86, 0x77, 0x60, 0xec, 0xc8, 0x14, 0xa1,
+	0x76, 0xcb, 0x53, 0xa0, 0x38, 0x29, 0x6a, 0x85, 0x6e, 0xfa, 0x3e, 0xff, 0xfe, 0xc9, 0xfe, 0xc9,
+	0x78, 0xc6, 0x61, 0x97, 0x98, 0xda, 0x54, 0x20, 0x8d, 0x50, 0x32, 0xae, 0xb4, 0xb2, 0x8a, 0x78,
+	0x06, 0x84, 0xdb, 0x98, 0x2a, 0x63, 0x03, 0x82, 0x15, 0x54, 0xc8, 0x98, 0xc3, 0x6e, 0x3e, 0xcb,
+	0x55, 0xae, 0x1c, 0x95, 0x74, 0x5b, 0xaf, 0x9f, 0xdf, 0x77, 0x29, 0x20, 0xeb, 0xad, 0xe9, 0x81,
+	0xc5, 0xcf, 0x31, 0x7e, 0xf1, 0xe5, 0x5f, 0x6a, 0x06, 0x4c, 0x69, 0x4e, 0xde, 0xe2, 0x3b, 0xa6,
+	0xa4, 0xd5, 0x94, 0xd9, 0x77, 0x9c, 0x6b, 0x0f, 0x85, 0x28, 0xba, 0x4d, 0x1f, 0xda, 0x26, 0x78,
+	0x76, 0xc2, 0xd7, 0x94, 0x73, 0x9d, 0x5d, 0xc8, 0xc8, 0x02, 0x4f, 0x0b, 0x10, 0x79, 0x61, 0xbd,
+	0x27, 0x21, 0x8a, 0xc6, 0x29, 0x6e, 0x9b, 0x60, 0x40, 0xb2, 0x61, 0x92, 0x4f, 0x78, 0x52, 0x15,
+	0xd4, 0x80, 0x37, 0x0e, 0x51, 0xf4, 0xfc, 0x75, 0x1c, 0x3f, 0xf6, 0x80, 0x78, 0x35, 0x44, 0x7f,
+	0xa0, 0xa2, 0xac, 0x35, 0x7c, 0xee, 0x5c, 0xe9, 0x6d, 0xdb, 0x04, 0x7d, 0x40, 0xd6, 0x0f, 0x12,
+	0xe0, 0x09, 0x68, 0xad, 0xb4, 0x77, 0xe5, 0x2e, 0xe9, 0x04, 0x0e, 0xc8, 0xfa, 0x41, 0x5e, 0xe1,
+	0xeb, 0x9c, 0x9a, 0xaf, 0x06, 0xb8, 0x37, 0x09, 0x51, 0x74, 0x95, 0xde, 0xb5, 0x4d, 0x70, 0x93,
+	0x53, 0xb3, 0xae, 0x0d, 0xf0, 0xec, 0x44, 0x92, 0x04, 0x63, 0xa5, 0x39, 0xe8, 0x95, 0xaa, 0xa5,
+	0xf5, 0xa6, 0x4e, 0x7a, 0xdf, 0x36, 0xc1, 0x53, 0x87, 0xae, 0x59, 0x07, 0x67, 0x67, 0x12, 0xf2,
+	0x1e, 0x3f, 0x30, 0x2a, 0x19, 0x94, 0x25, 0xb5, 0x42, 0xc9, 0xde, 0x77, 0xed, 0x7c, 0x2f, 0xdb,
+	0x26, 0x20, 0xe7, 0xe4, 0x60, 0xff, 0xdf, 0xd0, 0x75, 0xcd, 0xa1, 0x52, 0x46, 0xd8, 0x3e, 0xe0,
+	0xc6, 0x05, 0xb8, 0xae, 0x07, 0x7c, 0xf0, 0x5e, 0xc8, 0xd2, 0x8f, 0xbf, 0x0e, 0x3e, 0xda, 0x1f,
+	0x7c, 0xf4, 0xe7, 0xe0, 0xa3, 0x1f, 0x47, 0x7f, 0xb4, 0x3f, 0xfa, 0xa3, 0xdf, 0x47, 0x7f, 0xf4,
+	0x6d, 0x99, 0x0b, 0x5b, 0xd4, 0x9b, 0x98, 0xa9, 0x6d, 0x62, 0x40, 0x2c, 0x4f, 0xed, 0xba, 0x83,
+	0xab, 0x37, 0xd9, 0x25, 0xdd, 0x37, 0xb0, 0xdf, 0x2b, 0x30, 0x9b, 0xa9, 0xe3, 0xdf, 0xfc, 0x1d,
+	0x00, 0x8a, 0x6e, 0x0e, 0x30, 0x60, 0x02, 0x00, 0x00,
+}
+
+func (m *SuspensionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuspensionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuspensionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DepositCount != 0 {
+		i = encodeVarintSuspension(dAtA, i, uint64(m.DepositCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CancellationCount != 0 {
+		i = encodeVarintSuspension(dAtA, i, uint64(m.CancellationCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OrderCount != 0 {
+		i = encodeVarintSuspension(dAtA, i, uint64(m.OrderCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintSuspension(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSuspension(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Phase != 0 {
+		i = encodeVarintSuspension(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintSuspension(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintSuspension(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSuspension(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSuspension(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SuspensionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovSuspension(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSuspension(uint64(m.Height))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovSuspension(uint64(m.Phase))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSuspension(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovSuspension(uint64(m.GasUsed))
+	}
+	if m.OrderCount != 0 {
+		n += 1 + sovSuspension(uint64(m.OrderCount))
+	}
+	if m.CancellationCount != 0 {
+		n += 1 + sovSuspension(uint64(m.CancellationCount))
+	}
+	if m.DepositCount != 0 {
+		n += 1 + sovSuspension(uint64(m.DepositCount))
+	}
+	return n
+}
+
+func sovSuspension(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSuspension(x uint64) (n int) {
+	return sovSuspension(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SuspensionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuspension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuspensionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuspensionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuspension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuspension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuspension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuspension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuspension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ContractFailurePhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuspension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuspension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuspension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuspension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCount", wireType)
+			}
+			m.OrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuspension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationCount", wireType)
+			}
+			m.CancellationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuspension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancellationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+			}
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuspension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuspension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuspension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSuspension(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSuspension
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSuspension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSuspension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSuspension
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSuspension
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSuspension
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSuspension        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSuspension          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSuspension = fmt.Errorf("proto: unexpected end of group")
+)
@@ -238,6 +238,253 @@ func (m *MsgCancelOrdersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelOrdersResponse proto.InternalMessageInfo
 
+// MsgCancelAll cancels all resting orders of the creator on a contract, optionally
+// restricted to a single pair and/or position direction.
+type MsgCancelAll struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator"`
+	ContractAddr string `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	// empty to cancel orders of all pairs
+	PriceDenom string `protobuf:"bytes,3,opt,name=priceDenom,proto3" json:"price_denom"`
+	// empty to cancel orders of all pairs
+	AssetDenom string `protobuf:"bytes,4,opt,name=assetDenom,proto3" json:"asset_denom"`
+	// empty to cancel orders of both directions
+	PositionDirections []PositionDirection `protobuf:"varint,5,rep,packed,name=positionDirections,proto3,enum=seiprotocol.seichain.dex.PositionDirection" json:"position_directions"`
+}
+
+func (m *MsgCancelAll) Reset()         { *m = MsgCancelAll{} }
+func (m *MsgCancelAll) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAll) ProtoMessage()    {}
+func (*MsgCancelAll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{4}
+}
+func (m *MsgCancelAll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAll.Merge(m, src)
+}
+func (m *MsgCancelAll) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAll) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAll proto.InternalMessageInfo
+
+func (m *MsgCancelAll) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelAll) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *MsgCancelAll) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *MsgCancelAll) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *MsgCancelAll) GetPositionDirections() []PositionDirection {
+	if m != nil {
+		return m.PositionDirections
+	}
+	return nil
+}
+
+type MsgCancelAllResponse struct {
+	CancelledOrderIds []uint64 `protobuf:"varint,1,rep,packed,name=cancelledOrderIds,proto3" json:"cancelled_order_ids" yaml:"cancelled_order_ids"`
+}
+
+func (m *MsgCancelAllResponse) Reset()         { *m = MsgCancelAllResponse{} }
+func (m *MsgCancelAllResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllResponse) ProtoMessage()    {}
+func (*MsgCancelAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{5}
+}
+func (m *MsgCancelAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllResponse.Merge(m, src)
+}
+func (m *MsgCancelAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAllResponse proto.InternalMessageInfo
+
+func (m *MsgCancelAllResponse) GetCancelledOrderIds() []uint64 {
+	if m != nil {
+		return m.CancelledOrderIds
+	}
+	return nil
+}
+
+// MsgCancelReplace cancels a resting order and places a new order in the same
+// transaction. Neither takes effect if the other fails.
+type MsgCancelReplace struct {
+	Creator      string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator"`
+	ContractAddr string                                   `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	Cancellation *Cancellation                            `protobuf:"bytes,3,opt,name=cancellation,proto3" json:"cancellation"`
+	Order        *Order                                   `protobuf:"bytes,4,opt,name=order,proto3" json:"order"`
+	Funds        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
+}
+
+func (m *MsgCancelReplace) Reset()         { *m = MsgCancelReplace{} }
+func (m *MsgCancelReplace) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelReplace) ProtoMessage()    {}
+func (*MsgCancelReplace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{6}
+}
+func (m *MsgCancelReplace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelReplace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelReplace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelReplace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelReplace.Merge(m, src)
+}
+func (m *MsgCancelReplace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelReplace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelReplace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelReplace proto.InternalMessageInfo
+
+func (m *MsgCancelReplace) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelReplace) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *MsgCancelReplace) GetCancellation() *Cancellation {
+	if m != nil {
+		return m.Cancellation
+	}
+	return nil
+}
+
+func (m *MsgCancelReplace) GetOrder() *Order {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+func (m *MsgCancelReplace) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
+type MsgCancelReplaceResponse struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=orderId,proto3" json:"order_id" yaml:"order_id"`
+}
+
+func (m *MsgCancelReplaceResponse) Reset()         { *m = MsgCancelReplaceResponse{} }
+func (m *MsgCancelReplaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelReplaceResponse) ProtoMessage()    {}
+func (*MsgCancelReplaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{7}
+}
+func (m *MsgCancelReplaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelReplaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelReplaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelReplaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelReplaceResponse.Merge(m, src)
+}
+func (m *MsgCancelReplaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelReplaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelReplaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelReplaceResponse proto.InternalMessageInfo
+
+func (m *MsgCancelReplaceResponse) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
 type MsgRegisterContract struct {
 	Creator  string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Contract *ContractInfoV2 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
@@ -247,7 +494,7 @@ func (m *MsgRegisterContract) Reset()         { *m = MsgRegisterContract{} }
 func (m *MsgRegisterContract) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterContract) ProtoMessage()    {}
 func (*MsgRegisterContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{4}
+	return fileDescriptor_463701e671e5a5e0, []int{8}
 }
 func (m *MsgRegisterContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +544,7 @@ func (m *MsgRegisterContractResponse) Reset()         { *m = MsgRegisterContract
 func (m *MsgRegisterContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterContractResponse) ProtoMessage()    {}
 func (*MsgRegisterContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{5}
+	return fileDescriptor_463701e671e5a5e0, []int{9}
 }
 func (m *MsgRegisterContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +583,7 @@ func (m *MsgContractDepositRent) Reset()         { *m = MsgContractDepositRent{}
 func (m *MsgContractDepositRent) String() string { return proto.CompactTextString(m) }
 func (*MsgContractDepositRent) ProtoMessage()    {}
 func (*MsgContractDepositRent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{6}
+	return fileDescriptor_463701e671e5a5e0, []int{10}
 }
 func (m *MsgContractDepositRent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +640,7 @@ func (m *MsgContractDepositRentResponse) Reset()         { *m = MsgContractDepos
 func (m *MsgContractDepositRentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgContractDepositRentResponse) ProtoMessage()    {}
 func (*MsgContractDepositRentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{7}
+	return fileDescriptor_463701e671e5a5e0, []int{11}
 }
 func (m *MsgContractDepositRentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +678,7 @@ func (m *MsgUnregisterContract) Reset()         { *m = MsgUnregisterContract{} }
 func (m *MsgUnregisterContract) String() string { return proto.CompactTextString(m) }
 func (*MsgUnregisterContract) ProtoMessage()    {}
 func (*MsgUnregisterContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{8}
+	return fileDescriptor_463701e671e5a5e0, []int{12}
 }
 func (m *MsgUnregisterContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +728,7 @@ func (m *MsgUnregisterContractResponse) Reset()         { *m = MsgUnregisterCont
 func (m *MsgUnregisterContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnregisterContractResponse) ProtoMessage()    {}
 func (*MsgUnregisterContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{9}
+	return fileDescriptor_463701e671e5a5e0, []int{13}
 }
 func (m *MsgUnregisterContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +766,7 @@ func (m *MsgRegisterPairs) Reset()         { *m = MsgRegisterPairs{} }
 func (m *MsgRegisterPairs) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterPairs) ProtoMessage()    {}
 func (*MsgRegisterPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{10}
+	return fileDescriptor_463701e671e5a5e0, []int{14}
 }
 func (m *MsgRegisterPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +816,7 @@ func (m *MsgRegisterPairsResponse) Reset()         { *m = MsgRegisterPairsRespon
 func (m *MsgRegisterPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterPairsResponse) ProtoMessage()    {}
 func (*MsgRegisterPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{11}
+	return fileDescriptor_463701e671e5a5e0, []int{15}
 }
 func (m *MsgRegisterPairsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +854,7 @@ func (m *MsgUpdatePriceTickSize) Reset()         { *m = MsgUpdatePriceTickSize{}
 func (m *MsgUpdatePriceTickSize) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdatePriceTickSize) ProtoMessage()    {}
 func (*MsgUpdatePriceTickSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{12}
+	return fileDescriptor_463701e671e5a5e0, []int{16}
 }
 func (m *MsgUpdatePriceTickSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +906,7 @@ func (m *MsgUpdateQuantityTickSize) Reset()         { *m = MsgUpdateQuantityTick
 func (m *MsgUpdateQuantityTickSize) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateQuantityTickSize) ProtoMessage()    {}
 func (*MsgUpdateQuantityTickSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{13}
+	return fileDescriptor_463701e671e5a5e0, []int{17}
 }
 func (m *MsgUpdateQuantityTickSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,7 +956,7 @@ func (m *MsgUpdateTickSizeResponse) Reset()         { *m = MsgUpdateTickSizeResp
 func (m *MsgUpdateTickSizeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateTickSizeResponse) ProtoMessage()    {}
 func (*MsgUpdateTickSizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{14}
+	return fileDescriptor_463701e671e5a5e0, []int{18}
 }
 func (m *MsgUpdateTickSizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -747,7 +994,7 @@ func (m *MsgUnsuspendContract) Reset()         { *m = MsgUnsuspendContract{} }
 func (m *MsgUnsuspendContract) String() string { return proto.CompactTextString(m) }
 func (*MsgUnsuspendContract) ProtoMessage()    {}
 func (*MsgUnsuspendContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{15}
+	return fileDescriptor_463701e671e5a5e0, []int{19}
 }
 func (m *MsgUnsuspendContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +1044,7 @@ func (m *MsgUnsuspendContractResponse) Reset()         { *m = MsgUnsuspendContra
 func (m *MsgUnsuspendContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnsuspendContractResponse) ProtoMessage()    {}
 func (*MsgUnsuspendContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{16}
+	return fileDescriptor_463701e671e5a5e0, []int{20}
 }
 func (m *MsgUnsuspendContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -826,134 +1073,378 @@ func (m *MsgUnsuspendContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnsuspendContractResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgPlaceOrders)(nil), "seiprotocol.seichain.dex.MsgPlaceOrders")
-	proto.RegisterType((*MsgPlaceOrdersResponse)(nil), "seiprotocol.seichain.dex.MsgPlaceOrdersResponse")
-	proto.RegisterType((*MsgCancelOrders)(nil), "seiprotocol.seichain.dex.MsgCancelOrders")
-	proto.RegisterType((*MsgCancelOrdersResponse)(nil), "seiprotocol.seichain.dex.MsgCancelOrdersResponse")
-	proto.RegisterType((*MsgRegisterContract)(nil), "seiprotocol.seichain.dex.MsgRegisterContract")
-	proto.RegisterType((*MsgRegisterContractResponse)(nil), "seiprotocol.seichain.dex.MsgRegisterContractResponse")
-	proto.RegisterType((*MsgContractDepositRent)(nil), "seiprotocol.seichain.dex.MsgContractDepositRent")
-	proto.RegisterType((*MsgContractDepositRentResponse)(nil), "seiprotocol.seichain.dex.MsgContractDepositRentResponse")
-	proto.RegisterType((*MsgUnregisterContract)(nil), "seiprotocol.seichain.dex.MsgUnregisterContract")
-	proto.RegisterType((*MsgUnregisterContractResponse)(nil), "seiprotocol.seichain.dex.MsgUnregisterContractResponse")
-	proto.RegisterType((*MsgRegisterPairs)(nil), "seiprotocol.seichain.dex.MsgRegisterPairs")
-	proto.RegisterType((*MsgRegisterPairsResponse)(nil), "seiprotocol.seichain.dex.MsgRegisterPairsResponse")
-	proto.RegisterType((*MsgUpdatePriceTickSize)(nil), "seiprotocol.seichain.dex.MsgUpdatePriceTickSize")
-	proto.RegisterType((*MsgUpdateQuantityTickSize)(nil), "seiprotocol.seichain.dex.MsgUpdateQuantityTickSize")
-	proto.RegisterType((*MsgUpdateTickSizeResponse)(nil), "seiprotocol.seichain.dex.MsgUpdateTickSizeResponse")
-	proto.RegisterType((*MsgUnsuspendContract)(nil), "seiprotocol.seichain.dex.MsgUnsuspendContract")
-	proto.RegisterType((*MsgUnsuspendContractResponse)(nil), "seiprotocol.seichain.dex.MsgUnsuspendContractResponse")
+// Sets the rent balances below which an event is emitted for a contract. Can only be sent by the
+// creator of the contract.
+type MsgSetRentLowBalanceThresholds struct {
+	Creator      string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator"`
+	ContractAddr string   `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	Thresholds   []uint64 `protobuf:"varint,3,rep,packed,name=thresholds,proto3" json:"thresholds"`
 }
 
-func init() { proto.RegisterFile("dex/tx.proto", fileDescriptor_463701e671e5a5e0) }
-
-var fileDescriptor_463701e671e5a5e0 = []byte{
-	// 962 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x9b, 0x52, 0xba, 0xaf, 0xdd, 0x6e, 0xeb, 0x74, 0x17, 0xd7, 0xcb, 0xda, 0xc1, 0x12,
-	0x28, 0x80, 0x6a, 0xd3, 0xac, 0x80, 0x05, 0x89, 0x03, 0xc9, 0x4a, 0x68, 0x25, 0x22, 0x8a, 0x61,
-	0x41, 0xe2, 0x12, 0x39, 0xf6, 0xac, 0x3b, 0x34, 0xf5, 0x44, 0x9e, 0x09, 0x9b, 0x2e, 0x08, 0x09,
-	0x7e, 0x01, 0x07, 0x4e, 0x1c, 0x39, 0xf2, 0x0b, 0x90, 0x38, 0x72, 0xd9, 0x0b, 0xd2, 0x4a, 0x5c,
-	0x38, 0x19, 0xd4, 0xde, 0x72, 0xec, 0x2f, 0x40, 0x1e, 0xdb, 0x43, 0xec, 0x38, 0xc1, 0xd9, 0x03,
-	0x12, 0x27, 0x8f, 0x5f, 0xde, 0xf7, 0xbe, 0xf7, 0xbe, 0x37, 0xf3, 0xc6, 0x81, 0x2d, 0x0f, 0x8d,
-	0x2d, 0x36, 0x36, 0x87, 0x21, 0x61, 0x44, 0x56, 0x28, 0xc2, 0x7c, 0xe5, 0x92, 0x81, 0x49, 0x11,
-	0x76, 0x8f, 0x1d, 0x1c, 0x98, 0x1e, 0x1a, 0xab, 0x9a, 0x4b, 0xe8, 0x29, 0xa1, 0x56, 0xdf, 0xa1,
-	0xc8, 0xfa, 0xe2, 0xb0, 0x8f, 0x98, 0x73, 0x68, 0xb9, 0x04, 0x07, 0x09, 0x52, 0xdd, 0xf3, 0x89,
-	0x4f, 0xf8, 0xd2, 0x8a, 0x57, 0xa9, 0x55, 0x8e, 0xa3, 0xbb, 0x24, 0x60, 0xa1, 0xe3, 0xb2, 0xd4,
-	0x76, 0x2d, 0xb6, 0x91, 0xd0, 0x43, 0x61, 0x6a, 0xd8, 0x8e, 0x0d, 0x43, 0x07, 0x67, 0xef, 0x75,
-	0x9e, 0x12, 0x76, 0x4f, 0x7a, 0x14, 0x3f, 0x42, 0x89, 0xd1, 0xf8, 0x71, 0x15, 0xb6, 0xbb, 0xd4,
-	0x3f, 0x1a, 0x38, 0x2e, 0xfa, 0x20, 0x06, 0x53, 0xf9, 0x45, 0x78, 0xd6, 0x0d, 0x91, 0xc3, 0x48,
-	0xa8, 0x48, 0x0d, 0xa9, 0x79, 0xa5, 0xbd, 0x39, 0x89, 0xf4, 0xcc, 0x64, 0x67, 0x0b, 0xb9, 0x03,
-	0xeb, 0x9c, 0x8d, 0x2a, 0xab, 0x8d, 0x5a, 0x73, 0xb3, 0xa5, 0x9b, 0xf3, 0x8a, 0x34, 0x79, 0xe0,
-	0x36, 0x4c, 0x22, 0x3d, 0x85, 0xd8, 0xe9, 0x53, 0xbe, 0x03, 0x5b, 0x59, 0x19, 0xef, 0x7a, 0x5e,
-	0xa8, 0xd4, 0x38, 0xe1, 0xde, 0x24, 0xd2, 0x77, 0x32, 0x7b, 0xcf, 0xf1, 0xbc, 0x10, 0x51, 0x6a,
-	0xe7, 0x3c, 0xe5, 0xcf, 0xe1, 0x99, 0x07, 0xa3, 0xc0, 0xa3, 0xca, 0x1a, 0x67, 0xdf, 0x37, 0x13,
-	0x21, 0xcd, 0x58, 0x48, 0x33, 0x15, 0xd2, 0xec, 0x10, 0x1c, 0xb4, 0xdf, 0x7a, 0x1c, 0xe9, 0x2b,
-	0x93, 0x48, 0x4f, 0xfc, 0x7f, 0xfa, 0x53, 0x6f, 0xfa, 0x98, 0x1d, 0x8f, 0xfa, 0xa6, 0x4b, 0x4e,
-	0xad, 0x54, 0xfe, 0xe4, 0x71, 0x40, 0xbd, 0x13, 0x8b, 0x9d, 0x0d, 0x11, 0xe5, 0x48, 0x6a, 0x27,
-	0x10, 0xe3, 0x53, 0xb8, 0x91, 0xd7, 0xc8, 0x46, 0x74, 0x48, 0x02, 0x8a, 0xe4, 0x77, 0x60, 0x83,
-	0x57, 0x72, 0xcf, 0xa3, 0x8a, 0xd4, 0xa8, 0x35, 0xd7, 0xda, 0x2f, 0x4c, 0x22, 0xfd, 0x0a, 0xb7,
-	0xf5, 0xb0, 0x47, 0x2f, 0x23, 0x7d, 0xe7, 0xcc, 0x39, 0x1d, 0xbc, 0x6d, 0x08, 0x93, 0x61, 0x0b,
-	0x88, 0xf1, 0xbb, 0x04, 0xd7, 0xba, 0xd4, 0xef, 0x38, 0x81, 0x8b, 0x06, 0xcb, 0xc9, 0xdf, 0x83,
-	0xab, 0x2e, 0x87, 0x0d, 0x1c, 0x86, 0x49, 0x90, 0x75, 0xe1, 0xa5, 0xf9, 0x5d, 0xe8, 0x4c, 0xb9,
-	0xb7, 0x77, 0x27, 0x91, 0x9e, 0x0f, 0x60, 0xe7, 0x5f, 0x9f, 0xbe, 0x35, 0xc6, 0x3e, 0x3c, 0x57,
-	0x28, 0x2a, 0xd3, 0xcb, 0x18, 0x41, 0xbd, 0x4b, 0x7d, 0x1b, 0xf9, 0x98, 0x32, 0x14, 0x76, 0x52,
-	0x94, 0xac, 0x14, 0x6a, 0xfe, 0xa7, 0xcc, 0xbb, 0xb0, 0x91, 0xc5, 0x56, 0x56, 0x1b, 0x52, 0x73,
-	0xb3, 0xd5, 0x5c, 0x50, 0x61, 0xea, 0x79, 0x2f, 0x78, 0x40, 0x3e, 0x69, 0xd9, 0x02, 0x69, 0xdc,
-	0x82, 0x9b, 0x25, 0xb4, 0x22, 0xab, 0x1f, 0x24, 0xde, 0xe0, 0xcc, 0x7e, 0x17, 0x0d, 0x09, 0xc5,
-	0xcc, 0x46, 0x01, 0x9b, 0x51, 0x41, 0xaa, 0xbc, 0x41, 0x0d, 0x58, 0x77, 0x4e, 0xc9, 0x28, 0x48,
-	0xf2, 0x5e, 0x4b, 0xb6, 0x7f, 0x62, 0xb1, 0xd3, 0x67, 0xec, 0x43, 0x51, 0xe0, 0xa1, 0x4c, 0x5d,
-	0xee, 0x93, 0x58, 0xec, 0xf4, 0x69, 0x34, 0x40, 0x2b, 0xcf, 0x4d, 0xa4, 0x3f, 0x86, 0xeb, 0x5d,
-	0xea, 0xdf, 0x0f, 0xc2, 0xa2, 0xac, 0x15, 0xb7, 0x52, 0xb1, 0xc6, 0xd5, 0xca, 0x9d, 0xd6, 0xe1,
-	0x56, 0x29, 0xb3, 0x48, 0xed, 0x57, 0x09, 0x76, 0xa6, 0x94, 0x3f, 0x72, 0x70, 0x48, 0x17, 0x74,
-	0xfb, 0x7b, 0x09, 0x76, 0xfb, 0x0e, 0x73, 0x8f, 0x33, 0x96, 0x78, 0x7c, 0x29, 0x35, 0xbe, 0xb3,
-	0x5f, 0x9d, 0xdf, 0xf7, 0x76, 0x0c, 0xc9, 0xb8, 0x63, 0x0e, 0x71, 0xe6, 0xeb, 0x3c, 0x5a, 0x4f,
-	0x94, 0x11, 0xc7, 0xbb, 0x8c, 0x74, 0x35, 0x39, 0x93, 0x25, 0x3f, 0x1a, 0xf6, 0x6c, 0x02, 0x86,
-	0x0a, 0x4a, 0xb1, 0x08, 0x51, 0xe1, 0xcf, 0xc9, 0xde, 0xb9, 0x3f, 0xf4, 0x1c, 0x86, 0x8e, 0x42,
-	0xec, 0xa2, 0x8f, 0xb1, 0x7b, 0xf2, 0x11, 0x7e, 0x84, 0xaa, 0xca, 0xff, 0x10, 0xb6, 0x58, 0x0a,
-	0x79, 0x1f, 0x53, 0x96, 0x1e, 0x64, 0x63, 0x7e, 0xb9, 0x19, 0x41, 0xdb, 0x4a, 0xab, 0xdc, 0x16,
-	0x53, 0xbd, 0x37, 0xc0, 0x94, 0x5d, 0x46, 0xfa, 0xf5, 0xa4, 0xc0, 0xbc, 0xdd, 0xb0, 0x73, 0x44,
-	0xc6, 0x2f, 0x12, 0xec, 0x8b, 0xd4, 0x3f, 0x1c, 0x39, 0x01, 0xc3, 0xec, 0xec, 0x7f, 0x93, 0xfd,
-	0xcd, 0xa9, 0xe4, 0xb3, 0x98, 0xa2, 0x2b, 0x0f, 0x61, 0x8f, 0x6f, 0x4c, 0x3a, 0xa2, 0x43, 0x14,
-	0x78, 0xff, 0xdd, 0x89, 0xd0, 0xe0, 0xf9, 0x32, 0xe2, 0x2c, 0xb1, 0xd6, 0x6f, 0x1b, 0x50, 0xeb,
-	0x52, 0x5f, 0xc6, 0xb0, 0x39, 0x7d, 0xe7, 0x2e, 0x18, 0x6a, 0xf9, 0x9b, 0x47, 0x7d, 0xad, 0xaa,
-	0xa7, 0xb8, 0xa3, 0x06, 0xb0, 0x95, 0xbb, 0x60, 0x5e, 0x5e, 0x18, 0x61, 0xda, 0x55, 0x3d, 0xac,
-	0xec, 0x2a, 0xd8, 0xc6, 0xb0, 0x33, 0x33, 0xde, 0x0f, 0x16, 0x86, 0x29, 0xba, 0xab, 0xaf, 0x2f,
-	0xe5, 0x2e, 0x98, 0xbf, 0x91, 0xa0, 0x5e, 0x36, 0xc2, 0x17, 0x2b, 0x56, 0x82, 0x50, 0xef, 0x2c,
-	0x8b, 0x10, 0x39, 0x7c, 0x0d, 0x72, 0xc9, 0x1c, 0xb6, 0x16, 0xc6, 0x9b, 0x05, 0xa8, 0x6f, 0x2e,
-	0x09, 0x10, 0xfc, 0x04, 0xae, 0xe6, 0x67, 0xed, 0x2b, 0x95, 0xb4, 0xe4, 0xbe, 0x6a, 0xab, 0xba,
-	0xaf, 0x20, 0xfc, 0x0a, 0xea, 0x65, 0xa3, 0x6f, 0xb1, 0xe6, 0x25, 0x08, 0xf5, 0x76, 0x05, 0x44,
-	0xf1, 0x98, 0xcb, 0xdf, 0x4a, 0x70, 0x63, 0xce, 0xf8, 0xaa, 0x12, 0xaf, 0x08, 0x7a, 0xba, 0x24,
-	0xbe, 0x84, 0xdd, 0xd9, 0x41, 0x63, 0xfe, 0x4b, 0x07, 0x0b, 0xfe, 0xea, 0x1b, 0xcb, 0xf9, 0x67,
-	0xe4, 0xed, 0xf7, 0x1e, 0x9f, 0x6b, 0xd2, 0x93, 0x73, 0x4d, 0xfa, 0xeb, 0x5c, 0x93, 0xbe, 0xbb,
-	0xd0, 0x56, 0x9e, 0x5c, 0x68, 0x2b, 0x7f, 0x5c, 0x68, 0x2b, 0x9f, 0x1d, 0x4c, 0x7d, 0xe5, 0x52,
-	0x84, 0x0f, 0xb2, 0xe0, 0xfc, 0x85, 0x47, 0xb7, 0xc6, 0x16, 0xff, 0x4b, 0x10, 0x7f, 0xf0, 0xf6,
-	0xd7, 0xf9, 0xef, 0xb7, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x87, 0xb0, 0xd3, 0x48, 0xb9, 0x0c,
-	0x00, 0x00,
+func (m *MsgSetRentLowBalanceThresholds) Reset()         { *m = MsgSetRentLowBalanceThresholds{} }
+func (m *MsgSetRentLowBalanceThresholds) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRentLowBalanceThresholds) ProtoMessage()    {}
+func (*MsgSetRentLowBalanceThresholds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{21}
+}
+func (m *MsgSetRentLowBalanceThresholds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRentLowBalanceThresholds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRentLowBalanceThresholds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRentLowBalanceThresholds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRentLowBalanceThresholds.Merge(m, src)
+}
+func (m *MsgSetRentLowBalanceThresholds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRentLowBalanceThresholds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRentLowBalanceThresholds.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetRentLowBalanceThresholds proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	PlaceOrders(ctx context.Context, in *MsgPlaceOrders, opts ...grpc.CallOption) (*MsgPlaceOrdersResponse, error)
-	CancelOrders(ctx context.Context, in *MsgCancelOrders, opts ...grpc.CallOption) (*MsgCancelOrdersResponse, error)
-	RegisterContract(ctx context.Context, in *MsgRegisterContract, opts ...grpc.CallOption) (*MsgRegisterContractResponse, error)
-	ContractDepositRent(ctx context.Context, in *MsgContractDepositRent, opts ...grpc.CallOption) (*MsgContractDepositRentResponse, error)
-	UnregisterContract(ctx context.Context, in *MsgUnregisterContract, opts ...grpc.CallOption) (*MsgUnregisterContractResponse, error)
-	RegisterPairs(ctx context.Context, in *MsgRegisterPairs, opts ...grpc.CallOption) (*MsgRegisterPairsResponse, error)
-	UpdatePriceTickSize(ctx context.Context, in *MsgUpdatePriceTickSize, opts ...grpc.CallOption) (*MsgUpdateTickSizeResponse, error)
-	UpdateQuantityTickSize(ctx context.Context, in *MsgUpdateQuantityTickSize, opts ...grpc.CallOption) (*MsgUpdateTickSizeResponse, error)
-	UnsuspendContract(ctx context.Context, in *MsgUnsuspendContract, opts ...grpc.CallOption) (*MsgUnsuspendContractResponse, error)
+func (m *MsgSetRentLowBalanceThresholds) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetRentLowBalanceThresholds) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetRentLowBalanceThresholds) GetThresholds() []uint64 {
+	if m != nil {
+		return m.Thresholds
+	}
+	return nil
 }
 
-func (c *msgClient) PlaceOrders(ctx context.Context, in *MsgPlaceOrders, opts ...grpc.CallOption) (*MsgPlaceOrdersResponse, error) {
-	out := new(MsgPlaceOrdersResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/PlaceOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetRentLowBalanceThresholdsResponse struct {
 }
 
-func (c *msgClient) CancelOrders(ctx context.Context, in *MsgCancelOrders, opts ...grpc.CallOption) (*MsgCancelOrdersResponse, error) {
+func (m *MsgSetRentLowBalanceThresholdsResponse) Reset() {
+	*m = MsgSetRentLowBalanceThresholdsResponse{}
+}
+func (m *MsgSetRentLowBalanceThresholdsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRentLowBalanceThresholdsResponse) ProtoMessage()    {}
+func (*MsgSetRentLowBalanceThresholdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{22}
+}
+func (m *MsgSetRentLowBalanceThresholdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRentLowBalanceThresholdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRentLowBalanceThresholdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRentLowBalanceThresholdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRentLowBalanceThresholdsResponse.Merge(m, src)
+}
+func (m *MsgSetRentLowBalanceThresholdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRentLowBalanceThresholdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRentLowBalanceThresholdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRentLowBalanceThresholdsResponse proto.InternalMessageInfo
+
+// Makes the sender the funding account that tops up the rent balance of a contract, or disables
+// auto top-ups if amount is 0. An existing funding account can only be replaced or removed by
+// itself or by the creator of the contract.
+type MsgSetRentAutoTopup struct {
+	FundingAccount string `protobuf:"bytes,1,opt,name=fundingAccount,proto3" json:"funding_account"`
+	ContractAddr   string `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	Threshold      uint64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold"`
+	Amount         uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgSetRentAutoTopup) Reset()         { *m = MsgSetRentAutoTopup{} }
+func (m *MsgSetRentAutoTopup) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRentAutoTopup) ProtoMessage()    {}
+func (*MsgSetRentAutoTopup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{23}
+}
+func (m *MsgSetRentAutoTopup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRentAutoTopup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRentAutoTopup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRentAutoTopup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRentAutoTopup.Merge(m, src)
+}
+func (m *MsgSetRentAutoTopup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRentAutoTopup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRentAutoTopup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRentAutoTopup proto.InternalMessageInfo
+
+func (m *MsgSetRentAutoTopup) GetFundingAccount() string {
+	if m != nil {
+		return m.FundingAccount
+	}
+	return ""
+}
+
+func (m *MsgSetRentAutoTopup) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *MsgSetRentAutoTopup) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MsgSetRentAutoTopup) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type MsgSetRentAutoTopupResponse struct {
+}
+
+func (m *MsgSetRentAutoTopupResponse) Reset()         { *m = MsgSetRentAutoTopupResponse{} }
+func (m *MsgSetRentAutoTopupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRentAutoTopupResponse) ProtoMessage()    {}
+func (*MsgSetRentAutoTopupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{24}
+}
+func (m *MsgSetRentAutoTopupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRentAutoTopupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRentAutoTopupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRentAutoTopupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRentAutoTopupResponse.Merge(m, src)
+}
+func (m *MsgSetRentAutoTopupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRentAutoTopupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRentAutoTopupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRentAutoTopupResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgPlaceOrders)(nil), "seiprotocol.seichain.dex.MsgPlaceOrders")
+	proto.RegisterType((*MsgPlaceOrdersResponse)(nil), "seiprotocol.seichain.dex.MsgPlaceOrdersResponse")
+	proto.RegisterType((*MsgCancelOrders)(nil), "seiprotocol.seichain.dex.MsgCancelOrders")
+	proto.RegisterType((*MsgCancelOrdersResponse)(nil), "seiprotocol.seichain.dex.MsgCancelOrdersResponse")
+	proto.RegisterType((*MsgCancelAll)(nil), "seiprotocol.seichain.dex.MsgCancelAll")
+	proto.RegisterType((*MsgCancelAllResponse)(nil), "seiprotocol.seichain.dex.MsgCancelAllResponse")
+	proto.RegisterType((*MsgCancelReplace)(nil), "seiprotocol.seichain.dex.MsgCancelReplace")
+	proto.RegisterType((*MsgCancelReplaceResponse)(nil), "seiprotocol.seichain.dex.MsgCancelReplaceResponse")
+	proto.RegisterType((*MsgRegisterContract)(nil), "seiprotocol.seichain.dex.MsgRegisterContract")
+	proto.RegisterType((*MsgRegisterContractResponse)(nil), "seiprotocol.seichain.dex.MsgRegisterContractResponse")
+	proto.RegisterType((*MsgContractDepositRent)(nil), "seiprotocol.seichain.dex.MsgContractDepositRent")
+	proto.RegisterType((*MsgContractDepositRentResponse)(nil), "seiprotocol.seichain.dex.MsgContractDepositRentResponse")
+	proto.RegisterType((*MsgUnregisterContract)(nil), "seiprotocol.seichain.dex.MsgUnregisterContract")
+	proto.RegisterType((*MsgUnregisterContractResponse)(nil), "seiprotocol.seichain.dex.MsgUnregisterContractResponse")
+	proto.RegisterType((*MsgRegisterPairs)(nil), "seiprotocol.seichain.dex.MsgRegisterPairs")
+	proto.RegisterType((*MsgRegisterPairsResponse)(nil), "seiprotocol.seichain.dex.MsgRegisterPairsResponse")
+	proto.RegisterType((*MsgUpdatePriceTickSize)(nil), "seiprotocol.seichain.dex.MsgUpdatePriceTickSize")
+	proto.RegisterType((*MsgUpdateQuantityTickSize)(nil), "seiprotocol.seichain.dex.MsgUpdateQuantityTickSize")
+	proto.RegisterType((*MsgUpdateTickSizeResponse)(nil), "seiprotocol.seichain.dex.MsgUpdateTickSizeResponse")
+	proto.RegisterType((*MsgUnsuspendContract)(nil), "seiprotocol.seichain.dex.MsgUnsuspendContract")
+	proto.RegisterType((*MsgUnsuspendContractResponse)(nil), "seiprotocol.seichain.dex.MsgUnsuspendContractResponse")
+	proto.RegisterType((*MsgSetRentLowBalanceThresholds)(nil), "seiprotocol.seichain.dex.MsgSetRentLowBalanceThresholds")
+	proto.RegisterType((*MsgSetRentLowBalanceThresholdsResponse)(nil), "seiprotocol.seichain.dex.MsgSetRentLowBalanceThresholdsResponse")
+	proto.RegisterType((*MsgSetRentAutoTopup)(nil), "seiprotocol.seichain.dex.MsgSetRentAutoTopup")
+	proto.RegisterType((*MsgSetRentAutoTopupResponse)(nil), "seiprotocol.seichain.dex.MsgSetRentAutoTopupResponse")
+}
+
+func init() { proto.RegisterFile("dex/tx.proto", fileDescriptor_463701e671e5a5e0) }
+
+var fileDescriptor_463701e671e5a5e0 = []byte{
+	// 1367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6f, 0xdc, 0x54,
+	0x17, 0x8e, 0x93, 0x49, 0xdb, 0x9c, 0x4c, 0xf3, 0xe1, 0xe9, 0xc7, 0xd4, 0x7d, 0x3b, 0xce, 0x6b,
+	0x89, 0x6a, 0x68, 0x15, 0x0f, 0x9d, 0xaa, 0xd0, 0x82, 0x90, 0x1a, 0x27, 0x12, 0xaa, 0xd4, 0xa8,
+	0xe1, 0xb6, 0x01, 0x09, 0x21, 0x8d, 0x1c, 0xfb, 0x76, 0x72, 0xa9, 0xe3, 0x3b, 0xf2, 0xf5, 0xd0,
+	0xb4, 0x45, 0x48, 0xf0, 0x0b, 0x58, 0xb0, 0x81, 0x25, 0x4b, 0x76, 0xec, 0x40, 0x2c, 0x59, 0xd0,
+	0x65, 0x25, 0x36, 0x6c, 0x30, 0xa8, 0xdd, 0xcd, 0xb2, 0x7f, 0x00, 0xe4, 0x6b, 0xfb, 0x8e, 0xed,
+	0x99, 0x18, 0x4f, 0x50, 0x90, 0x58, 0xd9, 0x73, 0xfc, 0x3c, 0xe7, 0xfb, 0x9e, 0x63, 0x0f, 0x54,
+	0x6d, 0xbc, 0xdf, 0xf2, 0xf7, 0xf5, 0x9e, 0x47, 0x7d, 0x2a, 0xd7, 0x19, 0x26, 0xfc, 0xce, 0xa2,
+	0x8e, 0xce, 0x30, 0xb1, 0x76, 0x4d, 0xe2, 0xea, 0x36, 0xde, 0x57, 0x1a, 0x16, 0x65, 0x7b, 0x94,
+	0xb5, 0x76, 0x4c, 0x86, 0x5b, 0x1f, 0x5f, 0xd9, 0xc1, 0xbe, 0x79, 0xa5, 0x65, 0x51, 0xe2, 0x46,
+	0x4c, 0xe5, 0x54, 0x97, 0x76, 0x29, 0xbf, 0x6d, 0x85, 0x77, 0xb1, 0x54, 0x0e, 0xb5, 0x5b, 0xd4,
+	0xf5, 0x3d, 0xd3, 0xf2, 0x63, 0xd9, 0x62, 0x28, 0xa3, 0x9e, 0x8d, 0xbd, 0x58, 0xb0, 0x10, 0x0a,
+	0x7a, 0x26, 0x49, 0x7e, 0xd7, 0xb8, 0x4b, 0xc4, 0x7a, 0xd0, 0x61, 0xe4, 0x31, 0x4e, 0xb3, 0xb0,
+	0xdb, 0xdf, 0x63, 0x69, 0x96, 0x87, 0xdd, 0x58, 0xad, 0xf6, 0xcd, 0x34, 0x2c, 0x6c, 0xb2, 0xee,
+	0x96, 0x63, 0x5a, 0xf8, 0x4e, 0xa8, 0x9d, 0xc9, 0xaf, 0xc0, 0x71, 0xcb, 0xc3, 0xa6, 0x4f, 0xbd,
+	0xba, 0xb4, 0x22, 0x35, 0xe7, 0x8c, 0xf9, 0x41, 0xa0, 0x26, 0x22, 0x94, 0xdc, 0xc8, 0xeb, 0x70,
+	0x8c, 0xbb, 0xc3, 0xea, 0xd3, 0x2b, 0x33, 0xcd, 0xf9, 0xb6, 0xaa, 0x1f, 0x94, 0x05, 0x9d, 0x2b,
+	0x36, 0x60, 0x10, 0xa8, 0x31, 0x05, 0xc5, 0x57, 0xf9, 0x3a, 0x54, 0x93, 0x38, 0xd7, 0x6c, 0xdb,
+	0xab, 0xcf, 0x70, 0x83, 0xa7, 0x06, 0x81, 0xba, 0x94, 0xc8, 0x3b, 0xa6, 0x6d, 0x7b, 0x98, 0x31,
+	0x94, 0x41, 0xca, 0x1f, 0xc1, 0xec, 0xfd, 0xbe, 0x6b, 0xb3, 0x7a, 0x85, 0x5b, 0x3f, 0xa7, 0x47,
+	0x99, 0xd6, 0xc3, 0x4c, 0xeb, 0x71, 0xa6, 0xf5, 0x75, 0x4a, 0x5c, 0xe3, 0xc6, 0xd3, 0x40, 0x9d,
+	0x1a, 0x04, 0x6a, 0x84, 0xff, 0xf6, 0x77, 0xb5, 0xd9, 0x25, 0xfe, 0x6e, 0x7f, 0x47, 0xb7, 0xe8,
+	0x5e, 0x2b, 0xae, 0x4f, 0x74, 0x59, 0x65, 0xf6, 0x83, 0x96, 0xff, 0xa8, 0x87, 0x19, 0x67, 0x32,
+	0x14, 0x51, 0xb4, 0xf7, 0xe1, 0x4c, 0x36, 0x47, 0x08, 0xb3, 0x1e, 0x75, 0x19, 0x96, 0xdf, 0x86,
+	0x13, 0x3c, 0x92, 0x5b, 0x36, 0xab, 0x4b, 0x2b, 0x33, 0xcd, 0x8a, 0xf1, 0xff, 0x41, 0xa0, 0xce,
+	0x71, 0x59, 0x87, 0xd8, 0xec, 0x65, 0xa0, 0x2e, 0x3d, 0x32, 0xf7, 0x9c, 0x37, 0x35, 0x21, 0xd2,
+	0x90, 0xa0, 0x68, 0xbf, 0x48, 0xb0, 0xb8, 0xc9, 0xba, 0xeb, 0xa6, 0x6b, 0x61, 0x67, 0xb2, 0xf4,
+	0x77, 0xe0, 0xa4, 0xc5, 0x69, 0x8e, 0xe9, 0x13, 0xea, 0x26, 0x55, 0xb8, 0x78, 0x70, 0x15, 0xd6,
+	0x53, 0x70, 0x63, 0x79, 0x10, 0xa8, 0x59, 0x05, 0x28, 0xfb, 0xf3, 0xf0, 0xa5, 0xd1, 0xce, 0xc1,
+	0xd9, 0x5c, 0x50, 0x49, 0xbe, 0xb4, 0x9f, 0xa7, 0xa1, 0x2a, 0x9e, 0xad, 0x39, 0x4e, 0xd9, 0x68,
+	0xf3, 0xce, 0x4c, 0x97, 0xee, 0x93, 0x16, 0x40, 0xcf, 0x23, 0x16, 0xde, 0xc0, 0x2e, 0xdd, 0x8b,
+	0x83, 0x58, 0x1c, 0x04, 0xea, 0x3c, 0x97, 0x76, 0xec, 0x50, 0x8c, 0x52, 0x90, 0x90, 0x60, 0x32,
+	0x86, 0xfd, 0x88, 0x50, 0x19, 0x12, 0xb8, 0x34, 0x21, 0x0c, 0x21, 0xb2, 0x0f, 0x72, 0x8f, 0x32,
+	0x12, 0x66, 0x6d, 0x83, 0x78, 0xd8, 0x8a, 0xca, 0x31, 0xbb, 0x32, 0xd3, 0x5c, 0x68, 0x5f, 0x3e,
+	0xb8, 0x1c, 0x5b, 0x79, 0x8e, 0x71, 0x76, 0x10, 0xa8, 0xb5, 0x44, 0x55, 0xc7, 0x16, 0xba, 0xd0,
+	0x18, 0xfd, 0xda, 0x13, 0x38, 0x95, 0x4e, 0xa4, 0xe8, 0x48, 0x0b, 0x96, 0xe3, 0x3a, 0x62, 0xfb,
+	0x4e, 0xb6, 0x35, 0xaf, 0x85, 0xfa, 0xc5, 0xc3, 0x4e, 0xba, 0x49, 0x95, 0xa8, 0x49, 0xc7, 0x3c,
+	0xd4, 0xd0, 0xa8, 0x3e, 0xed, 0xcf, 0x69, 0x58, 0x12, 0xd6, 0x11, 0xee, 0x85, 0x27, 0xe3, 0xe8,
+	0x4b, 0xf9, 0x21, 0x54, 0xd3, 0x2d, 0xca, 0x8b, 0x59, 0xbe, 0xe3, 0x97, 0x06, 0x81, 0x9a, 0xe1,
+	0xa3, 0xcc, 0x2f, 0xf9, 0x26, 0xcc, 0xf2, 0xa0, 0x79, 0xc9, 0x4b, 0x8c, 0xb3, 0xb9, 0x70, 0xa4,
+	0x70, 0x06, 0x8a, 0x2e, 0xc3, 0x91, 0x34, 0x7b, 0xf4, 0x23, 0x69, 0x1b, 0xea, 0xf9, 0x02, 0x88,
+	0x16, 0xb8, 0x01, 0xc7, 0xe3, 0x09, 0xc3, 0x0b, 0x51, 0x31, 0xd4, 0x41, 0xa0, 0x9e, 0x48, 0x2a,
+	0xfa, 0x32, 0x50, 0x17, 0xb3, 0x23, 0x49, 0x43, 0x09, 0x5e, 0xeb, 0x43, 0x6d, 0x93, 0x75, 0x11,
+	0xee, 0x12, 0xe6, 0x63, 0x6f, 0x3d, 0xce, 0xbe, 0x5c, 0xcf, 0x95, 0x76, 0x58, 0xcd, 0x0d, 0x38,
+	0x91, 0xd4, 0x88, 0x57, 0x72, 0xbe, 0xdd, 0x2c, 0xa8, 0x47, 0x8c, 0xbc, 0xe5, 0xde, 0xa7, 0xef,
+	0xb5, 0x91, 0x60, 0x6a, 0x17, 0xe0, 0xfc, 0x18, 0xb3, 0x62, 0x6a, 0x7c, 0x2d, 0xf1, 0x01, 0x9c,
+	0xc8, 0x37, 0x30, 0x3f, 0x0f, 0x08, 0xbb, 0xfe, 0x48, 0x37, 0x49, 0xa5, 0xbb, 0x49, 0x83, 0x63,
+	0xe6, 0x1e, 0xed, 0xbb, 0x91, 0xdf, 0x95, 0x68, 0x3d, 0x45, 0x12, 0x14, 0x5f, 0x43, 0x0c, 0xc3,
+	0x6e, 0xd8, 0x14, 0xd1, 0xe0, 0xe0, 0x98, 0x48, 0x82, 0xe2, 0xab, 0xb6, 0x02, 0x8d, 0xf1, 0xbe,
+	0x09, 0xf7, 0xf7, 0xe1, 0xf4, 0x26, 0xeb, 0x6e, 0xbb, 0x5e, 0x3e, 0xad, 0x47, 0x7d, 0x62, 0x34,
+	0x15, 0x2e, 0x8c, 0xb5, 0x2c, 0x5c, 0xfb, 0x49, 0x82, 0xa5, 0x54, 0xe6, 0xb7, 0x4c, 0xe2, 0xb1,
+	0x82, 0x6a, 0x7f, 0x29, 0xc1, 0xf2, 0x8e, 0xe9, 0x5b, 0xbb, 0x89, 0x95, 0x9e, 0x49, 0xc2, 0xdc,
+	0x84, 0xed, 0x5e, 0x30, 0xea, 0x8c, 0x90, 0x92, 0xd8, 0x0e, 0x6d, 0x88, 0x03, 0x50, 0xe3, 0xda,
+	0x3a, 0x22, 0x8c, 0x50, 0xdf, 0x70, 0x1c, 0x8d, 0x79, 0xa8, 0xa1, 0x51, 0x07, 0x34, 0x05, 0xea,
+	0xf9, 0x20, 0x44, 0x84, 0xdf, 0x47, 0xbd, 0xb3, 0xdd, 0xb3, 0x4d, 0x1f, 0x6f, 0x79, 0xc4, 0xc2,
+	0xf7, 0x88, 0xf5, 0xe0, 0x2e, 0x79, 0x5c, 0x7a, 0x60, 0x3d, 0x84, 0xaa, 0x1f, 0x53, 0x6e, 0x13,
+	0xe6, 0xc7, 0x8b, 0x56, 0x3b, 0x38, 0xdc, 0xc4, 0x80, 0xd1, 0x8a, 0xa3, 0x5c, 0x10, 0xaf, 0x65,
+	0x1d, 0x87, 0x30, 0xff, 0x65, 0xa0, 0x9e, 0x8e, 0x02, 0xcc, 0xca, 0x35, 0x94, 0x31, 0xa4, 0xfd,
+	0x28, 0xc1, 0x39, 0xe1, 0xfa, 0xbb, 0x7d, 0xd3, 0xf5, 0x89, 0xff, 0xe8, 0x3f, 0xe3, 0xfd, 0xf9,
+	0x94, 0xf3, 0x89, 0x4e, 0x51, 0x95, 0x87, 0x7c, 0x7b, 0x6d, 0xbb, 0xac, 0xcf, 0x7a, 0xd8, 0xb5,
+	0xff, 0xbd, 0x13, 0xd1, 0x80, 0xff, 0x8d, 0x33, 0x2c, 0x1c, 0xfb, 0x4e, 0xe2, 0xc7, 0xf9, 0x2e,
+	0xe6, 0x47, 0xf8, 0x36, 0x7d, 0x68, 0x98, 0x4e, 0x38, 0x64, 0xef, 0xed, 0x7a, 0x98, 0xed, 0x52,
+	0xc7, 0x66, 0x47, 0xbf, 0xe7, 0x74, 0x00, 0x5f, 0x98, 0xe3, 0xa7, 0xab, 0x62, 0x2c, 0x0c, 0x02,
+	0x35, 0x25, 0x45, 0xa9, 0x7b, 0xad, 0x09, 0x17, 0x8b, 0x5d, 0x16, 0xd1, 0xfd, 0x26, 0x41, 0x6d,
+	0x08, 0x5d, 0xeb, 0xfb, 0xf4, 0x1e, 0xed, 0xf5, 0x7b, 0xf2, 0x5b, 0xb0, 0x10, 0xae, 0x15, 0xe2,
+	0x76, 0xd7, 0x2c, 0x8b, 0xcf, 0xc4, 0x28, 0xb2, 0xda, 0x20, 0x50, 0x17, 0xe3, 0x27, 0x1d, 0x33,
+	0x7a, 0x84, 0x72, 0xd0, 0x7f, 0x10, 0xe8, 0x65, 0x98, 0x13, 0x61, 0xf0, 0x09, 0x5b, 0x31, 0x4e,
+	0x86, 0xaf, 0xcf, 0x42, 0x88, 0x86, 0xb7, 0xa9, 0x79, 0x5d, 0x39, 0x68, 0x5e, 0xc7, 0x7b, 0x24,
+	0x1f, 0x5e, 0x12, 0x7e, 0xfb, 0x87, 0x2a, 0xcc, 0x6c, 0xb2, 0xae, 0x4c, 0x60, 0x3e, 0xfd, 0xc1,
+	0x53, 0xb0, 0xb1, 0xb2, 0xaf, 0xfd, 0xca, 0x6b, 0x65, 0x91, 0x62, 0x17, 0x3b, 0x50, 0xcd, 0xbc,
+	0xdd, 0xbf, 0x5a, 0xa8, 0x21, 0x0d, 0x55, 0xae, 0x94, 0x86, 0x0a, 0x6b, 0xfb, 0xb0, 0x34, 0xb2,
+	0xbb, 0x57, 0x0b, 0xd5, 0xe4, 0xe1, 0xca, 0xb5, 0x89, 0xe0, 0xc2, 0xf2, 0x67, 0x12, 0xd4, 0xc6,
+	0xed, 0xe7, 0xe2, 0x8c, 0x8d, 0x61, 0x28, 0xd7, 0x27, 0x65, 0x08, 0x1f, 0x3e, 0x05, 0x79, 0xcc,
+	0x92, 0x6d, 0x15, 0xea, 0x1b, 0x25, 0x28, 0x6f, 0x4c, 0x48, 0x10, 0xf6, 0x29, 0x9c, 0xcc, 0x2e,
+	0xd2, 0x4b, 0xa5, 0x72, 0xc9, 0xb1, 0x4a, 0xbb, 0x3c, 0x56, 0x18, 0xfc, 0x04, 0x6a, 0xe3, 0xf6,
+	0x5a, 0x71, 0xce, 0xc7, 0x30, 0x94, 0xab, 0x25, 0x18, 0xf9, 0x19, 0x2e, 0x7f, 0x2e, 0xc1, 0x99,
+	0x03, 0x76, 0x53, 0x19, 0x7d, 0x79, 0xd2, 0xe1, 0x9c, 0x78, 0x02, 0xcb, 0xa3, 0x5b, 0x44, 0xff,
+	0x9b, 0x0a, 0xe6, 0xf0, 0xca, 0xeb, 0x93, 0xe1, 0x53, 0xdf, 0x5a, 0x73, 0xc3, 0x2f, 0xd9, 0x8b,
+	0x25, 0x8e, 0xeb, 0x9a, 0xe3, 0x28, 0x7a, 0x39, 0x5c, 0xba, 0xab, 0xb2, 0xdf, 0x59, 0x97, 0x4a,
+	0x28, 0x88, 0xb1, 0x4a, 0xbb, 0x3c, 0x56, 0x18, 0xfc, 0x4a, 0x82, 0xf3, 0x45, 0xfb, 0xaf, 0xf8,
+	0x80, 0x16, 0x30, 0x95, 0x9b, 0x87, 0x65, 0xa6, 0x07, 0xdc, 0xc8, 0xf2, 0x5a, 0x2d, 0xa3, 0x55,
+	0xc0, 0x95, 0x6b, 0x13, 0xc1, 0x13, 0xcb, 0xc6, 0x3b, 0x4f, 0x9f, 0x37, 0xa4, 0x67, 0xcf, 0x1b,
+	0xd2, 0x1f, 0xcf, 0x1b, 0xd2, 0x17, 0x2f, 0x1a, 0x53, 0xcf, 0x5e, 0x34, 0xa6, 0x7e, 0x7d, 0xd1,
+	0x98, 0xfa, 0x60, 0x35, 0xf5, 0xed, 0xc6, 0x30, 0x59, 0x4d, 0x74, 0xf3, 0x1f, 0x5c, 0x79, 0x6b,
+	0xbf, 0xc5, 0xff, 0x9c, 0x0b, 0x3f, 0xe3, 0x76, 0x8e, 0xf1, 0xe7, 0x57, 0xff, 0x1a, 0x00, 0xe2,
+	0x8b, 0x1b, 0x72, 0x43, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	PlaceOrders(ctx context.Context, in *MsgPlaceOrders, opts ...grpc.CallOption) (*MsgPlaceOrdersResponse, error)
+	CancelOrders(ctx context.Context, in *MsgCancelOrders, opts ...grpc.CallOption) (*MsgCancelOrdersResponse, error)
+	RegisterContract(ctx context.Context, in *MsgRegisterContract, opts ...grpc.CallOption) (*MsgRegisterContractResponse, error)
+	ContractDepositRent(ctx context.Context, in *MsgContractDepositRent, opts ...grpc.CallOption) (*MsgContractDepositRentResponse, error)
+	UnregisterContract(ctx context.Context, in *MsgUnregisterContract, opts ...grpc.CallOption) (*MsgUnregisterContractResponse, error)
+	RegisterPairs(ctx context.Context, in *MsgRegisterPairs, opts ...grpc.CallOption) (*MsgRegisterPairsResponse, error)
+	UpdatePriceTickSize(ctx context.Context, in *MsgUpdatePriceTickSize, opts ...grpc.CallOption) (*MsgUpdateTickSizeResponse, error)
+	UpdateQuantityTickSize(ctx context.Context, in *MsgUpdateQuantityTickSize, opts ...grpc.CallOption) (*MsgUpdateTickSizeResponse, error)
+	UnsuspendContract(ctx context.Context, in *MsgUnsuspendContract, opts ...grpc.CallOption) (*MsgUnsuspendContractResponse, error)
+	CancelAll(ctx context.Context, in *MsgCancelAll, opts ...grpc.CallOption) (*MsgCancelAllResponse, error)
+	CancelReplace(ctx context.Context, in *MsgCancelReplace, opts ...grpc.CallOption) (*MsgCancelReplaceResponse, error)
+	SetRentLowBalanceThresholds(ctx context.Context, in *MsgSetRentLowBalanceThresholds, opts ...grpc.CallOption) (*MsgSetRentLowBalanceThresholdsResponse, error)
+	SetRentAutoTopup(ctx context.Context, in *MsgSetRentAutoTopup, opts ...grpc.CallOption) (*MsgSetRentAutoTopupResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) PlaceOrders(ctx context.Context, in *MsgPlaceOrders, opts ...grpc.CallOption) (*MsgPlaceOrdersResponse, error) {
+	out := new(MsgPlaceOrdersResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/PlaceOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOrders(ctx context.Context, in *MsgCancelOrders, opts ...grpc.CallOption) (*MsgCancelOrdersResponse, error) {
 	out := new(MsgCancelOrdersResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/CancelOrders", in, out, opts...)
 	if err != nil {
@@ -1025,6 +1516,42 @@ func (c *msgClient) UnsuspendContract(ctx context.Context, in *MsgUnsuspendContr
 	return out, nil
 }
 
+func (c *msgClient) CancelAll(ctx context.Context, in *MsgCancelAll, opts ...grpc.CallOption) (*MsgCancelAllResponse, error) {
+	out := new(MsgCancelAllResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/CancelAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelReplace(ctx context.Context, in *MsgCancelReplace, opts ...grpc.CallOption) (*MsgCancelReplaceResponse, error) {
+	out := new(MsgCancelReplaceResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/CancelReplace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRentLowBalanceThresholds(ctx context.Context, in *MsgSetRentLowBalanceThresholds, opts ...grpc.CallOption) (*MsgSetRentLowBalanceThresholdsResponse, error) {
+	out := new(MsgSetRentLowBalanceThresholdsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/SetRentLowBalanceThresholds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRentAutoTopup(ctx context.Context, in *MsgSetRentAutoTopup, opts ...grpc.CallOption) (*MsgSetRentAutoTopupResponse, error) {
+	out := new(MsgSetRentAutoTopupResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/SetRentAutoTopup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	PlaceOrders(context.Context, *MsgPlaceOrders) (*MsgPlaceOrdersResponse, error)
@@ -1036,6 +1563,10 @@ type MsgServer interface {
 	UpdatePriceTickSize(context.Context, *MsgUpdatePriceTickSize) (*MsgUpdateTickSizeResponse, error)
 	UpdateQuantityTickSize(context.Context, *MsgUpdateQuantityTickSize) (*MsgUpdateTickSizeResponse, error)
 	UnsuspendContract(context.Context, *MsgUnsuspendContract) (*MsgUnsuspendContractResponse, error)
+	CancelAll(context.Context, *MsgCancelAll) (*MsgCancelAllResponse, error)
+	CancelReplace(context.Context, *MsgCancelReplace) (*MsgCancelReplaceResponse, error)
+	SetRentLowBalanceThresholds(context.Context, *MsgSetRentLowBalanceThresholds) (*MsgSetRentLowBalanceThresholdsResponse, error)
+	SetRentAutoTopup(context.Context, *MsgSetRentAutoTopup) (*MsgSetRentAutoTopupResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1069,6 +1600,18 @@ func (*UnimplementedMsgServer) UpdateQuantityTickSize(ctx context.Context, req *
 func (*UnimplementedMsgServer) UnsuspendContract(ctx context.Context, req *MsgUnsuspendContract) (*MsgUnsuspendContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendContract not implemented")
 }
+func (*UnimplementedMsgServer) CancelAll(ctx context.Context, req *MsgCancelAll) (*MsgCancelAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAll not implemented")
+}
+func (*UnimplementedMsgServer) CancelReplace(ctx context.Context, req *MsgCancelReplace) (*MsgCancelReplaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReplace not implemented")
+}
+func (*UnimplementedMsgServer) SetRentLowBalanceThresholds(ctx context.Context, req *MsgSetRentLowBalanceThresholds) (*MsgSetRentLowBalanceThresholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRentLowBalanceThresholds not implemented")
+}
+func (*UnimplementedMsgServer) SetRentAutoTopup(ctx context.Context, req *MsgSetRentAutoTopup) (*MsgSetRentAutoTopupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRentAutoTopup not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1236,6 +1779,78 @@ func _Msg_UnsuspendContract_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Msg/CancelAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAll(ctx, req.(*MsgCancelAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelReplace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Msg/CancelReplace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelReplace(ctx, req.(*MsgCancelReplace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRentLowBalanceThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRentLowBalanceThresholds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRentLowBalanceThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Msg/SetRentLowBalanceThresholds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRentLowBalanceThresholds(ctx, req.(*MsgSetRentLowBalanceThresholds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRentAutoTopup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRentAutoTopup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRentAutoTopup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Msg/SetRentAutoTopup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRentAutoTopup(ctx, req.(*MsgSetRentAutoTopup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "seiprotocol.seichain.dex.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1276,6 +1891,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnsuspendContract",
 			Handler:    _Msg_UnsuspendContract_Handler,
 		},
+		{
+			MethodName: "CancelAll",
+			Handler:    _Msg_CancelAll_Handler,
+		},
+		{
+			MethodName: "CancelReplace",
+			Handler:    _Msg_CancelReplace_Handler,
+		},
+		{
+			MethodName: "SetRentLowBalanceThresholds",
+			Handler:    _Msg_SetRentLowBalanceThresholds_Handler,
+		},
+		{
+			MethodName: "SetRentAutoTopup",
+			Handler:    _Msg_SetRentAutoTopup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dex/tx.proto",
@@ -1461,7 +2092,7 @@ func (m *MsgCancelOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterContract) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelAll) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1471,34 +2102,247 @@ func (m *MsgRegisterContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelAll) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Contract != nil {
-		{
-			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.PositionDirections) > 0 {
+		dAtA4 := make([]byte, len(m.PositionDirections)*10)
+		var j3 int
+		for _, num := range m.PositionDirections {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetDenom)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledOrderIds) > 0 {
+		dAtA6 := make([]byte, len(m.CancelledOrderIds)*10)
+		var j5 int
+		for _, num := range m.CancelledOrderIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelReplace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelReplace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelReplace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Cancellation != nil {
+		{
+			size, err := m.Cancellation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelReplaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelReplaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelReplaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
@@ -1889,64 +2733,212 @@ func (m *MsgUnsuspendContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetRentLowBalanceThresholds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgPlaceOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetRentLowBalanceThresholds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRentLowBalanceThresholds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Thresholds) > 0 {
+		dAtA11 := make([]byte, len(m.Thresholds)*10)
+		var j10 int
+		for _, num := range m.Thresholds {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTx(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ContractAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Funds) > 0 {
-		for _, e := range m.Funds {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPlaceOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.OrderIds) > 0 {
-		l = 0
-		for _, e := range m.OrderIds {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+func (m *MsgSetRentLowBalanceThresholdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelOrders) Size() (n int) {
-	if m == nil {
+func (m *MsgSetRentLowBalanceThresholdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRentLowBalanceThresholdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRentAutoTopup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRentAutoTopup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRentAutoTopup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FundingAccount) > 0 {
+		i -= len(m.FundingAccount)
+		copy(dAtA[i:], m.FundingAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FundingAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRentAutoTopupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRentAutoTopupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRentAutoTopupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPlaceOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPlaceOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		l = 0
+		for _, e := range m.OrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCancelOrders) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1977,6 +2969,97 @@ func (m *MsgCancelOrdersResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PositionDirections) > 0 {
+		l = 0
+		for _, e := range m.PositionDirections {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCancelAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CancelledOrderIds) > 0 {
+		l = 0
+		for _, e := range m.CancelledOrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCancelReplace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Cancellation != nil {
+		l = m.Cancellation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelReplaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
 func (m *MsgRegisterContract) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2159,6 +3242,71 @@ func (m *MsgUnsuspendContractResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetRentLowBalanceThresholds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Thresholds) > 0 {
+		l = 0
+		for _, e := range m.Thresholds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSetRentLowBalanceThresholdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRentAutoTopup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FundingAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgSetRentAutoTopupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2449,9 +3597,987 @@ func (m *MsgPlaceOrdersResponse) Unmarshal(dAtA []byte) error {
 					}
 					m.OrderIds = append(m.OrderIds, v)
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancellations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cancellations = append(m.Cancellations, &Cancellation{})
+			if err := m.Cancellations[len(m.Cancellations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v PositionDirection
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PositionDirection(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PositionDirections = append(m.PositionDirections, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PositionDirections) == 0 {
+					m.PositionDirections = make([]PositionDirection, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PositionDirection
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PositionDirection(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PositionDirections = append(m.PositionDirections, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionDirections", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CancelledOrderIds = append(m.CancelledOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CancelledOrderIds) == 0 {
+					m.CancelledOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CancelledOrderIds = append(m.CancelledOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledOrderIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelReplace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelReplace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelReplace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancellation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cancellation == nil {
+				m.Cancellation = &Cancellation{}
+			}
+			if err := m.Cancellation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &Order{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelReplaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelReplaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelReplaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contract == nil {
+				m.Contract = &ContractInfoV2{}
 			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2473,7 +4599,7 @@ func (m *MsgPlaceOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2496,15 +4622,65 @@ func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrders: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgContractDepositRent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgContractDepositRent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgContractDepositRent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2532,13 +4708,13 @@ func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancellations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2548,29 +4724,14 @@ func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cancellations = append(m.Cancellations, &Cancellation{})
-			if err := m.Cancellations[len(m.Cancellations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2598,7 +4759,7 @@ func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2621,7 +4782,7 @@ func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgContractDepositRentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2644,10 +4805,10 @@ func (m *MsgCancelOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgContractDepositRentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgContractDepositRentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2671,7 +4832,7 @@ func (m *MsgCancelOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2694,10 +4855,10 @@ func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2734,9 +4895,9 @@ func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2746,27 +4907,23 @@ func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Contract == nil {
-				m.Contract = &ContractInfoV2{}
-			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2789,7 +4946,7 @@ func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2812,10 +4969,10 @@ func (m *MsgRegisterContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2839,7 +4996,7 @@ func (m *MsgRegisterContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgContractDepositRent) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2862,15 +5019,15 @@ func (m *MsgContractDepositRent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgContractDepositRent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterPairs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgContractDepositRent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterPairs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2898,32 +5055,13 @@ func (m *MsgContractDepositRent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batchcontractpair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2933,23 +5071,25 @@ func (m *MsgContractDepositRent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Batchcontractpair = append(m.Batchcontractpair, BatchContractPair{})
+			if err := m.Batchcontractpair[len(m.Batchcontractpair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2972,7 +5112,7 @@ func (m *MsgContractDepositRent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgContractDepositRentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2995,10 +5135,10 @@ func (m *MsgContractDepositRentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgContractDepositRentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgContractDepositRentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3022,7 +5162,7 @@ func (m *MsgContractDepositRentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterContract) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePriceTickSize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3045,10 +5185,10 @@ func (m *MsgUnregisterContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePriceTickSize: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePriceTickSize: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3085,9 +5225,9 @@ func (m *MsgUnregisterContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSizeList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3097,74 +5237,26 @@ func (m *MsgUnregisterContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUnregisterContractResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TickSizeList = append(m.TickSizeList, TickSize{})
+			if err := m.TickSizeList[len(m.TickSizeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterContractResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3186,7 +5278,7 @@ func (m *MsgUnregisterContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateQuantityTickSize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3209,10 +5301,10 @@ func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateQuantityTickSize: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateQuantityTickSize: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3247,9 +5339,9 @@ func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batchcontractpair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSizeList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3276,8 +5368,8 @@ func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batchcontractpair = append(m.Batchcontractpair, BatchContractPair{})
-			if err := m.Batchcontractpair[len(m.Batchcontractpair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TickSizeList = append(m.TickSizeList, TickSize{})
+			if err := m.TickSizeList[len(m.TickSizeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3302,7 +5394,7 @@ func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTickSizeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3325,10 +5417,10 @@ func (m *MsgRegisterPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTickSizeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTickSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3352,7 +5444,7 @@ func (m *MsgRegisterPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePriceTickSize) Unmarshal(dAtA []byte) error {
+func (m *MsgUnsuspendContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3375,10 +5467,10 @@ func (m *MsgUpdatePriceTickSize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePriceTickSize: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnsuspendContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePriceTickSize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnsuspendContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3415,9 +5507,9 @@ func (m *MsgUpdatePriceTickSize) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickSizeList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3427,26 +5519,74 @@ func (m *MsgUpdatePriceTickSize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TickSizeList = append(m.TickSizeList, TickSize{})
-			if err := m.TickSizeList[len(m.TickSizeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnsuspendContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnsuspendContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnsuspendContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3468,7 +5608,7 @@ func (m *MsgUpdatePriceTickSize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateQuantityTickSize) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRentLowBalanceThresholds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3491,10 +5631,10 @@ func (m *MsgUpdateQuantityTickSize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateQuantityTickSize: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRentLowBalanceThresholds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateQuantityTickSize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRentLowBalanceThresholds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3531,9 +5671,9 @@ func (m *MsgUpdateQuantityTickSize) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickSizeList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3543,26 +5683,100 @@ func (m *MsgUpdateQuantityTickSize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TickSizeList = append(m.TickSizeList, TickSize{})
-			if err := m.TickSizeList[len(m.TickSizeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Thresholds = append(m.Thresholds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Thresholds) == 0 {
+					m.Thresholds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Thresholds = append(m.Thresholds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3584,7 +5798,7 @@ func (m *MsgUpdateQuantityTickSize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTickSizeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRentLowBalanceThresholdsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3607,10 +5821,10 @@ func (m *MsgUpdateTickSizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTickSizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRentLowBalanceThresholdsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTickSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRentLowBalanceThresholdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3634,7 +5848,7 @@ func (m *MsgUpdateTickSizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnsuspendContract) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRentAutoTopup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3657,15 +5871,15 @@ func (m *MsgUnsuspendContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnsuspendContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRentAutoTopup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnsuspendContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRentAutoTopup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3693,7 +5907,7 @@ func (m *MsgUnsuspendContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.FundingAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3727,6 +5941,44 @@ func (m *MsgUnsuspendContract) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3748,7 +6000,7 @@ func (m *MsgUnsuspendContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnsuspendContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRentAutoTopupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3771,10 +6023,10 @@ func (m *MsgUnsuspendContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnsuspendContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRentAutoTopupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnsuspendContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRentAutoTopupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
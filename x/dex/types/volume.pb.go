@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/volume.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Volume traded for a pair within the bucket starting at the snapshot timestamp
+type Volume struct {
+	SnapshotTimestampInSeconds uint64                                 `protobuf:"varint,1,opt,name=snapshotTimestampInSeconds,proto3" json:"snapshot_timestamp_in_seconds"`
+	Quantity                   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity" yaml:"quantity"`
+	Notional                   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=notional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"notional" yaml:"notional"`
+	Pair                       *Pair                                  `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair"`
+}
+
+func (m *Volume) Reset()         { *m = Volume{} }
+func (m *Volume) String() string { return proto.CompactTextString(m) }
+func (*Volume) ProtoMessage()    {}
+func (*Volume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d8a4dbdb04d3dc, []int{0}
+}
+func (m *Volume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Volume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Volume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Volume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Volume.Merge(m, src)
+}
+func (m *Volume) XXX_Size() int {
+	return m.Size()
+}
+func (m *Volume) XXX_DiscardUnknown() {
+	xxx_messageInfo_Volume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Volume proto.InternalMessageInfo
+
+func (m *Volume) GetSnapshotTimestampInSeconds() uint64 {
+	if m != nil {
+		return m.SnapshotTimestampInSeconds
+	}
+	return 0
+}
+
+func (m *Volume) GetPair() *Pair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Volume)(nil), "seiprotocol.seichain.dex.Volume")
+}
+
+func init() { proto.RegisterFile("dex/volume.proto", fileDescriptor_e7d8a4dbdb04d3dc) }
+
+var fileDescriptor_e7d8a4dbdb04d3dc = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xb1, 0x4b, 0xfb, 0x40,
+	0x14, 0xc7, 0x73, 0xfd, 0x95, 0xd2, 0x5f, 0x04, 0x95, 0xe0, 0x10, 0x0a, 0x5e, 0x6a, 0x06, 0xe9,
+	0xd2, 0x0b, 0xe8, 0x26, 0x4e, 0x41, 0x10, 0x07, 0x41, 0xa2, 0x38, 0xb8, 0x94, 0x6b, 0x72, 0xb4,
+	0x87, 0xc9, 0xbd, 0xd8, 0x77, 0x95, 0xf6, 0xbf, 0xf0, 0xcf, 0xea, 0xd8, 0x51, 0x1c, 0x82, 0xb4,
+	0x5b, 0x47, 0xff, 0x01, 0x25, 0x57, 0x53, 0x5d, 0x74, 0x70, 0x49, 0xde, 0x7b, 0xdf, 0x2f, 0x9f,
+	0xef, 0xe3, 0x9e, 0xbd, 0x9b, 0x88, 0x49, 0xf0, 0x08, 0xe9, 0x38, 0x13, 0x2c, 0x1f, 0x81, 0x06,
+	0xc7, 0x45, 0x21, 0x4d, 0x15, 0x43, 0xca, 0x50, 0xc8, 0x78, 0xc8, 0xa5, 0x62, 0x89, 0x98, 0xb4,
+	0xf6, 0x06, 0x30, 0x00, 0x23, 0x05, 0x65, 0xb5, 0xf6, 0xb7, 0xb6, 0x4b, 0x42, 0xce, 0xe5, 0x68,
+	0xdd, 0xfb, 0xef, 0x35, 0xbb, 0x71, 0x6b, 0x80, 0x0e, 0xb7, 0x5b, 0xa8, 0x78, 0x8e, 0x43, 0xd0,
+	0x37, 0x32, 0x13, 0xa8, 0x79, 0x96, 0x5f, 0xa8, 0x6b, 0x11, 0x83, 0x4a, 0xd0, 0x25, 0x6d, 0xd2,
+	0xa9, 0x87, 0x07, 0xab, 0xc2, 0xdb, 0xaf, 0x5c, 0x3d, 0x5d, 0xd9, 0x7a, 0x52, 0xf5, 0x70, 0x6d,
+	0x8c, 0x7e, 0x81, 0x38, 0xd2, 0x6e, 0x3e, 0x8c, 0xb9, 0xd2, 0x52, 0x4f, 0xdd, 0x5a, 0x9b, 0x74,
+	0xfe, 0x87, 0x97, 0xb3, 0xc2, 0xb3, 0x5e, 0x0a, 0xef, 0x70, 0x20, 0xf5, 0x70, 0xdc, 0x67, 0x31,
+	0x64, 0x41, 0x0c, 0x98, 0x01, 0x7e, 0xfe, 0xba, 0x98, 0xdc, 0x07, 0x7a, 0x9a, 0x0b, 0x64, 0x67,
+	0x22, 0x5e, 0x15, 0xde, 0x86, 0xf0, 0x56, 0x78, 0x3b, 0x53, 0x9e, 0xa5, 0x27, 0x7e, 0x35, 0xf1,
+	0xa3, 0x8d, 0x58, 0x46, 0x29, 0xd0, 0x12, 0x14, 0x4f, 0xdd, 0x7f, 0x7f, 0x8d, 0xaa, 0x08, 0x5f,
+	0x51, 0xd5, 0xc4, 0x8f, 0x36, 0xa2, 0x73, 0x6a, 0xd7, 0xcb, 0x17, 0x75, 0xeb, 0x6d, 0xd2, 0xd9,
+	0x3a, 0xa2, 0xec, 0xa7, 0x93, 0xb0, 0x2b, 0x2e, 0x47, 0x61, 0x73, 0x55, 0x78, 0xc6, 0x1f, 0x99,
+	0x6f, 0x78, 0x3e, 0x5b, 0x50, 0x32, 0x5f, 0x50, 0xf2, 0xba, 0xa0, 0xe4, 0x69, 0x49, 0xad, 0xf9,
+	0x92, 0x5a, 0xcf, 0x4b, 0x6a, 0xdd, 0x75, 0xbf, 0x2d, 0x8a, 0x42, 0x76, 0x2b, 0xa8, 0x69, 0x0c,
+	0x35, 0x98, 0x04, 0xe5, 0x3d, 0xcd, 0xce, 0xfd, 0x86, 0xd1, 0x8f, 0x3f, 0x06, 0x00, 0x06, 0x51,
+	0xcc, 0xb9, 0x25, 0x02, 0x00, 0x00,
+}
+
+func (m *Volume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Volume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Volume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pair != nil {
+		{
+			size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVolume(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Notional.Size()
+		i -= size
+		if _, err := m.Notional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVolume(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVolume(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SnapshotTimestampInSeconds != 0 {
+		i = encodeVarintVolume(dAtA, i, uint64(m.SnapshotTimestampInSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVolume(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVolume(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Volume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotTimestampInSeconds != 0 {
+		n += 1 + sovVolume(uint64(m.SnapshotTimestampInSeconds))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovVolume(uint64(l))
+	l = m.Notional.Size()
+	n += 1 + l + sovVolume(uint64(l))
+	if m.Pair != nil {
+		l = m.Pair.Size()
+		n += 1 + l + sovVolume(uint64(l))
+	}
+	return n
+}
+
+func sovVolume(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVolume(x uint64) (n int) {
+	return sovVolume(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Volume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVolume
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Volume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Volume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestampInSeconds", wireType)
+			}
+			m.SnapshotTimestampInSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotTimestampInSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolume
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolume
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Notional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVolume
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pair == nil {
+				m.Pair = &Pair{}
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVolume(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVolume
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVolume(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVolume
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVolume
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVolume
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVolume
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVolume
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVolume
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVolume        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVolume          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVolume = fmt.Errorf("proto: unexpected end of group")
+)
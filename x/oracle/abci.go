@@ -46,9 +46,12 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		}
 
 		voteTargets := make(map[string]types.Denom)
+		// rewardBands is kept separately since voteTargets loses the denoms that fail to pass the threshold
+		rewardBands := make(map[string]sdk.Dec)
 		totalTargets := 0
 		k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) bool {
 			voteTargets[denom] = denomInfo
+			rewardBands[denom] = denomInfo.RewardBandOrDefault(params.RewardBand)
 			totalTargets++
 			return false
 		})
@@ -75,24 +78,31 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 				j++
 			}
 			sort.Strings(keys)
+			totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
 			for _, denom := range keys {
 				ballot := voteMap[denom]
+				// the ballot stats are measured before the cross rate conversion to be in the same unit as the exchange rate
+				originalBallot := ballot
 				// Convert ballot to cross exchange rates
 				if denom != referenceDenom {
 					ballot = ballot.ToCrossRateWithSort(voteMapRD)
 				}
 
 				// Get weighted median of cross exchange rates
-				exchangeRate := Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+				exchangeRate := Tally(ctx, ballot, rewardBands[denom], validatorClaimMap)
 
 				// Transform into the original form base/quote
 				if denom != referenceDenom {
 					exchangeRate = exchangeRateRD.Quo(exchangeRate)
 				}
 
+				standardDeviation := originalBallot.StandardDeviation(exchangeRate)
+				// the ballot passed the vote threshold, so the total bonded power is positive
+				votingPowerRatio := sdk.NewDec(originalBallot.Power()).QuoInt64(totalBondedPower)
+
 				// Set the exchange rate, emit ABCI event
 				metrics.IncrPriceUpdateDenom(denom)
-				k.SetBaseExchangeRateWithEvent(ctx, denom, exchangeRate)
+				k.SetBaseExchangeRateWithBallotStats(ctx, denom, exchangeRate, standardDeviation, votingPowerRatio)
 			}
 		}
 
@@ -107,9 +117,13 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		for _, denom := range belowThresholdKeys {
 			ballot := belowThresholdVoteMap[denom]
 			// perform tally for below threshold assets to calculate total win count
-			Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+			Tally(ctx, ballot, rewardBands[denom], validatorClaimMap)
 		}
 
+		//---------------------------
+		// Pay out a share of the reward pool to the ballot winners
+		k.RewardBallotWinners(ctx, params.VotePeriod, params.RewardDistributionWindow, validatorClaimMap)
+
 		//---------------------------
 		// Do miss counting & slashing
 		for _, claim := range validatorClaimMap {
@@ -597,6 +597,8 @@ func TestOraclePriceSnapshot(t *testing.T) {
 	// The value should have a stale height
 	require.Equal(t, sdk.ZeroInt(), lastUpdate)
 	ts := input.Ctx.BlockTime().UnixMilli()
+	// all validators voted the same rate
+	standardDeviation, votingPowerRatio := sdk.ZeroDec(), sdk.OneDec()
 
 	snapshot := input.OracleKeeper.GetPriceSnapshot(input.Ctx, 100)
 	require.NoError(t, err)
@@ -609,6 +611,8 @@ func TestOraclePriceSnapshot(t *testing.T) {
 					ExchangeRate:        randomExchangeRate,
 					LastUpdate:          sdk.NewInt(input.Ctx.BlockHeight()),
 					LastUpdateTimestamp: ts,
+					StandardDeviation:   &standardDeviation,
+					VotingPowerRatio:    &votingPowerRatio,
 				},
 			},
 		},
@@ -627,6 +631,8 @@ func TestOraclePriceSnapshot(t *testing.T) {
 					ExchangeRate:        randomExchangeRate,
 					LastUpdate:          sdk.NewInt(input.Ctx.BlockHeight()),
 					LastUpdateTimestamp: ts,
+					StandardDeviation:   &standardDeviation,
+					VotingPowerRatio:    &votingPowerRatio,
 				},
 			},
 		},
@@ -634,6 +640,86 @@ func TestOraclePriceSnapshot(t *testing.T) {
 	require.Equal(t, expected2, input.OracleKeeper.GetPriceSnapshot(input.Ctx, 200))
 }
 
+func TestOracleRewardDistribution(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.RewardDistributionWindow = 10
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	pool := sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(3000)))
+	require.NoError(t, keeper.FundAccount(input, input.OracleKeeper.GetOracleAccount(input.Ctx).GetAddress(), pool))
+
+	// validator 2 votes out of the reward band and isn't rewarded
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 0)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 1)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate.MulInt64(2)}}, 2)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// a tenth of the pool is split among the winners
+	for i := 0; i < 2; i++ {
+		rewards := input.OracleKeeper.GetValidatorOracleRewards(input.Ctx, keeper.ValAddrs[i])
+		require.Equal(t, sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(150))), rewards.TotalPaid)
+	}
+	require.True(t, input.OracleKeeper.GetValidatorOracleRewards(input.Ctx, keeper.ValAddrs[2]).TotalPaid.IsZero())
+	require.Equal(t, sdk.NewInt(2700), input.OracleKeeper.GetRewardPool(input.Ctx, utils.MicroSeiDenom).Amount)
+}
+
+func TestOracleCommitRevealTally(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.CommitRevealEnabled = true
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// validators 0 and 1 commit to their votes, validator 2 stored a plain vote before commit-reveal was enabled
+	rates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}
+	for i := 0; i < 2; i++ {
+		hash := types.GetAggregateVoteHash("saltsalt", rates.String(), keeper.ValAddrs[i])
+		_, err := h(input.Ctx, types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.NoError(t, err)
+	}
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[2], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		{Denom: utils.MicroAtomDenom, ExchangeRate: anotherRandomExchangeRate},
+	}, keeper.ValAddrs[2]))
+
+	// only validator 0 reveals, which is below the vote threshold
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	_, err := h(input.Ctx, types.NewMsgAggregateExchangeRateReveal("saltsalt", rates.String(), keeper.Addrs[0], keeper.ValAddrs[0]))
+	require.NoError(t, err)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	_, _, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.Error(t, err)
+	require.Equal(t, uint64(1), input.OracleKeeper.GetAbstainCount(input.Ctx, keeper.ValAddrs[1]))
+	require.Equal(t, uint64(1), input.OracleKeeper.GetAbstainCount(input.Ctx, keeper.ValAddrs[2]))
+
+	// the unrevealed prevote can no longer be revealed
+	_, err = h(input.Ctx.WithBlockHeight(2), types.NewMsgAggregateExchangeRateReveal("saltsalt", rates.String(), keeper.Addrs[1], keeper.ValAddrs[1]))
+	require.Error(t, err)
+
+	// all validators commit and reveal in the next vote periods
+	for i := 0; i < 3; i++ {
+		hash := types.GetAggregateVoteHash("saltsalt", rates.String(), keeper.ValAddrs[i])
+		_, err := h(input.Ctx.WithBlockHeight(2), types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.NoError(t, err)
+	}
+	input.Ctx = input.Ctx.WithBlockHeight(3)
+	for i := 0; i < 3; i++ {
+		_, err := h(input.Ctx, types.NewMsgAggregateExchangeRateReveal("saltsalt", rates.String(), keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.NoError(t, err)
+	}
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	rate, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+}
+
 func makeAggregateVote(t *testing.T, input keeper.TestInput, h sdk.Handler, height int64, rates sdk.DecCoins, idx int) {
 	voteMsg := types.NewMsgAggregateExchangeRateVote(rates.String(), keeper.Addrs[idx], keeper.ValAddrs[idx])
 	_, err := h(input.Ctx.WithBlockHeight(height), voteMsg)
@@ -672,3 +758,99 @@ func TestEndWindowClearExcessFeeds(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(response2.Actives))
 }
+
+func TestOraclePerDenomVoteThreshold(t *testing.T) {
+	input, h := setupVal5(t)
+	voteThreshold := sdk.NewDecWithPrec(5, 1)
+	atom := types.Denom{Name: utils.MicroAtomDenom, VoteThreshold: &voteThreshold}
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{atom, {Name: utils.MicroEthDenom}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTargetDenom(input.Ctx, atom)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroEthDenom)
+
+	// 60% of the voting power is below the global threshold but above the atom override
+	rates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}, {Denom: utils.MicroEthDenom, Amount: randomExchangeRate}}
+	makeAggregateVote(t, input, h, 0, rates, 0)
+	makeAggregateVote(t, input, h, 0, rates, 1)
+	makeAggregateVote(t, input, h, 0, rates, 2)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	rate, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+
+	_, _, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroEthDenom)
+	require.Error(t, err)
+}
+
+func TestOraclePerDenomRewardBand(t *testing.T) {
+	input, h := setup(t)
+	rewardBand := sdk.NewDecWithPrec(3, 1)
+	atom := types.Denom{Name: utils.MicroAtomDenom, RewardBand: &rewardBand}
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+
+	// the third vote is 10% off the median, which is outside the global reward band
+	offRate := randomExchangeRate.Mul(sdk.NewDecWithPrec(11, 1))
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 0)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 1)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: offRate}}, 2)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, uint64(1), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[2]))
+
+	// with the wider atom reward band the third vote is rewarded
+	params.Whitelist = types.DenomList{atom}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.SetVoteTargetDenom(input.Ctx, atom)
+
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 0)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}, 1)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: offRate}}, 2)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	require.Equal(t, uint64(1), input.OracleKeeper.GetMissCount(input.Ctx, keeper.ValAddrs[2]))
+	require.Equal(t, uint64(1), input.OracleKeeper.GetSuccessCount(input.Ctx, keeper.ValAddrs[2]))
+}
+
+func TestOracleBallotStats(t *testing.T) {
+	input, h := setupVal5(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: utils.MicroAtomDenom}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+
+	// four of the five validators vote, one of them 1 off the median
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: sdk.NewDec(10)}}, 0)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: sdk.NewDec(10)}}, 1)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: sdk.NewDec(10)}}, 2)
+	makeAggregateVote(t, input, h, 0, sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: sdk.NewDec(11)}}, 3)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	var rate types.OracleExchangeRate
+	input.OracleKeeper.IterateBaseExchangeRates(input.Ctx, func(denom string, exchangeRate types.OracleExchangeRate) bool {
+		rate = exchangeRate
+		return true
+	})
+	require.Equal(t, sdk.NewDec(10), rate.ExchangeRate)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), *rate.StandardDeviation)
+	require.Equal(t, sdk.NewDecWithPrec(8, 1), *rate.VotingPowerRatio)
+}
@@ -14,6 +14,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const flagStrict = "strict"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	oracleQueryCmd := &cobra.Command{
@@ -28,10 +30,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryExchangeRates(),
 		GetCmdQueryPriceSnapshotHistory(),
 		GetCmdQueryTwaps(),
+		GetCmdQueryPriceHistory(),
+		GetCmdQueryHistoricalTwaps(),
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
 		GetCmdQueryVotePenaltyCounter(),
+		GetCmdQueryValidatorRewards(),
 		GetCmdQueryVoteTargets(),
 	)
 
@@ -53,6 +58,8 @@ $ seid query oracle exchange-rates
 Or, can filter with denom
 
 $ seid query oracle exchange-rates ukrw
+
+Use --strict to fail instead of returning stale exchange rates
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -60,9 +67,13 @@ $ seid query oracle exchange-rates ukrw
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
+			strict, err := cmd.Flags().GetBool(flagStrict)
+			if err != nil {
+				return err
+			}
 
 			if len(args) == 0 {
-				res, err := queryClient.ExchangeRates(context.Background(), &types.QueryExchangeRatesRequest{})
+				res, err := queryClient.ExchangeRates(context.Background(), &types.QueryExchangeRatesRequest{Strict: strict})
 				if err != nil {
 					return err
 				}
@@ -73,7 +84,7 @@ $ seid query oracle exchange-rates ukrw
 			denom := args[0]
 			res, err := queryClient.ExchangeRate(
 				context.Background(),
-				&types.QueryExchangeRateRequest{Denom: denom},
+				&types.QueryExchangeRateRequest{Denom: denom, Strict: strict},
 			)
 			if err != nil {
 				return err
@@ -83,6 +94,7 @@ $ seid query oracle exchange-rates ukrw
 		},
 	}
 
+	cmd.Flags().Bool(flagStrict, false, "Fail if any of the queried exchange rates is stale")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -157,6 +169,108 @@ $ seid query oracle twaps
 	return cmd
 }
 
+// GetCmdQueryPriceHistory implements the query price history command.
+func GetCmdQueryPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [denom] [start-timestamp] [end-timestamp]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Query the prices of a denom in the price snapshots taken within a time range",
+		Long: strings.TrimSpace(`
+Query the prices of a denom in the price snapshots taken between two unix timestamps.
+Without an end timestamp, the prices up to the latest snapshot are returned.
+Example:
+
+$ seid query oracle price-history uatom 1667900000 1667903600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTimestamp, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			var endTimestamp int64
+			if len(args) == 3 {
+				endTimestamp, err = strconv.ParseInt(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PriceHistory(
+				context.Background(),
+				&types.QueryPriceHistoryRequest{
+					Denom:          args[0],
+					StartTimestamp: startTimestamp,
+					EndTimestamp:   endTimestamp,
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	return cmd
+}
+
+// GetCmdQueryHistoricalTwaps implements the query historical twaps command.
+func GetCmdQueryHistoricalTwaps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-twaps [end-timestamp] [lookback-seconds]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the time weighted average prices over a window ending at a past timestamp",
+		Long: strings.TrimSpace(`
+Query the time weighted average prices for denoms with price snapshot data over the lookback seconds before a past unix timestamp
+Example:
+
+$ seid query oracle historical-twaps 1667903600 600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			endTimestamp, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			lookbackSeconds, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HistoricalTwaps(
+				context.Background(),
+				&types.QueryHistoricalTwapsRequest{EndTimestamp: endTimestamp, LookbackSeconds: lookbackSeconds},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -294,6 +408,45 @@ $ seid query oracle miss seivaloper...
 	return cmd
 }
 
+// GetCmdQueryValidatorRewards implements the query oracle rewards of the validator command
+func GetCmdQueryValidatorRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-rewards [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the pending and paid oracle rewards of a validator",
+		Long: strings.TrimSpace(`
+Query the oracle rewards a validator has earned but not been paid yet, the total it has been paid, and its most recent payouts.
+
+$ seid query oracle validator-rewards seivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorRewards(
+				context.Background(),
+				&types.QueryValidatorRewardsRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryVoteTargets implements the query params command.
 func GetCmdQueryVoteTargets() *cobra.Command {
 	cmd := &cobra.Command{
@@ -16,6 +16,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const flagSalt = "salt"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	oracleTxCmd := &cobra.Command{
@@ -28,6 +30,7 @@ func GetTxCmd() *cobra.Command {
 
 	oracleTxCmd.AddCommand(
 		GetCmdDelegateFeederPermission(),
+		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
 	)
 
@@ -83,6 +86,77 @@ where "terra1..." is the address you want to delegate your voting rights to.
 	return cmd
 }
 
+// GetCmdAggregateExchangeRatePrevote will create a aggregateExchangeRatePrevote tx and sign it with the given key.
+func GetCmdAggregateExchangeRatePrevote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [salt] [exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit an oracle aggregate prevote for the exchange rates",
+		Long: strings.TrimSpace(`
+Submit an oracle aggregate prevote for the exchange rates of the base denom w.r.t the input denoms.
+The purpose of aggregate prevote is to hide aggregate exchange rate vote with hash which is formatted
+as hex string in SHA256("{salt}:{exchange_rate}{denom},...,{exchange_rate}{denom}:{voter}")
+
+# Aggregate Prevote
+$ seid tx oracle aggregate-prevote 9f3a6c1e5b7d 8888.0ukrw,1.243uusd,0.99usdr
+
+where "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" is the exchange rates of micro USD in micro denoms from the voter's point of view.
+
+The prevote has to be revealed in the next vote period with:
+$ seid tx oracle aggregate-vote 8888.0ukrw,1.243uusd,0.99usdr --salt 9f3a6c1e5b7d
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ seid tx oracle aggregate-prevote 9f3a6c1e5b7d 8888.0ukrw,1.243uusd,0.99usdr seivaloper1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salt := args[0]
+			if l := len(salt); l < types.MinSaltLength || l > types.MaxSaltLength {
+				return types.ErrInvalidSaltLength
+			}
+
+			exchangeRatesStr := args[1]
+			_, err = types.ParseExchangeRateTuples(exchangeRatesStr)
+			if err != nil {
+				return fmt.Errorf("given exchange_rates {%s} is not a valid format; exchange_rate should be formatted as DecCoins; %s", exchangeRatesStr, err.Error())
+			}
+
+			// Get from address
+			voter := clientCtx.GetFromAddress()
+
+			// By default the voter is voting on behalf of itself
+			validator := sdk.ValAddress(voter)
+
+			// Override validator if validator is given
+			if len(args) == 3 {
+				parsedVal, err := sdk.ValAddressFromBech32(args[2])
+				if err != nil {
+					return errors.Wrap(err, "validator address is invalid")
+				}
+				validator = parsedVal
+			}
+
+			hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, validator)
+			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRatePrevote(hash, voter, validator)}
+			for _, msg := range msgs {
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdAggregateExchangeRateVote will create a aggregateExchangeRateVote tx and sign it with the given key.
 func GetCmdAggregateExchangeRateVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -98,6 +172,9 @@ where "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" i
 
 If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
 $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1....
+
+When commit-reveal voting is enabled, the vote reveals the prevote submitted in the previous vote period with the same salt:
+$ seid tx oracle aggregate-vote 8888.0ukrw,1.243uusd,0.99usdr --salt 9f3a6c1e5b7d
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -126,7 +203,12 @@ $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1..
 				validator = parsedVal
 			}
 
-			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, voter, validator)}
+			salt, err := cmd.Flags().GetString(flagSalt)
+			if err != nil {
+				return err
+			}
+
+			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRateReveal(salt, exchangeRatesStr, voter, validator)}
 			for _, msg := range msgs {
 				if err := msg.ValidateBasic(); err != nil {
 					return err
@@ -137,6 +219,7 @@ $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1..
 		},
 	}
 
+	cmd.Flags().String(flagSalt, "", "Salt of the prevote to reveal when commit-reveal voting is enabled")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -16,6 +16,7 @@ import (
 
 func registerTxHandlers(cliCtx client.Context, rtr *mux.Router) {
 	rtr.HandleFunc(fmt.Sprintf("/oracle/voters/{%s}/feeder", RestVoter), newDelegateHandlerFunction(cliCtx)).Methods("POST")
+	rtr.HandleFunc(fmt.Sprintf("/oracle/voters/{%s}/aggregate_prevote", RestVoter), newAggregatePrevoteHandlerFunction(cliCtx)).Methods("POST")
 	rtr.HandleFunc(fmt.Sprintf("/oracle/voters/{%s}/aggregate_vote", RestVoter), newAggregateVoteHandlerFunction(cliCtx)).Methods("POST")
 }
 
@@ -25,10 +26,19 @@ type (
 		Feeder  sdk.AccAddress `json:"feeder" yaml:"feeder"`
 	}
 
+	aggregatePrevoteReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Hash          string `json:"hash" yaml:"hash"`
+		ExchangeRates string `json:"exchange_rates" yaml:"exchange_rates"`
+		Salt          string `json:"salt" yaml:"salt"`
+	}
+
 	aggregateVoteReq struct {
 		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 
 		ExchangeRates string `json:"exchange_rates" yaml:"exchange_rates"`
+		Salt          string `json:"salt" yaml:"salt"`
 	}
 )
 
@@ -59,6 +69,55 @@ func newDelegateHandlerFunction(clientCtx client.Context) http.HandlerFunc {
 	}
 }
 
+func newAggregatePrevoteHandlerFunction(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req aggregatePrevoteReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		feederAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		voterAddr, ok := checkVoterAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		var hash types.AggregateVoteHash
+
+		// If hash is not given, then retrieve hash from exchange_rate and salt
+		if len(req.Hash) == 0 && (len(req.ExchangeRates) > 0 && len(req.Salt) > 0) {
+			_, err := types.ParseExchangeRateTuples(req.ExchangeRates)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			hash = types.GetAggregateVoteHash(req.Salt, req.ExchangeRates, voterAddr)
+		} else {
+			hash, err = types.AggregateVoteHashFromHexString(req.Hash)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+		}
+
+		// create the message
+		msg := types.NewMsgAggregateExchangeRatePrevote(hash, feederAddr, voterAddr)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
 func newAggregateVoteHandlerFunction(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req aggregateVoteReq
@@ -89,7 +148,7 @@ func newAggregateVoteHandlerFunction(clientCtx client.Context) http.HandlerFunc
 		}
 
 		// create the message
-		msg := types.NewMsgAggregateExchangeRateVote(req.ExchangeRates, feederAddr, voterAddr)
+		msg := types.NewMsgAggregateExchangeRateReveal(req.Salt, req.ExchangeRates, feederAddr, voterAddr)
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
@@ -3,8 +3,15 @@ package bindings
 import "github.com/sei-protocol/sei-chain/x/oracle/types"
 
 type SeiOracleQuery struct {
+	// queries the oracle exchange rate of a single denom, so that a strict query only depends on
+	// the staleness of that denom
+	ExchangeRate *types.QueryExchangeRateRequest `json:"exchange_rate,omitempty"`
 	// queries the oracle exchange rates
 	ExchangeRates *types.QueryExchangeRatesRequest `json:"exchange_rates,omitempty"`
 	// queries the oracle TWAPs
 	OracleTwaps *types.QueryTwapsRequest `json:"oracle_twaps,omitempty"`
+	// queries the prices of a denom within a time range
+	PriceHistory *types.QueryPriceHistoryRequest `json:"price_history,omitempty"`
+	// queries the oracle TWAPs over a window ending at a past timestamp
+	HistoricalTwaps *types.QueryHistoricalTwapsRequest `json:"historical_twaps,omitempty"`
 }
@@ -16,10 +16,16 @@ func NewOracleWasmQueryHandler(keeper *oraclekeeper.Keeper) *OracleWasmQueryHand
 	}
 }
 
-func (handler OracleWasmQueryHandler) GetExchangeRates(ctx sdk.Context) (*types.QueryExchangeRatesResponse, error) {
+func (handler OracleWasmQueryHandler) GetExchangeRate(ctx sdk.Context, req *types.QueryExchangeRateRequest) (*types.QueryExchangeRateResponse, error) {
 	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
 	c := sdk.WrapSDKContext(ctx)
-	return querier.ExchangeRates(c, &types.QueryExchangeRatesRequest{})
+	return querier.ExchangeRate(c, req)
+}
+
+func (handler OracleWasmQueryHandler) GetExchangeRates(ctx sdk.Context, req *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.ExchangeRates(c, req)
 }
 
 func (handler OracleWasmQueryHandler) GetOracleTwaps(ctx sdk.Context, req *types.QueryTwapsRequest) (*types.QueryTwapsResponse, error) {
@@ -27,3 +33,15 @@ func (handler OracleWasmQueryHandler) GetOracleTwaps(ctx sdk.Context, req *types
 	c := sdk.WrapSDKContext(ctx)
 	return querier.Twaps(c, req)
 }
+
+func (handler OracleWasmQueryHandler) GetPriceHistory(ctx sdk.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.PriceHistory(c, req)
+}
+
+func (handler OracleWasmQueryHandler) GetHistoricalTwaps(ctx sdk.Context, req *types.QueryHistoricalTwapsRequest) (*types.QueryHistoricalTwapsResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.HistoricalTwaps(c, req)
+}
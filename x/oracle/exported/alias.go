@@ -4,5 +4,6 @@ package exported
 import "github.com/sei-protocol/sei-chain/x/oracle/types"
 
 type (
-	MsgAggregateExchangeRateVote = types.MsgAggregateExchangeRateVote
+	MsgAggregateExchangeRatePrevote = types.MsgAggregateExchangeRatePrevote
+	MsgAggregateExchangeRateVote    = types.MsgAggregateExchangeRateVote
 )
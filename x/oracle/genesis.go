@@ -49,10 +49,28 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetAggregateExchangeRateVote(ctx, valAddr, av)
 	}
 
+	for _, ap := range data.AggregateExchangeRatePrevotes {
+		valAddr, err := sdk.ValAddressFromBech32(ap.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetAggregateExchangeRatePrevote(ctx, valAddr, ap)
+	}
+
 	for _, priceSnapshot := range data.PriceSnapshots {
 		keeper.AddPriceSnapshot(ctx, priceSnapshot)
 	}
 
+	for _, rewards := range data.ValidatorOracleRewards {
+		operator, err := sdk.ValAddressFromBech32(rewards.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetValidatorOracleRewards(ctx, operator, rewards)
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.GetOracleAccount(ctx)
 	if moduleAcc == nil {
@@ -95,12 +113,24 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	aggregateExchangeRatePrevotes := []types.AggregateExchangeRatePrevote{}
+	keeper.IterateAggregateExchangeRatePrevotes(ctx, func(_ sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		aggregateExchangeRatePrevotes = append(aggregateExchangeRatePrevotes, aggregatePrevote)
+		return false
+	})
+
 	priceSnapshots := types.PriceSnapshots{}
 	keeper.IteratePriceSnapshots(ctx, func(snapshot types.PriceSnapshot) bool {
 		priceSnapshots = append(priceSnapshots, snapshot)
 		return false
 	})
 
+	validatorOracleRewards := []types.ValidatorOracleRewards{}
+	keeper.IterateValidatorOracleRewards(ctx, func(_ sdk.ValAddress, rewards types.ValidatorOracleRewards) bool {
+		validatorOracleRewards = append(validatorOracleRewards, rewards)
+		return false
+	})
+
 	return types.NewGenesisState(
 		params,
 		exchangeRates,
@@ -108,5 +138,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		penaltyCounters,
 		aggregateExchangeRateVotes,
 		priceSnapshots,
+		validatorOracleRewards,
+		aggregateExchangeRatePrevotes,
 	)
 }
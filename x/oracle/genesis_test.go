@@ -60,7 +60,17 @@ func TestExportInitGenesis(t *testing.T) {
 		},
 		int64(3700),
 	))
+	input.OracleKeeper.SetValidatorOracleRewards(input.Ctx, keeper.ValAddrs[0], types.ValidatorOracleRewards{
+		ValidatorAddress: keeper.ValAddrs[0].String(),
+		Pending:          sdk.NewDecCoins(sdk.NewDecCoinFromDec("usei", sdk.NewDecWithPrec(5, 1))),
+		TotalPaid:        sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10))),
+		RecentPayouts:    []types.OracleRewardPayout{{Height: 1, Amount: sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10)))}},
+	})
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRatePrevote(
+		types.GetAggregateVoteHash("salt", "123.0usei", keeper.ValAddrs[0]), keeper.ValAddrs[0], 2))
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
+	require.Len(t, genesis.ValidatorOracleRewards, 1)
+	require.Len(t, genesis.AggregateExchangeRatePrevotes, 1)
 
 	newInput := keeper.CreateTestInput(t)
 	oracle.InitGenesis(newInput.Ctx, newInput.OracleKeeper, genesis)
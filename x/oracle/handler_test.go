@@ -68,3 +68,68 @@ func TestFeederDelegation(t *testing.T) {
 	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
 	require.NoError(t, err)
 }
+
+func TestAggregatePrevoteVote(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.CommitRevealEnabled = true
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	salt := "1a2b3c4d"
+	exchangeRatesStr := randomExchangeRate.String() + utils.MicroAtomDenom
+	otherExchangeRateStr := anotherRandomExchangeRate.String() + utils.MicroAtomDenom
+	hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, keeper.ValAddrs[0])
+
+	// Case 1: a vote without a prevote fails
+	voteMsg := types.NewMsgAggregateExchangeRateReveal(salt, exchangeRatesStr, keeper.Addrs[0], keeper.ValAddrs[0])
+	_, err := h(input.Ctx.WithBlockHeight(1), voteMsg)
+	require.Error(t, err)
+
+	// Case 2: a prevote from a non-feeder fails
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[1], keeper.ValAddrs[0])
+	_, err = h(input.Ctx, prevoteMsg)
+	require.Error(t, err)
+
+	// Case 3: a normal prevote succeeds
+	prevoteMsg = types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+	_, err = h(input.Ctx, prevoteMsg)
+	require.NoError(t, err)
+
+	// Case 4: revealing in the same vote period fails
+	_, err = h(input.Ctx, voteMsg)
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+
+	// Case 5: revealing different exchange rates fails
+	invalidVoteMsg := types.NewMsgAggregateExchangeRateReveal(salt, otherExchangeRateStr, keeper.Addrs[0], keeper.ValAddrs[0])
+	_, err = h(input.Ctx.WithBlockHeight(1), invalidVoteMsg)
+	require.ErrorIs(t, err, types.ErrVerificationFailed)
+
+	// Case 6: revealing with a different salt fails
+	invalidVoteMsg = types.NewMsgAggregateExchangeRateReveal("2a2b3c4d", exchangeRatesStr, keeper.Addrs[0], keeper.ValAddrs[0])
+	_, err = h(input.Ctx.WithBlockHeight(1), invalidVoteMsg)
+	require.ErrorIs(t, err, types.ErrVerificationFailed)
+
+	// Case 7: a vote without a salt fails
+	invalidVoteMsg = types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, keeper.Addrs[0], keeper.ValAddrs[0])
+	_, err = h(input.Ctx.WithBlockHeight(1), invalidVoteMsg)
+	require.ErrorIs(t, err, types.ErrInvalidSaltLength)
+
+	// Case 8: revealing after the reveal period fails
+	_, err = h(input.Ctx.WithBlockHeight(2), voteMsg)
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+
+	// Case 9: a proper reveal succeeds and consumes the prevote
+	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
+	require.NoError(t, err)
+	vote, err := input.OracleKeeper.GetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0])
+	require.NoError(t, err)
+	require.True(t, vote.Revealed)
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0])
+	require.Error(t, err)
+
+	// Case 10: prevotes are rejected while commit-reveal voting is disabled
+	params.CommitRevealEnabled = false
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	_, err = h(input.Ctx, prevoteMsg)
+	require.ErrorIs(t, err, types.ErrCommitRevealDisabled)
+}
@@ -13,9 +13,15 @@ import (
 // OrganizeBallotByDenom collects all oracle votes for the period, categorized by the votes' denom parameter
 func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[string]types.Claim) (votes map[string]types.ExchangeRateBallot) {
 	votes = map[string]types.ExchangeRateBallot{}
+	revealRequired := k.CommitRevealEnabled(ctx)
 
 	// Organize aggregate votes
 	aggregateHandler := func(voterAddr sdk.ValAddress, vote types.AggregateExchangeRateVote) (stop bool) {
+		// only votes revealed against a prevote count when commit-reveal voting is enabled
+		if revealRequired && !vote.Revealed {
+			return false
+		}
+
 		// organize ballot only for the active validators
 		claim, ok := validatorClaimMap[vote.Voter]
 
@@ -54,8 +60,19 @@ func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[str
 	return votes
 }
 
-// ClearBallots clears all tallied votes from the store
-func (k Keeper) ClearBallots(ctx sdk.Context, _ uint64) {
+// ClearBallots clears all tallied votes and the prevotes that can no longer be revealed from the store
+func (k Keeper) ClearBallots(ctx sdk.Context, votePeriod uint64) {
+	// Clear all aggregate prevotes whose reveal period has passed; a prevote can only be revealed in
+	// the vote period following the one it was submitted in, which ends at the latest in this block
+	currentPeriod := uint64(ctx.BlockHeight()) / votePeriod
+	k.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) (stop bool) {
+		if aggregatePrevote.SubmitBlock/votePeriod < currentPeriod {
+			k.DeleteAggregateExchangeRatePrevote(ctx, voterAddr)
+		}
+
+		return false
+	})
+
 	// Clear all aggregate votes
 	k.IterateAggregateExchangeRateVotes(ctx, func(voterAddr sdk.ValAddress, aggregateVote types.AggregateExchangeRateVote) (stop bool) {
 		k.DeleteAggregateExchangeRateVote(ctx, voterAddr)
@@ -71,7 +88,7 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 		updateRequired = true
 	} else {
 		for _, item := range whitelist {
-			if _, ok := voteTargets[item.Name]; !ok {
+			if voteTarget, ok := voteTargets[item.Name]; !ok || !voteTarget.Equal(&item) {
 				updateRequired = true
 				break
 			}
@@ -82,7 +99,7 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 		k.ClearVoteTargets(ctx)
 
 		for _, item := range whitelist {
-			k.SetVoteTarget(ctx, item.Name)
+			k.SetVoteTargetDenom(ctx, item)
 
 			// Register meta data to bank module
 			if _, ok := k.bankKeeper.GetDenomMetaData(ctx, item.Name); !ok {
@@ -114,7 +114,19 @@ func TestClearBallots(t *testing.T) {
 			}, ValAddrs[i]))
 	}
 
-	input.OracleKeeper.ClearBallots(input.Ctx, 5)
+	// the prevote that can still be revealed is kept, the stale one is dropped
+	submitBlock := uint64(input.Ctx.BlockHeight())
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0],
+		types.NewAggregateExchangeRatePrevote(types.GetAggregateVoteHash("1", "", ValAddrs[0]), ValAddrs[0], submitBlock))
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1],
+		types.NewAggregateExchangeRatePrevote(types.GetAggregateVoteHash("1", "", ValAddrs[1]), ValAddrs[1], submitBlock+10))
+
+	input.OracleKeeper.ClearBallots(input.Ctx.WithBlockHeight(int64(submitBlock)+10), 5)
+
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0])
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1])
+	require.NoError(t, err)
 
 	voteCounter := 0
 	input.OracleKeeper.IterateAggregateExchangeRateVotes(input.Ctx, func(_ sdk.ValAddress, _ types.AggregateExchangeRateVote) bool {
@@ -124,6 +136,31 @@ func TestClearBallots(t *testing.T) {
 	require.Equal(t, voteCounter, 0)
 }
 
+func TestClearBallotsPrevoteInLastBlockOfPeriod(t *testing.T) {
+	input := CreateTestInput(t)
+	votePeriod := uint64(5)
+
+	// submitted in the last block of the first vote period and in the first block of the second one
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0],
+		types.NewAggregateExchangeRatePrevote(types.GetAggregateVoteHash("1", "", ValAddrs[0]), ValAddrs[0], votePeriod-1))
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1],
+		types.NewAggregateExchangeRatePrevote(types.GetAggregateVoteHash("1", "", ValAddrs[1]), ValAddrs[1], votePeriod))
+
+	// both can still be revealed in the second vote period
+	input.OracleKeeper.ClearBallots(input.Ctx.WithBlockHeight(int64(votePeriod)-1), votePeriod)
+	_, err := input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0])
+	require.NoError(t, err)
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1])
+	require.NoError(t, err)
+
+	// the reveal period of the first prevote ends with the second vote period
+	input.OracleKeeper.ClearBallots(input.Ctx.WithBlockHeight(int64(2*votePeriod)-1), votePeriod)
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0])
+	require.Error(t, err)
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1])
+	require.NoError(t, err)
+}
+
 func TestApplyWhitelist(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -156,3 +193,24 @@ func TestApplyWhitelist(t *testing.T) {
 	require.Equal(t, len(metadata.DenomUnits), 3)
 	require.Equal(t, metadata.Description, "usdc")
 }
+
+func TestApplyWhitelistOverrides(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.OracleKeeper.ApplyWhitelist(input.Ctx, types.DenomList{{Name: "uatom"}}, map[string]types.Denom{})
+	voteTargets := map[string]types.Denom{}
+	input.OracleKeeper.IterateVoteTargets(input.Ctx, func(denom string, denomInfo types.Denom) bool {
+		voteTargets[denom] = denomInfo
+		return false
+	})
+
+	// changing only the overrides of a whitelisted denom updates the vote target
+	rewardBand := sdk.NewDecWithPrec(1, 1)
+	input.OracleKeeper.ApplyWhitelist(input.Ctx, types.DenomList{{Name: "uatom", RewardBand: &rewardBand, MaxStalenessBlocks: 10}}, voteTargets)
+
+	voteTarget, err := input.OracleKeeper.GetVoteTarget(input.Ctx, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, rewardBand, *voteTarget.RewardBand)
+	require.Nil(t, voteTarget.VoteThreshold)
+	require.Equal(t, uint64(10), voteTarget.MaxStalenessBlocks)
+}
@@ -77,20 +77,50 @@ func (k Keeper) GetBaseExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, sdk
 
 	exchangeRate := types.OracleExchangeRate{}
 	k.cdc.MustUnmarshal(b, &exchangeRate)
+
+	// the rate is still returned alongside the error so callers can decide whether to use it
+	if k.IsExchangeRateStale(ctx, denom, exchangeRate) {
+		return exchangeRate.ExchangeRate, exchangeRate.LastUpdate, exchangeRate.LastUpdateTimestamp,
+			sdkerrors.Wrapf(types.ErrStaleExchangeRate, "%s last updated at height %s", denom, exchangeRate.LastUpdate)
+	}
 	return exchangeRate.ExchangeRate, exchangeRate.LastUpdate, exchangeRate.LastUpdateTimestamp, nil
 }
 
+// IsExchangeRateStale returns true if the rate is older than the max staleness of the denom's vote target
+func (k Keeper) IsExchangeRateStale(ctx sdk.Context, denom string, rate types.OracleExchangeRate) bool {
+	voteTarget, err := k.GetVoteTarget(ctx, denom)
+	return err == nil && voteTarget.IsStale(rate.LastUpdate.Int64(), ctx.BlockHeight())
+}
+
 func (k Keeper) SetBaseExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	k.setBaseExchangeRate(ctx, denom, types.OracleExchangeRate{ExchangeRate: exchangeRate})
+}
+
+// SetBaseExchangeRateWithBallotStats sets the exchange rate along with the standard deviation and
+// voting power ratio of the ballot it was tallied from, and emits an update event
+func (k Keeper) SetBaseExchangeRateWithBallotStats(ctx sdk.Context, denom string, exchangeRate sdk.Dec, standardDeviation sdk.Dec, votingPowerRatio sdk.Dec) {
+	k.setBaseExchangeRate(ctx, denom, types.OracleExchangeRate{
+		ExchangeRate:      exchangeRate,
+		StandardDeviation: &standardDeviation,
+		VotingPowerRatio:  &votingPowerRatio,
+	})
+	k.emitExchangeRateUpdate(ctx, denom, exchangeRate)
+}
+
+func (k Keeper) setBaseExchangeRate(ctx sdk.Context, denom string, rate types.OracleExchangeRate) {
 	store := ctx.KVStore(k.storeKey)
-	currHeight := sdk.NewInt(ctx.BlockHeight())
-	blockTimestamp := ctx.BlockTime().UnixMilli()
-	rate := types.OracleExchangeRate{ExchangeRate: exchangeRate, LastUpdate: currHeight, LastUpdateTimestamp: blockTimestamp}
+	rate.LastUpdate = sdk.NewInt(ctx.BlockHeight())
+	rate.LastUpdateTimestamp = ctx.BlockTime().UnixMilli()
 	bz := k.cdc.MustMarshal(&rate)
 	store.Set(types.GetExchangeRateKey(denom), bz)
 }
 
 func (k Keeper) SetBaseExchangeRateWithEvent(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
 	k.SetBaseExchangeRate(ctx, denom, exchangeRate)
+	k.emitExchangeRateUpdate(ctx, denom, exchangeRate)
+}
+
+func (k Keeper) emitExchangeRateUpdate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeExchangeRateUpdate,
 			sdk.NewAttribute(types.AttributeKeyDenom, denom),
@@ -270,6 +300,50 @@ func (k Keeper) IterateVotePenaltyCounters(ctx sdk.Context,
 	}
 }
 
+//-----------------------------------
+// AggregateExchangeRatePrevote logic
+
+// GetAggregateExchangeRatePrevote retrieves an oracle prevote from the store
+func (k Keeper) GetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) (aggregatePrevote types.AggregateExchangeRatePrevote, err error) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAggregateExchangeRatePrevoteKey(voter))
+	if b == nil {
+		err = sdkerrors.Wrap(types.ErrNoAggregatePrevote, voter.String())
+		return
+	}
+	k.cdc.MustUnmarshal(b, &aggregatePrevote)
+	return
+}
+
+// SetAggregateExchangeRatePrevote set an oracle aggregate prevote to the store
+func (k Keeper) SetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress, prevote types.AggregateExchangeRatePrevote) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&prevote)
+	store.Set(types.GetAggregateExchangeRatePrevoteKey(voter), bz)
+}
+
+// DeleteAggregateExchangeRatePrevote deletes an oracle prevote from the store
+func (k Keeper) DeleteAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAggregateExchangeRatePrevoteKey(voter))
+}
+
+// IterateAggregateExchangeRatePrevotes iterates rate over prevotes in the store
+func (k Keeper) IterateAggregateExchangeRatePrevotes(ctx sdk.Context, handler func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AggregateExchangeRatePrevoteKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		voterAddr := sdk.ValAddress(iter.Key()[2:])
+
+		var aggregatePrevote types.AggregateExchangeRatePrevote
+		k.cdc.MustUnmarshal(iter.Value(), &aggregatePrevote)
+		if handler(voterAddr, aggregatePrevote) {
+			break
+		}
+	}
+}
+
 //-----------------------------------
 // AggregateExchangeRateVote logic
 
@@ -329,9 +403,14 @@ func (k Keeper) GetVoteTarget(ctx sdk.Context, denom string) (types.Denom, error
 }
 
 func (k Keeper) SetVoteTarget(ctx sdk.Context, denom string) {
+	k.SetVoteTargetDenom(ctx, types.Denom{Name: denom})
+}
+
+// SetVoteTargetDenom stores the vote target along with its per-denom overrides
+func (k Keeper) SetVoteTargetDenom(ctx sdk.Context, denom types.Denom) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&types.Denom{Name: denom})
-	store.Set(types.GetVoteTargetKey(denom), bz)
+	bz := k.cdc.MustMarshal(&denom)
+	store.Set(types.GetVoteTargetKey(denom.Name), bz)
 }
 
 func (k Keeper) IterateVoteTargets(ctx sdk.Context, handler func(denom string, denomInfo types.Denom) (stop bool)) {
@@ -463,39 +542,96 @@ func (k Keeper) IteratePriceSnapshotsReverse(ctx sdk.Context, handler func(snaps
 	}
 }
 
+// IteratePriceSnapshotsInRange iterates the price snapshots taken between startTimestamp and endTimestamp, both inclusive,
+// latest first if reverse is set. Only the snapshots in the range are read.
+func (k Keeper) IteratePriceSnapshotsInRange(ctx sdk.Context, startTimestamp int64, endTimestamp int64, reverse bool, handler func(snapshot types.PriceSnapshot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	start := types.GetPriceSnapshotKey(uint64(startTimestamp))
+	end := types.GetPriceSnapshotKey(uint64(endTimestamp) + 1)
+	var iterator sdk.Iterator
+	if reverse {
+		iterator = store.ReverseIterator(start, end)
+	} else {
+		iterator = store.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if handler(val) {
+			break
+		}
+	}
+}
+
 func (k Keeper) DeletePriceSnapshot(ctx sdk.Context, timestamp int64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetPriceSnapshotKey(uint64(timestamp)))
 }
 
 func (k Keeper) CalculateTwaps(ctx sdk.Context, lookbackSeconds uint64) (types.OracleTwaps, error) {
-	oracleTwaps := types.OracleTwaps{}
-	currentTime := ctx.BlockTime().Unix()
 	err := k.ValidateLookbackSeconds(ctx, lookbackSeconds)
 	if err != nil {
-		return oracleTwaps, err
+		return types.OracleTwaps{}, err
 	}
-	var timeTraversed int64
-	denomToTimeWeightedMap := make(map[string]sdk.Dec)
-	denomDurationMap := make(map[string]int64)
+	return k.calculateTwaps(ctx, ctx.BlockTime().Unix(), lookbackSeconds, k.voteTargetSet(ctx))
+}
 
-	// get targets - only calculate for the targets
+// CalculateTwap calculates the TWAP of a single vote target over the lookbackSeconds before the
+// current block, without computing the TWAPs of the other denoms
+func (k Keeper) CalculateTwap(ctx sdk.Context, denom string, lookbackSeconds uint64) (sdk.Dec, error) {
+	if err := k.ValidateLookbackSeconds(ctx, lookbackSeconds); err != nil {
+		return sdk.Dec{}, err
+	}
+	if _, err := k.GetVoteTarget(ctx, denom); err != nil {
+		return sdk.Dec{}, err
+	}
+	twaps, err := k.calculateTwaps(ctx, ctx.BlockTime().Unix(), lookbackSeconds, map[string]struct{}{denom: {}})
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return twaps[0].Twap, nil
+}
+
+// CalculateHistoricalTwaps calculates the TWAPs over the lookbackSeconds before the past endTimestamp
+func (k Keeper) CalculateHistoricalTwaps(ctx sdk.Context, endTimestamp int64, lookbackSeconds uint64) (types.OracleTwaps, error) {
+	err := k.ValidateHistoricalTwapWindow(ctx, endTimestamp, lookbackSeconds)
+	if err != nil {
+		return types.OracleTwaps{}, err
+	}
+	return k.calculateTwaps(ctx, endTimestamp, lookbackSeconds, k.voteTargetSet(ctx))
+}
+
+// voteTargetSet returns the denoms of all vote targets
+func (k Keeper) voteTargetSet(ctx sdk.Context) map[string]struct{} {
 	targetsMap := make(map[string]struct{})
 	k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) (stop bool) {
 		targetsMap[denom] = struct{}{}
 		return false
 	})
+	return targetsMap
+}
+
+// calculateTwaps calculates the TWAPs of the denoms in targetsMap, which are the only ones read
+// from the price snapshots
+func (k Keeper) calculateTwaps(ctx sdk.Context, endTimestamp int64, lookbackSeconds uint64, targetsMap map[string]struct{}) (types.OracleTwaps, error) {
+	oracleTwaps := types.OracleTwaps{}
+	var timeTraversed int64
+	denomToTimeWeightedMap := make(map[string]sdk.Dec)
+	denomDurationMap := make(map[string]int64)
 
-	k.IteratePriceSnapshotsReverse(ctx, func(snapshot types.PriceSnapshot) (stop bool) {
+	// snapshots taken after the end of the window are never read
+	k.IteratePriceSnapshotsInRange(ctx, 0, endTimestamp, true, func(snapshot types.PriceSnapshot) (stop bool) {
 		stop = false
 		snapshotTimestamp := snapshot.SnapshotTimestamp
-		if currentTime-int64(lookbackSeconds) > snapshotTimestamp {
-			snapshotTimestamp = currentTime - int64(lookbackSeconds)
+		if endTimestamp-int64(lookbackSeconds) > snapshotTimestamp {
+			snapshotTimestamp = endTimestamp - int64(lookbackSeconds)
 			stop = true
 		}
 		// update time traversed to represent current snapshot
 		// replace SnapshotTimestamp with lookback duration bounding
-		timeTraversed = currentTime - snapshotTimestamp
+		timeTraversed = endTimestamp - snapshotTimestamp
 
 		// iterate through denoms in the snapshot
 		// if we find a new one, we have to setup the TWAP calc for that one
@@ -571,3 +707,21 @@ func (k Keeper) ValidateLookbackSeconds(ctx sdk.Context, lookbackSeconds uint64)
 
 	return nil
 }
+
+// ValidateHistoricalTwapWindow checks the window ends no later than the current block
+// and starts within the lookback duration that price snapshots are kept for
+func (k Keeper) ValidateHistoricalTwapWindow(ctx sdk.Context, endTimestamp int64, lookbackSeconds uint64) error {
+	currentTime := ctx.BlockTime().Unix()
+	if endTimestamp > currentTime || endTimestamp < 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidHistoryRange, "twap window must end between 0 and %d", currentTime)
+	}
+
+	if err := k.ValidateLookbackSeconds(ctx, lookbackSeconds); err != nil {
+		return err
+	}
+	if endTimestamp-int64(lookbackSeconds) < currentTime-int64(k.LookbackDuration(ctx)) {
+		return sdkerrors.Wrap(types.ErrInvalidTwapLookback, "twap window starts before the kept price snapshots")
+	}
+
+	return nil
+}
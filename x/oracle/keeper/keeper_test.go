@@ -98,6 +98,27 @@ func TestExchangeRate(t *testing.T) {
 
 }
 
+func TestStaleExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.OracleKeeper.SetVoteTargetDenom(input.Ctx, types.Denom{Name: utils.MicroAtomDenom, MaxStalenessBlocks: 5})
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx.WithBlockHeight(10), utils.MicroAtomDenom, sdk.OneDec())
+
+	_, _, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx.WithBlockHeight(15), utils.MicroAtomDenom)
+	require.NoError(t, err)
+
+	// the rate is still returned along with the stale error
+	rate, lastUpdate, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx.WithBlockHeight(16), utils.MicroAtomDenom)
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+	require.Equal(t, sdk.OneDec(), rate)
+	require.Equal(t, sdk.NewInt(10), lastUpdate)
+
+	// denoms without a max staleness never go stale
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx.WithBlockHeight(10), utils.MicroEthDenom, sdk.OneDec())
+	_, _, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx.WithBlockHeight(1000), utils.MicroEthDenom)
+	require.NoError(t, err)
+}
+
 func TestIterateSeiExchangeRates(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -152,6 +173,7 @@ func TestParams(t *testing.T) {
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	rewardDistributionWindow := uint64(10000)
 	whitelist := types.DenomList{
 		{Name: utils.MicroEthDenom},
 		{Name: utils.MicroAtomDenom},
@@ -166,6 +188,8 @@ func TestParams(t *testing.T) {
 		SlashFraction:     slashFraction,
 		SlashWindow:       slashWindow,
 		MinValidPerWindow: minValidPerWindow,
+
+		RewardDistributionWindow: rewardDistributionWindow,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -286,6 +310,49 @@ func TestIterateMissCounters(t *testing.T) {
 	require.Equal(t, missCounter, votePenaltyCounters[0].MissCount)
 }
 
+func TestAggregatePrevoteAddDelete(t *testing.T) {
+	input := CreateTestInput(t)
+
+	hash := types.GetAggregateVoteHash("salt", "100usei,1000uatom", sdk.ValAddress(Addrs[0]))
+	aggregatePrevote := types.NewAggregateExchangeRatePrevote(hash, sdk.ValAddress(Addrs[0]), 0)
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, sdk.ValAddress(Addrs[0]), aggregatePrevote)
+
+	KPrevote, err := input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, sdk.ValAddress(Addrs[0]))
+	require.NoError(t, err)
+	require.Equal(t, aggregatePrevote, KPrevote)
+
+	input.OracleKeeper.DeleteAggregateExchangeRatePrevote(input.Ctx, sdk.ValAddress(Addrs[0]))
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, sdk.ValAddress(Addrs[0]))
+	require.Error(t, err)
+}
+
+func TestAggregatePrevoteIterate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	hash := types.GetAggregateVoteHash("salt", "100usei,1000uatom", sdk.ValAddress(Addrs[0]))
+	aggregatePrevote1 := types.NewAggregateExchangeRatePrevote(hash, sdk.ValAddress(Addrs[0]), 0)
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, sdk.ValAddress(Addrs[0]), aggregatePrevote1)
+
+	hash2 := types.GetAggregateVoteHash("salt", "100usei,1000uatom", sdk.ValAddress(Addrs[1]))
+	aggregatePrevote2 := types.NewAggregateExchangeRatePrevote(hash2, sdk.ValAddress(Addrs[1]), 0)
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, sdk.ValAddress(Addrs[1]), aggregatePrevote2)
+
+	i := 0
+	bigger := bytes.Compare(address.MustLengthPrefix(Addrs[0]), address.MustLengthPrefix(Addrs[1]))
+	input.OracleKeeper.IterateAggregateExchangeRatePrevotes(input.Ctx, func(voter sdk.ValAddress, p types.AggregateExchangeRatePrevote) (stop bool) {
+		if (i == 0 && bigger == -1) || (i == 1 && bigger == 1) {
+			require.Equal(t, aggregatePrevote1, p)
+			require.Equal(t, voter.String(), p.Voter)
+		} else {
+			require.Equal(t, aggregatePrevote2, p)
+			require.Equal(t, voter.String(), p.Voter)
+		}
+
+		i++
+		return false
+	})
+}
+
 func TestAggregateVoteAddDelete(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -694,6 +761,128 @@ func TestCalculateTwaps(t *testing.T) {
 	require.Equal(t, types.ErrInvalidTwapLookback, err)
 }
 
+func TestCalculateTwap(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, err := input.OracleKeeper.CalculateTwap(input.Ctx, utils.MicroAtomDenom, 3600)
+	require.Equal(t, types.ErrNoTwapData, err)
+
+	input.OracleKeeper.SetPriceSnapshot(input.Ctx, types.NewPriceSnapshot(types.PriceSnapshotItems{
+		types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+			ExchangeRate: sdk.NewDec(40),
+			LastUpdate:   sdk.NewInt(1800),
+		}),
+	}, 1200))
+	input.OracleKeeper.SetPriceSnapshot(input.Ctx, types.NewPriceSnapshot(types.PriceSnapshotItems{
+		types.NewPriceSnapshotItem(utils.MicroEthDenom, types.OracleExchangeRate{
+			ExchangeRate: sdk.NewDec(10),
+			LastUpdate:   sdk.NewInt(3600),
+		}),
+		types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+			ExchangeRate: sdk.NewDec(20),
+			LastUpdate:   sdk.NewInt(3600),
+		}),
+	}, 3600))
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
+
+	// the TWAP of a single denom matches the one calculated for all denoms
+	twaps, err := input.OracleKeeper.CalculateTwaps(input.Ctx, 3600)
+	require.NoError(t, err)
+	for _, twap := range twaps {
+		denomTwap, err := input.OracleKeeper.CalculateTwap(input.Ctx, twap.Denom, 3600)
+		require.NoError(t, err)
+		require.Equal(t, twap.Twap, denomTwap)
+	}
+	atomTwap, err := input.OracleKeeper.CalculateTwap(input.Ctx, utils.MicroAtomDenom, 3600)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(30), atomTwap)
+
+	// denoms that aren't vote targets have no TWAP
+	_, err = input.OracleKeeper.CalculateTwap(input.Ctx, "unknown", 3600)
+	require.ErrorIs(t, err, types.ErrNoVoteTarget)
+
+	_, err = input.OracleKeeper.CalculateTwap(input.Ctx, utils.MicroAtomDenom, 0)
+	require.Equal(t, types.ErrInvalidTwapLookback, err)
+}
+
+func TestCalculateHistoricalTwaps(t *testing.T) {
+	input := CreateTestInput(t)
+
+	priceSnapshots := types.PriceSnapshots{
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(40),
+				LastUpdate:   sdk.NewInt(1800),
+			}),
+		}, 1200),
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroEthDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(10),
+				LastUpdate:   sdk.NewInt(3600),
+			}),
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(20),
+				LastUpdate:   sdk.NewInt(3600),
+			}),
+		}, 3600),
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroEthDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(20),
+				LastUpdate:   sdk.NewInt(4500),
+			}),
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{
+				ExchangeRate: sdk.NewDec(40),
+				LastUpdate:   sdk.NewInt(4500),
+			}),
+		}, 4500),
+	}
+	for _, snap := range priceSnapshots {
+		input.OracleKeeper.SetPriceSnapshot(input.Ctx, snap)
+	}
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(6000, 0))
+
+	// the window from 2700 to 4500 ignores the snapshot taken at its end
+	twaps, err := input.OracleKeeper.CalculateHistoricalTwaps(input.Ctx, 4500, 1800)
+	require.NoError(t, err)
+	require.Equal(t, types.OracleTwaps{
+		{Denom: utils.MicroAtomDenom, Twap: sdk.NewDec(30), LookbackSeconds: 1800},
+		{Denom: utils.MicroEthDenom, Twap: sdk.NewDec(10), LookbackSeconds: 900},
+	}, twaps)
+
+	// the window can't end in the future
+	_, err = input.OracleKeeper.CalculateHistoricalTwaps(input.Ctx, 7000, 1800)
+	require.ErrorIs(t, err, types.ErrInvalidHistoryRange)
+
+	// nor start before the lookback duration of the kept snapshots
+	_, err = input.OracleKeeper.CalculateHistoricalTwaps(input.Ctx, 4500, 3000)
+	require.ErrorIs(t, err, types.ErrInvalidTwapLookback)
+
+	_, err = input.OracleKeeper.CalculateHistoricalTwaps(input.Ctx, 4500, 0)
+	require.ErrorIs(t, err, types.ErrInvalidTwapLookback)
+}
+
+func TestIteratePriceSnapshotsInRange(t *testing.T) {
+	input := CreateTestInput(t)
+
+	for _, ts := range []int64{10, 20, 30, 40} {
+		input.OracleKeeper.SetPriceSnapshot(input.Ctx, types.NewPriceSnapshot(types.PriceSnapshotItems{}, ts))
+	}
+
+	timestamps := []int64{}
+	input.OracleKeeper.IteratePriceSnapshotsInRange(input.Ctx, 20, 40, false, func(snapshot types.PriceSnapshot) bool {
+		timestamps = append(timestamps, snapshot.SnapshotTimestamp)
+		return false
+	})
+	require.Equal(t, []int64{20, 30, 40}, timestamps)
+
+	timestamps = []int64{}
+	input.OracleKeeper.IteratePriceSnapshotsInRange(input.Ctx, 0, 30, true, func(snapshot types.PriceSnapshot) bool {
+		timestamps = append(timestamps, snapshot.SnapshotTimestamp)
+		return false
+	})
+	require.Equal(t, []int64{30, 20, 10}, timestamps)
+}
+
 func TestCalculateTwapsWithUnsupportedDenom(t *testing.T) {
 	input := CreateTestInput(t)
 
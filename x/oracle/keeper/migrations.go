@@ -94,3 +94,15 @@ func (m Migrator) Migrate5To6(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// Migrate6To7 sets the reward distribution window param introduced in version 7
+func (m Migrator) Migrate6To7(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyRewardDistributionWindow, types.DefaultRewardDistributionWindow)
+	return nil
+}
+
+// Migrate7To8 sets the commit reveal enabled param introduced in version 8
+func (m Migrator) Migrate7To8(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyCommitRevealEnabled, types.DefaultCommitRevealEnabled)
+	return nil
+}
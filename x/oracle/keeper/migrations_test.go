@@ -152,3 +152,23 @@ func TestMigrate5to6(t *testing.T) {
 		SuccessCount: 9975,
 	}, votePenaltyCounter)
 }
+
+func TestMigrate6to7(t *testing.T) {
+	input := CreateTestInput(t)
+	input.OracleKeeper.paramSpace.Set(input.Ctx, types.KeyRewardDistributionWindow, uint64(0))
+
+	m := NewMigrator(input.OracleKeeper)
+	require.NoError(t, m.Migrate6To7(input.Ctx))
+
+	require.Equal(t, types.DefaultRewardDistributionWindow, input.OracleKeeper.GetParams(input.Ctx).RewardDistributionWindow)
+}
+
+func TestMigrate7to8(t *testing.T) {
+	input := CreateTestInput(t)
+	input.OracleKeeper.paramSpace.Set(input.Ctx, types.KeyCommitRevealEnabled, true)
+
+	m := NewMigrator(input.OracleKeeper)
+	require.NoError(t, m.Migrate7To8(input.Ctx))
+
+	require.Equal(t, types.DefaultCommitRevealEnabled, input.OracleKeeper.GetParams(input.Ctx).CommitRevealEnabled)
+}
@@ -20,6 +20,52 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 	return &msgServer{Keeper: keeper}
 }
 
+func (ms msgServer) AggregateExchangeRatePrevote(goCtx context.Context, msg *types.MsgAggregateExchangeRatePrevote) (*types.MsgAggregateExchangeRatePrevoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.CommitRevealEnabled(ctx) {
+		return nil, types.ErrCommitRevealDisabled
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
+		return nil, err
+	}
+
+	// Convert hex string to votehash
+	voteHash, err := types.AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidHash, err.Error())
+	}
+
+	aggregatePrevote := types.NewAggregateExchangeRatePrevote(voteHash, valAddr, uint64(ctx.BlockHeight()))
+	ms.SetAggregateExchangeRatePrevote(ctx, valAddr, aggregatePrevote)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAggregatePrevote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyAggregateHash, msg.Hash),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+		),
+	})
+
+	return &types.MsgAggregateExchangeRatePrevoteResponse{}, nil
+}
+
 func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.MsgAggregateExchangeRateVote) (*types.MsgAggregateExchangeRateVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -49,7 +95,15 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 		}
 	}
 
-	ms.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(exchangeRateTuples, valAddr))
+	aggregateVote := types.NewAggregateExchangeRateVote(exchangeRateTuples, valAddr)
+	if ms.CommitRevealEnabled(ctx) {
+		if err := ms.revealAggregateExchangeRateVote(ctx, valAddr, msg); err != nil {
+			return nil, err
+		}
+		aggregateVote.Revealed = true
+	}
+
+	ms.SetAggregateExchangeRateVote(ctx, valAddr, aggregateVote)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -67,6 +121,34 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 	return &types.MsgAggregateExchangeRateVoteResponse{}, nil
 }
 
+// revealAggregateExchangeRateVote checks that the vote matches the prevote the validator
+// submitted during the previous vote period, and consumes the prevote
+func (ms msgServer) revealAggregateExchangeRateVote(ctx sdk.Context, valAddr sdk.ValAddress, msg *types.MsgAggregateExchangeRateVote) error {
+	if len(msg.Salt) == 0 {
+		return types.ErrInvalidSaltLength
+	}
+
+	aggregatePrevote, err := ms.GetAggregateExchangeRatePrevote(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	// Check a msg is submitted proper period
+	votePeriod := ms.VotePeriod(ctx)
+	if (uint64(ctx.BlockHeight())/votePeriod)-(aggregatePrevote.SubmitBlock/votePeriod) != 1 {
+		return types.ErrRevealPeriodMissMatch
+	}
+
+	// Verify a exchange rate with aggregate prevote hash
+	hash := types.GetAggregateVoteHash(msg.Salt, msg.ExchangeRates, valAddr)
+	if aggregatePrevote.Hash != hash.String() {
+		return sdkerrors.Wrapf(types.ErrVerificationFailed, "must be given %s not %s", aggregatePrevote.Hash, hash)
+	}
+
+	ms.DeleteAggregateExchangeRatePrevote(ctx, valAddr)
+	return nil
+}
+
 func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDelegateFeedConsent) (*types.MsgDelegateFeedConsentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
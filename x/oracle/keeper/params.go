@@ -59,6 +59,12 @@ func (k Keeper) LookbackDuration(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// CommitRevealEnabled returns whether votes have to be revealed against a prevote to be tallied
+func (k Keeper) CommitRevealEnabled(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyCommitRevealEnabled, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
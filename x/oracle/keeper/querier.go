@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/sei-protocol/sei-chain/x/oracle/types"
 )
@@ -33,7 +36,7 @@ func (q querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
-// ExchangeRate queries exchange rate of a denom
+// ExchangeRate queries exchange rate of a denom, along with how old it is
 func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateRequest) (*types.QueryExchangeRateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -45,28 +48,58 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 
 	ctx := sdk.UnwrapSDKContext(c)
 	exchangeRate, lastUpdate, lastUpdateTimestamp, err := q.GetBaseExchangeRate(ctx, req.Denom)
-	if err != nil {
+	stale := sdkerrors.IsOf(err, types.ErrStaleExchangeRate)
+	if err != nil && (!stale || req.Strict) {
 		return nil, err
 	}
 
-	return &types.QueryExchangeRateResponse{OracleExchangeRate: types.OracleExchangeRate{
+	rate := types.OracleExchangeRate{
 		ExchangeRate: exchangeRate, LastUpdate: lastUpdate, LastUpdateTimestamp: lastUpdateTimestamp,
-	}}, nil
+	}
+	ageBlocks, ageSeconds := exchangeRateAge(ctx, rate)
+	return &types.QueryExchangeRateResponse{
+		OracleExchangeRate: rate,
+		AgeBlocks:          ageBlocks,
+		AgeSeconds:         ageSeconds,
+		Stale:              stale,
+	}, nil
 }
 
-// ExchangeRates queries exchange rates of all denoms
-func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
+// ExchangeRates queries exchange rates of all denoms, along with how old they are
+func (q querier) ExchangeRates(c context.Context, req *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	strict := req != nil && req.Strict
 
+	var err error
 	exchangeRates := []types.DenomOracleExchangeRatePair{}
 	q.IterateBaseExchangeRates(ctx, func(denom string, rate types.OracleExchangeRate) (stop bool) {
-		exchangeRates = append(exchangeRates, types.DenomOracleExchangeRatePair{Denom: denom, OracleExchangeRate: rate})
+		stale := q.IsExchangeRateStale(ctx, denom, rate)
+		if stale && strict {
+			err = sdkerrors.Wrapf(types.ErrStaleExchangeRate, "%s last updated at height %s", denom, rate.LastUpdate)
+			return true
+		}
+		ageBlocks, ageSeconds := exchangeRateAge(ctx, rate)
+		exchangeRates = append(exchangeRates, types.DenomOracleExchangeRatePair{
+			Denom:              denom,
+			OracleExchangeRate: rate,
+			AgeBlocks:          ageBlocks,
+			AgeSeconds:         ageSeconds,
+			Stale:              stale,
+		})
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.QueryExchangeRatesResponse{DenomOracleExchangeRatePairs: exchangeRates}, nil
 }
 
+// exchangeRateAge returns the number of blocks and seconds since the rate was last updated
+func exchangeRateAge(ctx sdk.Context, rate types.OracleExchangeRate) (blocks int64, seconds int64) {
+	return ctx.BlockHeight() - rate.LastUpdate.Int64(), (ctx.BlockTime().UnixMilli() - rate.LastUpdateTimestamp) / 1000
+}
+
 // Actives queries all denoms for which exchange rates exist
 func (q querier) Actives(c context.Context, _ *types.QueryActivesRequest) (*types.QueryActivesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -107,6 +140,91 @@ func (q querier) Twaps(c context.Context, req *types.QueryTwapsRequest) (*types.
 	return &response, nil
 }
 
+// PriceHistory queries the prices of a denom in the price snapshots taken within a time range.
+// The page limit bounds the number of snapshots read rather than the number of prices returned.
+func (q querier) PriceHistory(c context.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	startTimestamp, endTimestamp := req.StartTimestamp, req.EndTimestamp
+	if endTimestamp == 0 {
+		endTimestamp = ctx.BlockTime().Unix()
+	}
+	if startTimestamp < 0 || startTimestamp > endTimestamp {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidHistoryRange, "start %d, end %d", startTimestamp, endTimestamp)
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset pagination is not supported, use the next key instead")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	// the page key is the timestamp of the snapshot to resume from
+	if len(pageReq.Key) > 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, status.Error(codes.InvalidArgument, "invalid page key")
+		}
+		resumeTimestamp := int64(binary.BigEndian.Uint64(pageReq.Key))
+		if pageReq.Reverse {
+			endTimestamp = resumeTimestamp
+		} else {
+			startTimestamp = resumeTimestamp
+		}
+	}
+
+	priceHistory := []types.PriceHistoryEntry{}
+	var nextKey []byte
+	snapshotsRead := uint64(0)
+	q.IteratePriceSnapshotsInRange(ctx, startTimestamp, endTimestamp, pageReq.Reverse, func(snapshot types.PriceSnapshot) (stop bool) {
+		if snapshotsRead == limit {
+			nextKey = types.GetKeyForTimestamp(uint64(snapshot.SnapshotTimestamp))
+			return true
+		}
+		snapshotsRead++
+		for _, item := range snapshot.PriceSnapshotItems {
+			if item.Denom == req.Denom {
+				priceHistory = append(priceHistory, types.PriceHistoryEntry{
+					SnapshotTimestamp:  snapshot.SnapshotTimestamp,
+					OracleExchangeRate: item.OracleExchangeRate,
+				})
+				break
+			}
+		}
+		return false
+	})
+
+	return &types.QueryPriceHistoryResponse{
+		PriceHistory: priceHistory,
+		Pagination:   &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// HistoricalTwaps queries the time weighted average prices over a window ending at a past timestamp
+func (q querier) HistoricalTwaps(c context.Context, req *types.QueryHistoricalTwapsRequest) (*types.QueryHistoricalTwapsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	twaps, err := q.CalculateHistoricalTwaps(ctx, req.EndTimestamp, req.LookbackSeconds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryHistoricalTwapsResponse{OracleTwaps: twaps}, nil
+}
+
 // FeederDelegation queries the account address that the validator operator delegated oracle vote rights to
 func (q querier) FeederDelegation(c context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
 	if req == nil {
@@ -145,6 +263,23 @@ func (q querier) VotePenaltyCounter(c context.Context, req *types.QueryVotePenal
 	}, nil
 }
 
+// ValidatorRewards queries the oracle rewards pending for and paid to a validator
+func (q querier) ValidatorRewards(c context.Context, req *types.QueryValidatorRewardsRequest) (*types.QueryValidatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorRewardsResponse{
+		Rewards: q.GetValidatorOracleRewards(ctx, valAddr),
+	}, nil
+}
+
 func (q querier) SlashWindow(
 	goCtx context.Context,
 	_ *types.QuerySlashWindowRequest,
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/sei-protocol/sei-chain/x/oracle/types"
 	"github.com/sei-protocol/sei-chain/x/oracle/utils"
@@ -68,6 +69,73 @@ func TestQueryExchangeRates(t *testing.T) {
 	}, res.DenomOracleExchangeRatePairs)
 }
 
+func TestQueryExchangeRatesAgeAndStaleness(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	rate := sdk.NewDec(1700)
+	ts := time.Unix(1000, 0)
+	input.OracleKeeper.SetVoteTargetDenom(input.Ctx, types.Denom{Name: utils.MicroAtomDenom, MaxStalenessBlocks: 5})
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx.WithBlockHeight(10).WithBlockTime(ts), utils.MicroAtomDenom, rate)
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx.WithBlockHeight(12).WithBlockTime(ts.Add(12*time.Second)), utils.MicroSeiDenom, rate)
+
+	ctx := input.Ctx.WithBlockHeight(16).WithBlockTime(ts.Add(36 * time.Second))
+	res, err := querier.ExchangeRates(sdk.WrapSDKContext(ctx), &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.DenomOracleExchangeRatePairs, 2)
+
+	atom := res.DenomOracleExchangeRatePairs[0]
+	require.Equal(t, utils.MicroAtomDenom, atom.Denom)
+	require.Equal(t, int64(6), atom.AgeBlocks)
+	require.Equal(t, int64(36), atom.AgeSeconds)
+	require.True(t, atom.Stale)
+
+	// usei has no max staleness
+	sei := res.DenomOracleExchangeRatePairs[1]
+	require.Equal(t, int64(4), sei.AgeBlocks)
+	require.Equal(t, int64(24), sei.AgeSeconds)
+	require.False(t, sei.Stale)
+
+	_, err = querier.ExchangeRates(sdk.WrapSDKContext(ctx), &types.QueryExchangeRatesRequest{Strict: true})
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+
+	// the atom rate is fresh a block earlier
+	_, err = querier.ExchangeRates(sdk.WrapSDKContext(ctx.WithBlockHeight(15)), &types.QueryExchangeRatesRequest{Strict: true})
+	require.NoError(t, err)
+}
+
+func TestQueryExchangeRateAgeAndStaleness(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	rate := sdk.NewDec(1700)
+	ts := time.Unix(1000, 0)
+	input.OracleKeeper.SetVoteTargetDenom(input.Ctx, types.Denom{Name: utils.MicroAtomDenom, MaxStalenessBlocks: 5})
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx.WithBlockHeight(10).WithBlockTime(ts), utils.MicroAtomDenom, rate)
+
+	// a stale rate is still returned, and flagged as such
+	ctx := input.Ctx.WithBlockHeight(16).WithBlockTime(ts.Add(36 * time.Second))
+	res, err := querier.ExchangeRate(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateRequest{Denom: utils.MicroAtomDenom})
+	require.NoError(t, err)
+	require.Equal(t, rate, res.OracleExchangeRate.ExchangeRate)
+	require.Equal(t, sdk.NewInt(10), res.OracleExchangeRate.LastUpdate)
+	require.Equal(t, int64(6), res.AgeBlocks)
+	require.Equal(t, int64(36), res.AgeSeconds)
+	require.True(t, res.Stale)
+
+	_, err = querier.ExchangeRate(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateRequest{Denom: utils.MicroAtomDenom, Strict: true})
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+
+	// the rate is fresh a block earlier
+	res, err = querier.ExchangeRate(sdk.WrapSDKContext(ctx.WithBlockHeight(15)), &types.QueryExchangeRateRequest{Denom: utils.MicroAtomDenom, Strict: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), res.AgeBlocks)
+	require.False(t, res.Stale)
+
+	_, err = querier.ExchangeRate(sdk.WrapSDKContext(ctx), &types.QueryExchangeRateRequest{Denom: utils.MicroSeiDenom})
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+}
+
 func TestQueryFeederDelegation(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -83,6 +151,32 @@ func TestQueryFeederDelegation(t *testing.T) {
 	require.Equal(t, Addrs[1].String(), res.FeederAddr)
 }
 
+func TestQueryValidatorRewards(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	res, err := querier.ValidatorRewards(ctx, &types.QueryValidatorRewardsRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, types.ValidatorOracleRewards{ValidatorAddress: ValAddrs[0].String()}, res.Rewards)
+
+	rewards := types.ValidatorOracleRewards{
+		ValidatorAddress: ValAddrs[0].String(),
+		Pending:          sdk.NewDecCoins(sdk.NewDecCoinFromDec(utils.MicroSeiDenom, sdk.NewDecWithPrec(5, 1))),
+		TotalPaid:        sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(10))),
+		RecentPayouts: []types.OracleRewardPayout{
+			{Height: 1, Amount: sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(10)))},
+		},
+	}
+	input.OracleKeeper.SetValidatorOracleRewards(input.Ctx, ValAddrs[0], rewards)
+	res, err = querier.ValidatorRewards(ctx, &types.QueryValidatorRewardsRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, rewards, res.Rewards)
+
+	_, err = querier.ValidatorRewards(ctx, &types.QueryValidatorRewardsRequest{ValidatorAddr: "invalid"})
+	require.Error(t, err)
+}
+
 func TestQuerySlashingWindow(t *testing.T) {
 	input := CreateTestInput(t)
 	querier := NewQuerier(input.OracleKeeper)
@@ -162,6 +256,91 @@ func TestQueryPriceSnapshotHistory(t *testing.T) {
 	require.Equal(t, priceSnapshots, res.PriceSnapshots)
 }
 
+func TestQueryPriceHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	atomRates := map[int64]types.OracleExchangeRate{}
+	for i, ts := range []int64{1200, 3600, 4500} {
+		atomRate := types.OracleExchangeRate{ExchangeRate: sdk.NewDec(int64(10 * (i + 1))), LastUpdate: sdk.NewInt(ts)}
+		atomRates[ts] = atomRate
+		items := types.PriceSnapshotItems{types.NewPriceSnapshotItem(utils.MicroAtomDenom, atomRate)}
+		if ts != 1200 {
+			items = append(items, types.NewPriceSnapshotItem(utils.MicroEthDenom, atomRate))
+		}
+		input.OracleKeeper.SetPriceSnapshot(input.Ctx, types.NewPriceSnapshot(items, ts))
+	}
+	entry := func(ts int64) types.PriceHistoryEntry {
+		return types.PriceHistoryEntry{SnapshotTimestamp: ts, OracleExchangeRate: atomRates[ts]}
+	}
+
+	res, err := querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Denom: utils.MicroAtomDenom})
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceHistoryEntry{entry(1200), entry(3600), entry(4500)}, res.PriceHistory)
+	require.Nil(t, res.Pagination.NextKey)
+
+	res, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Denom: utils.MicroEthDenom})
+	require.NoError(t, err)
+	require.Len(t, res.PriceHistory, 2)
+
+	res, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Denom: utils.MicroAtomDenom, StartTimestamp: 2000, EndTimestamp: 4000})
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceHistoryEntry{entry(3600)}, res.PriceHistory)
+
+	// the limit bounds the snapshots read, so the first eth page only has one price
+	res, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Denom: utils.MicroEthDenom, Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.PriceHistory, 1)
+	require.Equal(t, types.GetKeyForTimestamp(4500), res.Pagination.NextKey)
+
+	res, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Denom: utils.MicroEthDenom, Pagination: &query.PageRequest{Limit: 2, Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.PriceHistory, 1)
+	require.Equal(t, int64(4500), res.PriceHistory[0].SnapshotTimestamp)
+	require.Nil(t, res.Pagination.NextKey)
+
+	res, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Denom: utils.MicroAtomDenom, Pagination: &query.PageRequest{Limit: 1, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceHistoryEntry{entry(4500)}, res.PriceHistory)
+	require.Equal(t, types.GetKeyForTimestamp(3600), res.Pagination.NextKey)
+
+	res, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Denom: utils.MicroAtomDenom, Pagination: &query.PageRequest{Limit: 1, Reverse: true, Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceHistoryEntry{entry(3600)}, res.PriceHistory)
+
+	_, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Denom: utils.MicroAtomDenom, StartTimestamp: 4000, EndTimestamp: 2000})
+	require.ErrorIs(t, err, types.ErrInvalidHistoryRange)
+
+	_, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{Denom: utils.MicroAtomDenom, Pagination: &query.PageRequest{Offset: 1}})
+	require.Error(t, err)
+
+	_, err = querier.PriceHistory(ctx, &types.QueryPriceHistoryRequest{})
+	require.Error(t, err)
+}
+
+func TestQueryHistoricalTwaps(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.SetPriceSnapshot(input.Ctx, types.NewPriceSnapshot(types.PriceSnapshotItems{
+		types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(20), LastUpdate: sdk.NewInt(3000)}),
+	}, 3000))
+	input.OracleKeeper.SetPriceSnapshot(input.Ctx, types.NewPriceSnapshot(types.PriceSnapshotItems{
+		types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(40), LastUpdate: sdk.NewInt(4000)}),
+	}, 4000))
+
+	res, err := querier.HistoricalTwaps(ctx, &types.QueryHistoricalTwapsRequest{EndTimestamp: 4500, LookbackSeconds: 1000})
+	require.NoError(t, err)
+	require.Equal(t, types.OracleTwaps{{Denom: utils.MicroAtomDenom, Twap: sdk.NewDec(30), LookbackSeconds: 1000}}, res.OracleTwaps)
+
+	_, err = querier.HistoricalTwaps(ctx, &types.QueryHistoricalTwapsRequest{EndTimestamp: 6000, LookbackSeconds: 1000})
+	require.Error(t, err)
+}
+
 func TestQueryTwaps(t *testing.T) {
 	input := CreateTestInput(t)
 	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
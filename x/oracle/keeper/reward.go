@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// MaxRecentOracleRewardPayouts is the number of payouts kept in the history of each validator
+const MaxRecentOracleRewardPayouts = 100
+
+// GetValidatorOracleRewards retrieves the pending and historical oracle rewards of a validator
+func (k Keeper) GetValidatorOracleRewards(ctx sdk.Context, operator sdk.ValAddress) types.ValidatorOracleRewards {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorRewardsKey(operator))
+	if bz == nil {
+		return types.ValidatorOracleRewards{ValidatorAddress: operator.String()}
+	}
+
+	var rewards types.ValidatorOracleRewards
+	k.cdc.MustUnmarshal(bz, &rewards)
+	return rewards
+}
+
+// SetValidatorOracleRewards stores the pending and historical oracle rewards of a validator
+func (k Keeper) SetValidatorOracleRewards(ctx sdk.Context, operator sdk.ValAddress, rewards types.ValidatorOracleRewards) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rewards)
+	store.Set(types.GetValidatorRewardsKey(operator), bz)
+}
+
+// IterateValidatorOracleRewards iterates over the oracle rewards of all validators
+func (k Keeper) IterateValidatorOracleRewards(ctx sdk.Context, handler func(operator sdk.ValAddress, rewards types.ValidatorOracleRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorRewardsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var rewards types.ValidatorOracleRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		if handler(operator, rewards) {
+			break
+		}
+	}
+}
+
+// GetTotalPendingOracleRewards returns the rewards that ballot winners have earned but that haven't
+// been paid out from the reward pool yet
+func (k Keeper) GetTotalPendingOracleRewards(ctx sdk.Context) sdk.DecCoins {
+	total := sdk.DecCoins{}
+	k.IterateValidatorOracleRewards(ctx, func(_ sdk.ValAddress, rewards types.ValidatorOracleRewards) bool {
+		total = total.Add(rewards.Pending...)
+		return false
+	})
+	return total
+}
+
+// RewardBallotWinners distributes votePeriod / rewardDistributionWindow of the reward pool to the
+// ballot winners of the vote period, weighted by the voting power of their winning votes. Rewards
+// that are fractions of the smallest unit of a denom stay pending until they add up to a full unit.
+func (k Keeper) RewardBallotWinners(
+	ctx sdk.Context,
+	votePeriod uint64,
+	rewardDistributionWindow uint64,
+	validatorClaimMap map[string]types.Claim,
+) {
+	totalWeight := int64(0)
+	for _, claim := range validatorClaimMap {
+		totalWeight += claim.Weight
+	}
+	if totalWeight == 0 {
+		return
+	}
+
+	// rewards already earned by validators are not available for distribution anymore, and nothing
+	// is distributed if the pool can't even cover them
+	available, hasNeg := sdk.NewDecCoinsFromCoins(k.GetRewardPoolLegacy(ctx)...).SafeSub(k.GetTotalPendingOracleRewards(ctx))
+	if hasNeg {
+		return
+	}
+	periodRewards := available.MulDecTruncate(sdk.NewDec(int64(votePeriod))).QuoDecTruncate(sdk.NewDec(int64(rewardDistributionWindow)))
+	if periodRewards.IsZero() {
+		return
+	}
+
+	// iterate in a deterministic order
+	winners := make([]string, 0, len(validatorClaimMap))
+	for addr, claim := range validatorClaimMap {
+		if claim.Weight > 0 {
+			winners = append(winners, addr)
+		}
+	}
+	sort.Strings(winners)
+
+	distributed := sdk.NewCoins()
+	for _, addr := range winners {
+		claim := validatorClaimMap[addr]
+		validator := k.StakingKeeper.Validator(ctx, claim.Recipient)
+		if validator == nil {
+			continue
+		}
+
+		rewards := k.GetValidatorOracleRewards(ctx, claim.Recipient)
+		earned := periodRewards.MulDecTruncate(sdk.NewDec(claim.Weight)).QuoDecTruncate(sdk.NewDec(totalWeight))
+		payout, pending := rewards.Pending.Add(earned...).TruncateDecimal()
+		rewards.Pending = pending
+		if !payout.IsZero() {
+			k.distrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(payout...))
+			distributed = distributed.Add(payout...)
+			rewards.TotalPaid = rewards.TotalPaid.Add(payout...)
+			rewards.RecentPayouts = append(rewards.RecentPayouts, types.OracleRewardPayout{
+				Height: ctx.BlockHeight(),
+				Amount: payout,
+			})
+			if len(rewards.RecentPayouts) > MaxRecentOracleRewardPayouts {
+				rewards.RecentPayouts = rewards.RecentPayouts[len(rewards.RecentPayouts)-MaxRecentOracleRewardPayouts:]
+			}
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeOracleReward,
+				sdk.NewAttribute(types.AttributeKeyValidator, addr),
+				sdk.NewAttribute(types.AttributeKeyAmount, payout.String()),
+			))
+		}
+		k.SetValidatorOracleRewards(ctx, claim.Recipient, rewards)
+	}
+
+	if distributed.IsZero() {
+		return
+	}
+	// the distribution module holds the tokens allocated to validators
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrName, distributed); err != nil {
+		panic(err)
+	}
+}
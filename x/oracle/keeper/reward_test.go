@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
+)
+
+func TestRewardBallotWinners(t *testing.T) {
+	input := CreateTestInput(t)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx.WithBlockHeight(10)
+
+	for i := 0; i < 3; i++ {
+		_, err := sh(ctx, NewTestMsgCreateValidator(ValAddrs[i], ValPubKeys[i], amt))
+		require.NoError(t, err)
+	}
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	pool := sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(1000)))
+	require.NoError(t, FundAccount(input, input.OracleKeeper.GetOracleAccount(ctx).GetAddress(), pool))
+
+	claimMap := map[string]types.Claim{
+		ValAddrs[0].String(): types.NewClaim(100, 1, 1, ValAddrs[0], true),
+		ValAddrs[1].String(): types.NewClaim(100, 2, 1, ValAddrs[1], true),
+		ValAddrs[2].String(): types.NewClaim(100, 0, 0, ValAddrs[2], true),
+	}
+
+	// a tenth of the pool is distributed, and fractions of a usei stay pending
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	input.OracleKeeper.RewardBallotWinners(ctx, 10, 100, claimMap)
+
+	rewards0 := input.OracleKeeper.GetValidatorOracleRewards(ctx, ValAddrs[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(33))), rewards0.TotalPaid)
+	require.Equal(t, sdk.MustNewDecFromStr("0.333333333333333333"), rewards0.Pending.AmountOf(utils.MicroSeiDenom))
+	require.Equal(t, []types.OracleRewardPayout{{Height: 10, Amount: rewards0.TotalPaid}}, rewards0.RecentPayouts)
+	rewards1 := input.OracleKeeper.GetValidatorOracleRewards(ctx, ValAddrs[1])
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(66))), rewards1.TotalPaid)
+	require.Equal(t, types.ValidatorOracleRewards{ValidatorAddress: ValAddrs[2].String()}, input.OracleKeeper.GetValidatorOracleRewards(ctx, ValAddrs[2]))
+
+	require.Equal(t, sdk.NewDecCoinsFromCoins(rewards0.TotalPaid...), input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[0]))
+	require.Equal(t, sdk.NewInt(901), input.OracleKeeper.GetRewardPool(ctx, utils.MicroSeiDenom).Amount)
+	distrAddr := input.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	require.Equal(t, sdk.NewInt(99), input.BankKeeper.GetBalance(ctx, distrAddr, utils.MicroSeiDenom).Amount)
+
+	events := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeOracleReward {
+			events++
+		}
+	}
+	require.Equal(t, 2, events)
+
+	// pending rewards are excluded from the distributable pool and paid out once they add up to a usei
+	ctx = ctx.WithBlockHeight(20)
+	input.OracleKeeper.RewardBallotWinners(ctx, 10, 100, claimMap)
+	rewards0 = input.OracleKeeper.GetValidatorOracleRewards(ctx, ValAddrs[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(63))), rewards0.TotalPaid)
+	require.Len(t, rewards0.RecentPayouts, 2)
+	rewards1 = input.OracleKeeper.GetValidatorOracleRewards(ctx, ValAddrs[1])
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(126))), rewards1.TotalPaid)
+	require.Equal(t, sdk.NewInt(811), input.OracleKeeper.GetRewardPool(ctx, utils.MicroSeiDenom).Amount)
+}
+
+func TestRewardBallotWinnersNoWinners(t *testing.T) {
+	input := CreateTestInput(t)
+	pool := sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(1000)))
+	require.NoError(t, FundAccount(input, input.OracleKeeper.GetOracleAccount(input.Ctx).GetAddress(), pool))
+
+	claimMap := map[string]types.Claim{
+		ValAddrs[0].String(): types.NewClaim(100, 0, 0, ValAddrs[0], true),
+	}
+	input.OracleKeeper.RewardBallotWinners(input.Ctx, 10, 100, claimMap)
+	require.Equal(t, pool[0], input.OracleKeeper.GetRewardPool(input.Ctx, utils.MicroSeiDenom))
+}
+
+func TestRewardBallotWinnersPendingExceedsPool(t *testing.T) {
+	input := CreateTestInput(t)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx.WithBlockHeight(10)
+
+	for i := 0; i < 2; i++ {
+		_, err := sh(ctx, NewTestMsgCreateValidator(ValAddrs[i], ValPubKeys[i], amt))
+		require.NoError(t, err)
+	}
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	pool := sdk.NewCoins(sdk.NewCoin(utils.MicroSeiDenom, sdk.NewInt(1)))
+	require.NoError(t, FundAccount(input, input.OracleKeeper.GetOracleAccount(ctx).GetAddress(), pool))
+
+	// the pending rewards add up to more than the pool holds
+	pending := sdk.NewDecCoins(sdk.NewDecCoinFromDec(utils.MicroSeiDenom, sdk.MustNewDecFromStr("0.9")))
+	for i := 0; i < 2; i++ {
+		input.OracleKeeper.SetValidatorOracleRewards(ctx, ValAddrs[i], types.ValidatorOracleRewards{
+			ValidatorAddress: ValAddrs[i].String(),
+			Pending:          pending,
+		})
+	}
+
+	claimMap := map[string]types.Claim{
+		ValAddrs[0].String(): types.NewClaim(100, 1, 1, ValAddrs[0], true),
+		ValAddrs[1].String(): types.NewClaim(100, 1, 1, ValAddrs[1], true),
+	}
+	input.OracleKeeper.RewardBallotWinners(ctx, 10, 10, claimMap)
+
+	require.Equal(t, pool[0], input.OracleKeeper.GetRewardPool(ctx, utils.MicroSeiDenom))
+	for i := 0; i < 2; i++ {
+		rewards := input.OracleKeeper.GetValidatorOracleRewards(ctx, ValAddrs[i])
+		require.Equal(t, pending, rewards.Pending)
+		require.True(t, rewards.TotalPaid.IsZero())
+	}
+}
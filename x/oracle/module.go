@@ -144,6 +144,8 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	_ = cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
 	_ = cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
 	_ = cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5To6)
+	_ = cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6To7)
+	_ = cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7To8)
 }
 
 // InitGenesis performs genesis initialization for the oracle module. It returns
@@ -164,7 +166,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 6 }
+func (AppModule) ConsensusVersion() uint64 { return 8 }
 
 // BeginBlock returns the begin blocker for the oracle module.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
@@ -19,7 +19,7 @@ const (
 	votePeriodKey               = "vote_period"
 	voteThresholdKey            = "vote_threshold"
 	rewardBandKey               = "reward_band"
-	rewardDistributionWindowKey = "reward_distribution_window" //nolint:deadcode // we will use this later
+	rewardDistributionWindowKey = "reward_distribution_window"
 	slashFractionKey            = "slash_fraction"
 	slashWindowKey              = "slash_window"
 	minValidPerWindowKey        = "min_valid_per_window"
@@ -40,6 +40,11 @@ func GenRewardBand(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
 }
 
+// GenRewardDistributionWindow randomized RewardDistributionWindow
+func GenRewardDistributionWindow(r *rand.Rand) uint64 {
+	return uint64(100 + r.Intn(100000))
+}
+
 // GenSlashFraction randomized SlashFraction
 func GenSlashFraction(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
@@ -75,6 +80,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { rewardBand = GenRewardBand(r) },
 	)
 
+	var rewardDistributionWindow uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardDistributionWindowKey, &rewardDistributionWindow, simState.Rand,
+		func(r *rand.Rand) { rewardDistributionWindow = GenRewardDistributionWindow(r) },
+	)
+
 	var slashFraction sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, slashFractionKey, &slashFraction, simState.Rand,
@@ -105,12 +116,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 			SlashFraction:     slashFraction,
 			SlashWindow:       slashWindow,
 			MinValidPerWindow: minValidPerWindow,
+
+			RewardDistributionWindow: rewardDistributionWindow,
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
 		[]types.PenaltyCounter{},
 		[]types.AggregateExchangeRateVote{},
 		types.PriceSnapshots{},
+		[]types.ValidatorOracleRewards{},
+		[]types.AggregateExchangeRatePrevote{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
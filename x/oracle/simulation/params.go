@@ -31,6 +31,11 @@ func ParamChanges(_ *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenRewardBand(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRewardDistributionWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenRewardDistributionWindow(r))
+			},
+		),
 		simulation.NewSimParamChange(types.ModuleName, string(types.KeySlashFraction),
 			func(r *rand.Rand) string {
 				return fmt.Sprintf("\"%s\"", GenSlashFraction(r))
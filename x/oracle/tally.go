@@ -53,16 +53,19 @@ func pickReferenceDenom(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 
 	totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
 	voteThreshold := k.VoteThreshold(ctx)
-	thresholdVotes := voteThreshold.MulInt64(totalBondedPower).RoundInt()
 
 	for denom, ballot := range voteMap {
 		// If denom is not in the voteTargets, or the ballot for it has failed, then skip
 		// and remove it from voteMap for iteration efficiency
-		if _, exists := voteTargets[denom]; !exists {
+		voteTarget, exists := voteTargets[denom]
+		if !exists {
 			delete(voteMap, denom)
 			continue
 		}
 
+		// the denom may override the global vote threshold
+		thresholdVotes := voteTarget.VoteThresholdOrDefault(voteThreshold).MulInt64(totalBondedPower).RoundInt()
+
 		ballotPower := int64(0)
 
 		// If the ballot is not passed, remove it from the voteTargets array
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 
 	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // String implements fmt.Stringer interface
@@ -14,7 +17,58 @@ func (d Denom) String() string {
 
 // Equal implements equal interface
 func (d Denom) Equal(d1 *Denom) bool {
-	return d.Name == d1.Name
+	return d.Name == d1.Name &&
+		decPtrEqual(d.VoteThreshold, d1.VoteThreshold) &&
+		decPtrEqual(d.RewardBand, d1.RewardBand) &&
+		d.MaxStalenessBlocks == d1.MaxStalenessBlocks
+}
+
+// Validate checks the denom has a name and that its overrides, if set, are in range
+func (d Denom) Validate() error {
+	if len(d.Name) == 0 {
+		return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+	}
+
+	if d.VoteThreshold != nil && (d.VoteThreshold.LTE(sdk.NewDecWithPrec(33, 2)) || d.VoteThreshold.GT(sdk.OneDec())) {
+		return fmt.Errorf("oracle parameter Whitelist Denom %s VoteThreshold must be greater than 33 percent and at most 100 percent", d.Name)
+	}
+
+	if d.RewardBand != nil && (d.RewardBand.GT(sdk.OneDec()) || d.RewardBand.IsNegative()) {
+		return fmt.Errorf("oracle parameter Whitelist Denom %s RewardBand must be between [0, 1]", d.Name)
+	}
+
+	return nil
+}
+
+// VoteThresholdOrDefault returns the vote threshold override of the denom,
+// or defaultThreshold if the denom does not set one
+func (d Denom) VoteThresholdOrDefault(defaultThreshold sdk.Dec) sdk.Dec {
+	if d.VoteThreshold == nil {
+		return defaultThreshold
+	}
+	return *d.VoteThreshold
+}
+
+// RewardBandOrDefault returns the reward band override of the denom,
+// or defaultRewardBand if the denom does not set one
+func (d Denom) RewardBandOrDefault(defaultRewardBand sdk.Dec) sdk.Dec {
+	if d.RewardBand == nil {
+		return defaultRewardBand
+	}
+	return *d.RewardBand
+}
+
+// IsStale returns true if the denom has a max staleness and more than that many
+// blocks have passed between lastUpdate and currentHeight
+func (d Denom) IsStale(lastUpdate int64, currentHeight int64) bool {
+	return d.MaxStalenessBlocks > 0 && currentHeight-lastUpdate > int64(d.MaxStalenessBlocks)
+}
+
+func decPtrEqual(a, b *sdk.Dec) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
 }
 
 // DenomList is array of Denom
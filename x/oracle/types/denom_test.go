@@ -1,6 +1,12 @@
 package types
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func TestDenomListContains(t *testing.T) {
 	tests := []struct {
@@ -45,3 +51,27 @@ func TestDenomListContains(t *testing.T) {
 		})
 	}
 }
+
+func TestDenomOverrides(t *testing.T) {
+	voteThreshold := sdk.NewDecWithPrec(9, 1)
+	rewardBand := sdk.NewDecWithPrec(1, 1)
+	d := Denom{Name: "uatom", VoteThreshold: &voteThreshold, RewardBand: &rewardBand, MaxStalenessBlocks: 10}
+	require.NoError(t, d.Validate())
+	require.Equal(t, voteThreshold, d.VoteThresholdOrDefault(DefaultVoteThreshold))
+	require.Equal(t, rewardBand, d.RewardBandOrDefault(DefaultRewardBand))
+	require.False(t, d.IsStale(10, 20))
+	require.True(t, d.IsStale(10, 21))
+
+	plain := Denom{Name: "uatom"}
+	require.Equal(t, DefaultVoteThreshold, plain.VoteThresholdOrDefault(DefaultVoteThreshold))
+	require.Equal(t, DefaultRewardBand, plain.RewardBandOrDefault(DefaultRewardBand))
+	require.False(t, plain.IsStale(0, 1000))
+	require.False(t, plain.Equal(&d))
+	require.True(t, d.Equal(&Denom{Name: "uatom", VoteThreshold: &voteThreshold, RewardBand: &rewardBand, MaxStalenessBlocks: 10}))
+
+	lowThreshold := sdk.NewDecWithPrec(3, 1)
+	require.Error(t, Denom{Name: "uatom", VoteThreshold: &lowThreshold}.Validate())
+	largeBand := sdk.NewDecWithPrec(11, 1)
+	require.Error(t, Denom{Name: "uatom", RewardBand: &largeBand}.Validate())
+	require.Error(t, Denom{}.Validate())
+}
@@ -15,6 +15,9 @@ var (
 	ErrInvalidHash           = sdkerrors.Register(ModuleName, 6, "invalid hash")
 	ErrInvalidHashLength     = sdkerrors.Register(ModuleName, 7, fmt.Sprintf("invalid hash length; should equal %d", ed25519.TruncatedSize))
 	ErrVerificationFailed    = sdkerrors.Register(ModuleName, 8, "hash verification failed")
+	ErrRevealPeriodMissMatch = sdkerrors.Register(ModuleName, 9, "reveal period of submitted vote do not match with registered prevote")
+	ErrInvalidSaltLength     = sdkerrors.Register(ModuleName, 10, "invalid salt length; should be 8~64")
+	ErrNoAggregatePrevote    = sdkerrors.Register(ModuleName, 11, "no aggregate prevote")
 	ErrNoAggregateVote       = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
 	ErrNoVoteTarget          = sdkerrors.Register(ModuleName, 13, "no vote target")
 	ErrUnknownDenom          = sdkerrors.Register(ModuleName, 14, "unknown denom")
@@ -28,4 +31,9 @@ var (
 	ErrEncodingOracleTwaps   = sdkerrors.Register(ModuleName, 22, "Error encoding oracle twaps as JSON")
 	ErrUnknownSeiOracleQuery = sdkerrors.Register(ModuleName, 23, "Error unknown sei oracle query")
 	ErrAggregateVoteExist    = sdkerrors.Register(ModuleName, 24, "aggregate vote still present in current voting window")
+	ErrCommitRevealDisabled  = sdkerrors.Register(ModuleName, 25, "commit-reveal voting is disabled")
+	ErrAggregatePrevoteExist = sdkerrors.Register(ModuleName, 26, "aggregate prevote still present in current voting window")
+	ErrStaleExchangeRate     = sdkerrors.Register(ModuleName, 27, "exchange rate is stale")
+	ErrInvalidHistoryRange   = sdkerrors.Register(ModuleName, 28, "invalid price history time range")
+	ErrEncodingPriceHistory  = sdkerrors.Register(ModuleName, 29, "Error encoding price history as JSON")
 )
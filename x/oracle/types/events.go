@@ -5,11 +5,14 @@ const (
 	EventTypeExchangeRateUpdate = "exchange_rate_update"
 	EventTypeVote               = "vote"
 	EventTypeFeedDelegate       = "feed_delegate"
+	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeAggregateVote      = "aggregate_vote"
 	EventTypeEndSlashWindow     = "end_slash_window"
+	EventTypeOracleReward       = "oracle_reward"
 
 	AttributeKeyDenom         = "denom"
 	AttributeKeyVoter         = "voter"
+	AttributeKeyAggregateHash = "aggregate_hash"
 	AttributeKeyExchangeRate  = "exchange_rate"
 	AttributeKeyExchangeRates = "exchange_rates"
 	AttributeKeyOperator      = "operator"
@@ -18,6 +21,8 @@ const (
 	AttributeKeyAbstainCount  = "abstain_count"
 	AttributeKeyWinCount      = "win_count"
 	AttributeKeySuccessCount  = "success_count"
+	AttributeKeyValidator     = "validator"
+	AttributeKeyAmount        = "amount"
 
 	AttributeValueCategory = ModuleName
 )
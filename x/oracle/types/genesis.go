@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -12,6 +14,8 @@ func NewGenesisState(
 	feederDelegations []FeederDelegation, penaltyCounters []PenaltyCounter,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
 	priceSnapshots []PriceSnapshot,
+	validatorOracleRewards []ValidatorOracleRewards,
+	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                     params,
@@ -20,6 +24,9 @@ func NewGenesisState(
 		PenaltyCounters:            penaltyCounters,
 		AggregateExchangeRateVotes: aggregateExchangeRateVotes,
 		PriceSnapshots:             priceSnapshots,
+		ValidatorOracleRewards:     validatorOracleRewards,
+
+		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 	}
 }
 
@@ -32,11 +39,33 @@ func DefaultGenesisState() *GenesisState {
 		PenaltyCounters:            []PenaltyCounter{},
 		AggregateExchangeRateVotes: []AggregateExchangeRateVote{},
 		PriceSnapshots:             PriceSnapshots{},
+		ValidatorOracleRewards:     []ValidatorOracleRewards{},
+
+		AggregateExchangeRatePrevotes: []AggregateExchangeRatePrevote{},
 	}
 }
 
 // ValidateGenesis validates the oracle genesis state
 func ValidateGenesis(data *GenesisState) error {
+	for _, rewards := range data.ValidatorOracleRewards {
+		if _, err := sdk.ValAddressFromBech32(rewards.ValidatorAddress); err != nil {
+			return err
+		}
+		if err := rewards.Pending.Validate(); err != nil {
+			return fmt.Errorf("invalid pending oracle rewards of %s: %w", rewards.ValidatorAddress, err)
+		}
+		if err := rewards.TotalPaid.Validate(); err != nil {
+			return fmt.Errorf("invalid paid oracle rewards of %s: %w", rewards.ValidatorAddress, err)
+		}
+	}
+	for _, prevote := range data.AggregateExchangeRatePrevotes {
+		if _, err := sdk.ValAddressFromBech32(prevote.Voter); err != nil {
+			return err
+		}
+		if _, err := AggregateVoteHashFromHexString(prevote.Hash); err != nil {
+			return fmt.Errorf("invalid aggregate prevote hash of %s: %w", prevote.Voter, err)
+		}
+	}
 	return data.Params.Validate()
 }
 
@@ -25,12 +25,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params                     Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	FeederDelegations          []FeederDelegation          `protobuf:"bytes,2,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
-	ExchangeRates              ExchangeRateTuples          `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
-	PenaltyCounters            []PenaltyCounter            `protobuf:"bytes,4,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
-	AggregateExchangeRateVotes []AggregateExchangeRateVote `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
-	PriceSnapshots             PriceSnapshots              `protobuf:"bytes,7,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	Params                        Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	FeederDelegations             []FeederDelegation             `protobuf:"bytes,2,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
+	ExchangeRates                 ExchangeRateTuples             `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
+	PenaltyCounters               []PenaltyCounter               `protobuf:"bytes,4,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
+	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	PriceSnapshots                PriceSnapshots                 `protobuf:"bytes,7,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	ValidatorOracleRewards        []ValidatorOracleRewards       `protobuf:"bytes,8,rep,name=validator_oracle_rewards,json=validatorOracleRewards,proto3" json:"validator_oracle_rewards"`
+	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,9,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +110,20 @@ func (m *GenesisState) GetPriceSnapshots() PriceSnapshots {
 	return nil
 }
 
+func (m *GenesisState) GetValidatorOracleRewards() []ValidatorOracleRewards {
+	if m != nil {
+		return m.ValidatorOracleRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAggregateExchangeRatePrevotes() []AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregateExchangeRatePrevotes
+	}
+	return nil
+}
+
 type FeederDelegation struct {
 	FeederAddress    string `protobuf:"bytes,1,opt,name=feeder_address,json=feederAddress,proto3" json:"feeder_address,omitempty"`
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
@@ -221,40 +237,44 @@ func init() {
 func init() { proto.RegisterFile("oracle/genesis.proto", fileDescriptor_ce0b3a2b4a184fc3) }
 
 var fileDescriptor_ce0b3a2b4a184fc3 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xdb, 0x10, 0x60, 0x43, 0xd3, 0x74, 0x89, 0x50, 0x14, 0x84, 0x5b, 0x05, 0x21, 0x55,
-	0x54, 0xb5, 0x69, 0x90, 0xb8, 0x27, 0x7c, 0x49, 0x3d, 0x21, 0x17, 0x71, 0x40, 0x48, 0xd6, 0xda,
-	0x9e, 0x38, 0x2b, 0x1c, 0xaf, 0xf1, 0x6c, 0xa2, 0xf6, 0xc4, 0x5f, 0xe0, 0x27, 0x70, 0xe6, 0x97,
-	0xf4, 0xd8, 0x23, 0x27, 0x40, 0xc9, 0xbf, 0xe0, 0x84, 0xbc, 0xbb, 0x2d, 0x75, 0xda, 0xfa, 0x94,
-	0xdd, 0x37, 0xef, 0xcd, 0xcb, 0x9b, 0x59, 0x93, 0x8e, 0xc8, 0x59, 0x98, 0x80, 0x1b, 0x43, 0x0a,
-	0xc8, 0xd1, 0xc9, 0x72, 0x21, 0x05, 0x7d, 0x88, 0xc0, 0xd5, 0x29, 0x14, 0x89, 0x83, 0xc0, 0xc3,
-	0x09, 0xe3, 0xa9, 0xa3, 0xa9, 0xbd, 0x4e, 0x2c, 0x62, 0xa1, 0xaa, 0x6e, 0x71, 0xd2, 0x92, 0xde,
-	0x7d, 0xd3, 0x48, 0xff, 0x18, 0xd0, 0x0e, 0x05, 0x4e, 0x05, 0xba, 0x01, 0x43, 0x70, 0xe7, 0x07,
-	0x01, 0x48, 0x76, 0xe0, 0x86, 0x82, 0xa7, 0xba, 0xde, 0xff, 0x5b, 0x27, 0xf7, 0xde, 0x6a, 0xe7,
-	0x23, 0xc9, 0x24, 0xd0, 0x21, 0x69, 0x64, 0x2c, 0x67, 0x53, 0xec, 0x5a, 0x3b, 0xd6, 0x6e, 0x73,
-	0xf0, 0xd8, 0xa9, 0xf8, 0x27, 0xce, 0x3b, 0x45, 0x1d, 0xd5, 0x4f, 0x7f, 0x6d, 0xd7, 0x3c, 0x23,
-	0xa4, 0x01, 0xa1, 0x63, 0x80, 0x08, 0x72, 0x3f, 0x82, 0x04, 0x62, 0x26, 0xb9, 0x48, 0xb1, 0xbb,
-	0xb6, 0xb3, 0xbe, 0xdb, 0x1c, 0xec, 0x57, 0xb6, 0x7b, 0xa3, 0x64, 0xaf, 0x2e, 0x54, 0xa6, 0xf1,
-	0xd6, 0x78, 0x05, 0x47, 0xfa, 0x85, 0xb4, 0xe0, 0x38, 0x9c, 0xb0, 0x34, 0x06, 0x3f, 0x67, 0x12,
-	0xb0, 0xbb, 0xae, 0xfa, 0x3b, 0x95, 0xfd, 0x5f, 0x1b, 0x89, 0xc7, 0x24, 0xbc, 0x9f, 0x65, 0x09,
-	0x8c, 0x7a, 0x85, 0xc1, 0x8f, 0xdf, 0xdb, 0xf4, 0x4a, 0x09, 0xbd, 0x0d, 0xb8, 0x84, 0x21, 0xfd,
-	0x44, 0xda, 0x19, 0xa4, 0x2c, 0x91, 0x27, 0x7e, 0x28, 0x66, 0xa9, 0x84, 0x1c, 0xbb, 0x75, 0x65,
-	0xba, 0x57, 0x3d, 0x23, 0x2d, 0x7a, 0xa9, 0x35, 0x26, 0xd2, 0x66, 0x56, 0x42, 0x91, 0x7e, 0x25,
-	0x8f, 0x58, 0x1c, 0xe7, 0x45, 0x40, 0xf0, 0x4b, 0xd1, 0xfc, 0xb9, 0x28, 0xf2, 0x35, 0x94, 0xd5,
-	0x8b, 0x4a, 0xab, 0xe1, 0x79, 0x87, 0xcb, 0x69, 0x3e, 0x08, 0x09, 0xc6, 0xb5, 0xc7, 0x6e, 0x22,
-	0x20, 0xfd, 0x4c, 0x36, 0xb3, 0x9c, 0x87, 0xe0, 0x63, 0xca, 0x32, 0x9c, 0x08, 0x89, 0xdd, 0xdb,
-	0xca, 0xf2, 0x69, 0x75, 0xba, 0x42, 0x73, 0x64, 0x24, 0xa3, 0x07, 0x66, 0x9c, 0xad, 0x12, 0x8c,
-	0x5e, 0x2b, 0x2b, 0xdd, 0x0f, 0xeb, 0x77, 0x6e, 0xb5, 0x1b, 0xfd, 0x31, 0x69, 0xaf, 0x6e, 0x9c,
-	0x3e, 0x21, 0x2d, 0xf3, 0x78, 0x58, 0x14, 0xe5, 0x80, 0xfa, 0x1d, 0xde, 0xf5, 0x36, 0x34, 0x3a,
-	0xd4, 0x20, 0xdd, 0x23, 0x5b, 0x73, 0x96, 0xf0, 0x88, 0x49, 0xf1, 0x9f, 0xb9, 0xa6, 0x98, 0xed,
-	0x8b, 0x82, 0x21, 0xf7, 0xbf, 0x5b, 0xa4, 0x55, 0xde, 0xc2, 0xf5, 0x7a, 0xeb, 0x7a, 0x3d, 0x65,
-	0xa4, 0x53, 0xec, 0xc0, 0x5f, 0x59, 0xbf, 0xf2, 0x6b, 0x0e, 0xdc, 0xca, 0xf9, 0x14, 0xc3, 0x2d,
-	0x7b, 0x7b, 0x74, 0x7e, 0x05, 0x1b, 0x1d, 0x9e, 0x2e, 0x6c, 0xeb, 0x6c, 0x61, 0x5b, 0x7f, 0x16,
-	0xb6, 0xf5, 0x6d, 0x69, 0xd7, 0xce, 0x96, 0x76, 0xed, 0xe7, 0xd2, 0xae, 0x7d, 0x7c, 0x16, 0x73,
-	0x39, 0x99, 0x05, 0x4e, 0x28, 0xa6, 0x2e, 0x02, 0xdf, 0x3f, 0x77, 0x52, 0x17, 0x65, 0xe5, 0x1e,
-	0x9b, 0x6f, 0xde, 0x95, 0x27, 0x19, 0x60, 0xd0, 0x50, 0x94, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x29, 0xe9, 0x57, 0x70, 0x5a, 0x04, 0x00, 0x00,
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xd4, 0x40,
+	0x14, 0xdf, 0x02, 0xae, 0x30, 0xc8, 0xb2, 0x8c, 0x84, 0x34, 0x6b, 0x28, 0x04, 0x63, 0x42, 0x24,
+	0xb4, 0x02, 0x89, 0x89, 0x47, 0xd6, 0x7f, 0x09, 0x17, 0x49, 0x31, 0x1c, 0x8c, 0x49, 0x33, 0xdb,
+	0x3e, 0xba, 0x8d, 0xa5, 0x53, 0xe7, 0xcd, 0xae, 0x70, 0xf2, 0xea, 0xd1, 0x8f, 0xe0, 0xd9, 0x4f,
+	0xc2, 0x91, 0xa3, 0x27, 0x35, 0xbb, 0xdf, 0xc2, 0x93, 0xe9, 0xcc, 0x2c, 0xd2, 0x65, 0x69, 0xe2,
+	0x69, 0x67, 0x7e, 0xef, 0xf7, 0x67, 0xde, 0xdb, 0x99, 0x92, 0x65, 0x2e, 0x58, 0x98, 0x82, 0x17,
+	0x43, 0x06, 0x98, 0xa0, 0x9b, 0x0b, 0x2e, 0x39, 0x7d, 0x80, 0x90, 0xa8, 0x55, 0xc8, 0x53, 0x17,
+	0x21, 0x09, 0xbb, 0x2c, 0xc9, 0x5c, 0x4d, 0x6d, 0x2d, 0xc7, 0x3c, 0xe6, 0xaa, 0xea, 0x15, 0x2b,
+	0x2d, 0x69, 0xdd, 0x37, 0x46, 0xfa, 0xc7, 0x80, 0x4e, 0xc8, 0xf1, 0x94, 0xa3, 0xd7, 0x61, 0x08,
+	0x5e, 0x7f, 0xa7, 0x03, 0x92, 0xed, 0x78, 0x21, 0x4f, 0x32, 0x5d, 0xdf, 0xf8, 0x53, 0x27, 0xf7,
+	0x5e, 0xeb, 0xe4, 0x23, 0xc9, 0x24, 0xd0, 0x7d, 0x52, 0xcf, 0x99, 0x60, 0xa7, 0x68, 0x5b, 0xeb,
+	0xd6, 0xe6, 0xfc, 0xee, 0x43, 0xb7, 0xe2, 0x24, 0xee, 0xa1, 0xa2, 0xb6, 0x67, 0x2e, 0x7e, 0xae,
+	0xd5, 0x7c, 0x23, 0xa4, 0x1d, 0x42, 0x4f, 0x00, 0x22, 0x10, 0x41, 0x04, 0x29, 0xc4, 0x4c, 0x26,
+	0x3c, 0x43, 0x7b, 0x6a, 0x7d, 0x7a, 0x73, 0x7e, 0x77, 0xbb, 0xd2, 0xee, 0x95, 0x92, 0xbd, 0xb8,
+	0x52, 0x19, 0xe3, 0xa5, 0x93, 0x31, 0x1c, 0xe9, 0x47, 0xd2, 0x80, 0xb3, 0xb0, 0xcb, 0xb2, 0x18,
+	0x02, 0xc1, 0x24, 0xa0, 0x3d, 0xad, 0xfc, 0xdd, 0x4a, 0xff, 0x97, 0x46, 0xe2, 0x33, 0x09, 0x6f,
+	0x7b, 0x79, 0x0a, 0xed, 0x56, 0x11, 0xf0, 0xfd, 0xd7, 0x1a, 0xbd, 0x51, 0x42, 0x7f, 0x01, 0xae,
+	0x61, 0x48, 0xdf, 0x93, 0x66, 0x0e, 0x19, 0x4b, 0xe5, 0x79, 0x10, 0xf2, 0x5e, 0x26, 0x41, 0xa0,
+	0x3d, 0xa3, 0x42, 0xb7, 0xaa, 0x67, 0xa4, 0x45, 0xcf, 0xb5, 0xc6, 0xb4, 0xb4, 0x98, 0x97, 0x50,
+	0xa4, 0x9f, 0xc9, 0x2a, 0x8b, 0x63, 0x51, 0x34, 0x08, 0x41, 0xa9, 0xb5, 0xa0, 0xcf, 0x8b, 0xfe,
+	0xea, 0x2a, 0xea, 0x69, 0x65, 0xd4, 0xfe, 0xc8, 0xe1, 0x7a, 0x37, 0xc7, 0x5c, 0x82, 0x49, 0x6d,
+	0xb1, 0xdb, 0x08, 0x48, 0x3f, 0x90, 0xc5, 0x5c, 0x24, 0x21, 0x04, 0x98, 0xb1, 0x1c, 0xbb, 0x5c,
+	0xa2, 0x7d, 0x57, 0x45, 0x3e, 0xae, 0xee, 0xae, 0xd0, 0x1c, 0x19, 0x49, 0x7b, 0xc5, 0x8c, 0xb3,
+	0x51, 0x82, 0xd1, 0x6f, 0xe4, 0xa5, 0x3d, 0x45, 0x62, 0xf7, 0x59, 0x9a, 0x44, 0x4c, 0x72, 0x11,
+	0x68, 0xa7, 0x40, 0xc0, 0x27, 0x26, 0x22, 0xb4, 0x67, 0x55, 0xea, 0x5e, 0x65, 0xea, 0xf1, 0x48,
+	0xfc, 0x46, 0xed, 0x7d, 0x2d, 0x35, 0x5d, 0xae, 0xf4, 0x27, 0x56, 0xe9, 0x17, 0x8b, 0xac, 0xdf,
+	0x36, 0xe3, 0x5c, 0x80, 0x1e, 0xf3, 0x9c, 0x4a, 0x7f, 0xf6, 0xff, 0x63, 0x3e, 0xd4, 0x0e, 0xe6,
+	0x0c, 0xab, 0xac, 0x82, 0x83, 0x07, 0x33, 0xb3, 0x77, 0x9a, 0xf5, 0x8d, 0x13, 0xd2, 0x1c, 0xbf,
+	0xf1, 0xf4, 0x11, 0x69, 0x98, 0xc7, 0xc3, 0xa2, 0x48, 0x00, 0xea, 0x77, 0x38, 0xe7, 0x2f, 0x68,
+	0x74, 0x5f, 0x83, 0x74, 0x8b, 0x2c, 0xfd, 0x1b, 0xe0, 0x88, 0x39, 0xa5, 0x98, 0xcd, 0xab, 0x82,
+	0x21, 0x6f, 0x7c, 0xb3, 0x48, 0xa3, 0x7c, 0x0b, 0x27, 0xeb, 0xad, 0xc9, 0x7a, 0xca, 0xc8, 0x72,
+	0x71, 0xec, 0x60, 0xec, 0xfa, 0xab, 0xbc, 0xf9, 0x5d, 0xaf, 0xfa, 0x9f, 0xe2, 0x12, 0xca, 0xd9,
+	0x3e, 0xed, 0xdf, 0xc0, 0xda, 0x07, 0x17, 0x03, 0xc7, 0xba, 0x1c, 0x38, 0xd6, 0xef, 0x81, 0x63,
+	0x7d, 0x1d, 0x3a, 0xb5, 0xcb, 0xa1, 0x53, 0xfb, 0x31, 0x74, 0x6a, 0xef, 0x9e, 0xc4, 0x89, 0xec,
+	0xf6, 0x3a, 0x6e, 0xc8, 0x4f, 0x3d, 0x84, 0x64, 0x7b, 0x94, 0xa4, 0x36, 0x2a, 0xca, 0x3b, 0x33,
+	0xdf, 0x3c, 0x4f, 0x9e, 0xe7, 0x80, 0x9d, 0xba, 0xa2, 0xec, 0xfd, 0x1d, 0x00, 0x32, 0x1f, 0xea,
+	0x62, 0x5a, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -277,6 +297,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for iNdEx := len(m.AggregateExchangeRatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateExchangeRatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ValidatorOracleRewards) > 0 {
+		for iNdEx := len(m.ValidatorOracleRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorOracleRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.PriceSnapshots) > 0 {
 		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -488,6 +536,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorOracleRewards) > 0 {
+		for _, e := range m.ValidatorOracleRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for _, e := range m.AggregateExchangeRatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -763,6 +823,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOracleRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorOracleRewards = append(m.ValidatorOracleRewards, ValidatorOracleRewards{})
+			if err := m.ValidatorOracleRewards[len(m.ValidatorOracleRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateExchangeRatePrevotes = append(m.AggregateExchangeRatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregateExchangeRatePrevotes[len(m.AggregateExchangeRatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -30,20 +30,25 @@ const (
 //
 // - 0x03<valAddress_Bytes>: int64
 //
-// - 0x04<valAddress_Bytes>: DEPRECATED: AggregateExchangeRatePrevote
+// - 0x04<valAddress_Bytes>: AggregateExchangeRatePrevote
 //
 // - 0x05<valAddress_Bytes>: AggregateExchangeRateVote
 //
 // - 0x06<denom_Bytes>: sdk.Dec
+//
+// - 0x07<timestamp_Bytes>: PriceSnapshot
+//
+// - 0x08<valAddress_Bytes>: ValidatorOracleRewards
 var (
 	// Keys for store prefixes
-	ExchangeRateKey       = []byte{0x01} // prefix for each key to a rate
-	FeederDelegationKey   = []byte{0x02} // prefix for each key to a feeder delegation
-	VotePenaltyCounterKey = []byte{0x03} // prefix for each key to a miss counter
-	// DEPRECATED AggregateExchangeRatePrevoteKey = []byte{0x04}
-	AggregateExchangeRateVoteKey = []byte{0x05} // prefix for each key to a aggregate vote
-	VoteTargetKey                = []byte{0x06} // prefix for each key to a vote target
-	PriceSnapshotKey             = []byte{0x07} // key for price snapshots history
+	ExchangeRateKey                 = []byte{0x01} // prefix for each key to a rate
+	FeederDelegationKey             = []byte{0x02} // prefix for each key to a feeder delegation
+	VotePenaltyCounterKey           = []byte{0x03} // prefix for each key to a miss counter
+	AggregateExchangeRatePrevoteKey = []byte{0x04} // prefix for each key to a aggregate prevote
+	AggregateExchangeRateVoteKey    = []byte{0x05} // prefix for each key to a aggregate vote
+	VoteTargetKey                   = []byte{0x06} // prefix for each key to a vote target
+	PriceSnapshotKey                = []byte{0x07} // key for price snapshots history
+	ValidatorRewardsKey             = []byte{0x08} // prefix for each key to a validator's oracle rewards
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -61,11 +66,21 @@ func GetVotePenaltyCounterKey(v sdk.ValAddress) []byte {
 	return append(VotePenaltyCounterKey, address.MustLengthPrefix(v)...)
 }
 
+// GetAggregateExchangeRatePrevoteKey - stored by *Validator* address
+func GetAggregateExchangeRatePrevoteKey(v sdk.ValAddress) []byte {
+	return append(AggregateExchangeRatePrevoteKey, address.MustLengthPrefix(v)...)
+}
+
 // GetAggregateExchangeRateVoteKey - stored by *Validator* address
 func GetAggregateExchangeRateVoteKey(v sdk.ValAddress) []byte {
 	return append(AggregateExchangeRateVoteKey, address.MustLengthPrefix(v)...)
 }
 
+// GetValidatorRewardsKey - stored by *Validator* address
+func GetValidatorRewardsKey(v sdk.ValAddress) []byte {
+	return append(ValidatorRewardsKey, address.MustLengthPrefix(v)...)
+}
+
 func GetVoteTargetKey(d string) []byte {
 	return append(VoteTargetKey, []byte(d)...)
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,36 @@ func TestMsgFeederDelegation(t *testing.T) {
 	}
 }
 
+func TestMsgAggregateExchangeRatePrevote(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	exchangeRates := sdk.DecCoins{sdk.NewDecCoinFromDec("foo", sdk.OneDec()), sdk.NewDecCoinFromDec("bar", sdk.OneDec())}
+	bz := GetAggregateVoteHash("1", exchangeRates.String(), sdk.ValAddress(addrs[0]))
+
+	tests := []struct {
+		hash          AggregateVoteHash
+		exchangeRates sdk.DecCoins
+		voter         sdk.AccAddress
+		expectPass    bool
+	}{
+		{bz, exchangeRates, addrs[0], true},
+		{bz[1:], exchangeRates, addrs[0], false},
+		{bz, exchangeRates, sdk.AccAddress([]byte("")), false},
+		{AggregateVoteHash{}, exchangeRates, addrs[0], false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgAggregateExchangeRatePrevote(tc.hash, tc.voter, sdk.ValAddress(tc.voter))
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgAggregateExchangeRateVote(t *testing.T) {
 	addrs := []sdk.AccAddress{
 		sdk.AccAddress([]byte("addr1_______________")),
@@ -65,4 +96,14 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
 		}
 	}
+
+	// the salt revealing a prevote is 8 to 64 characters long
+	msg := NewMsgAggregateExchangeRateReveal(strings.Repeat("a", MinSaltLength), exchangeRates, addrs[0], sdk.ValAddress(addrs[0]))
+	require.NoError(t, msg.ValidateBasic())
+	msg = NewMsgAggregateExchangeRateReveal(strings.Repeat("a", MaxSaltLength), exchangeRates, addrs[0], sdk.ValAddress(addrs[0]))
+	require.NoError(t, msg.ValidateBasic())
+	msg = NewMsgAggregateExchangeRateReveal(strings.Repeat("a", MinSaltLength-1), exchangeRates, addrs[0], sdk.ValAddress(addrs[0]))
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidSaltLength)
+	msg = NewMsgAggregateExchangeRateReveal(strings.Repeat("a", MaxSaltLength+1), exchangeRates, addrs[0], sdk.ValAddress(addrs[0]))
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidSaltLength)
 }
@@ -21,12 +21,17 @@ var (
 	KeySlashWindow       = []byte("SlashWindow")
 	KeyMinValidPerWindow = []byte("MinValidPerWindow")
 	KeyLookbackDuration  = []byte("LookbackDuration")
+
+	KeyRewardDistributionWindow = []byte("RewardDistributionWindow")
+	KeyCommitRevealEnabled      = []byte("CommitRevealEnabled")
 )
 
 // Default parameter values
 const (
 	DefaultVotePeriod  = 2                      // Voting every other block
 	DefaultSlashWindow = utils.BlocksPerDay * 2 // 2 days for oracle slashing
+
+	DefaultRewardDistributionWindow = utils.BlocksPerYear // the reward pool is paid out over a year
 )
 
 // Default parameter values
@@ -42,6 +47,8 @@ var (
 	DefaultSlashFraction     = sdk.NewDecWithPrec(0, 4) // 0.00%
 	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
 	DefaultLookbackDuration  = uint64(3600)             // in seconds
+
+	DefaultCommitRevealEnabled = false
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -57,6 +64,9 @@ func DefaultParams() Params {
 		SlashWindow:       DefaultSlashWindow,
 		MinValidPerWindow: DefaultMinValidPerWindow,
 		LookbackDuration:  DefaultLookbackDuration,
+
+		RewardDistributionWindow: DefaultRewardDistributionWindow,
+		CommitRevealEnabled:      DefaultCommitRevealEnabled,
 	}
 }
 
@@ -77,6 +87,8 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
 		paramstypes.NewParamSetPair(KeyLookbackDuration, &p.LookbackDuration, validateLookbackDuration),
+		paramstypes.NewParamSetPair(KeyRewardDistributionWindow, &p.RewardDistributionWindow, validateRewardDistributionWindow),
+		paramstypes.NewParamSetPair(KeyCommitRevealEnabled, &p.CommitRevealEnabled, validateCommitRevealEnabled),
 	}
 }
 
@@ -115,9 +127,13 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.RewardDistributionWindow < p.VotePeriod {
+		return fmt.Errorf("oracle parameter RewardDistributionWindow must be greater than or equal with VotePeriod")
+	}
+
 	for _, denom := range p.Whitelist {
-		if len(denom.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+		if err := denom.Validate(); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -177,8 +193,8 @@ func validateWhitelist(i interface{}) error {
 	}
 
 	for _, d := range v {
-		if len(d.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+		if err := d.Validate(); err != nil {
+			return err
 		}
 	}
 
@@ -240,3 +256,25 @@ func validateLookbackDuration(i interface{}) error {
 
 	return nil
 }
+
+func validateRewardDistributionWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("reward distribution window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateCommitRevealEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -61,6 +61,12 @@ func TestParamsValid(t *testing.T) {
 	err = p8.Validate()
 	require.Error(t, err)
 
+	// reward distribution window shorter than a vote period
+	p10 := DefaultParams()
+	p10.RewardDistributionWindow = p10.VotePeriod - 1
+	err = p10.Validate()
+	require.Error(t, err)
+
 	p9 := DefaultParams()
 	require.NotNil(t, p9.ParamSetPairs())
 	require.NotNil(t, p9.String())